@@ -25,6 +25,33 @@ const (
 	RoleManager_GetModeratorAndParticipantsByUIDs_FullMethodName = "/Ayana.v1.RoleManager/GetModeratorAndParticipantsByUIDs"
 	RoleManager_GetAvailableModels_FullMethodName                = "/Ayana.v1.RoleManager/GetAvailableModels"
 	RoleManager_SetRole_FullMethodName                           = "/Ayana.v1.RoleManager/SetRole"
+	RoleManager_CreateCredential_FullMethodName                  = "/Ayana.v1.RoleManager/CreateCredential"
+	RoleManager_GetCredentials_FullMethodName                    = "/Ayana.v1.RoleManager/GetCredentials"
+	RoleManager_SetCredential_FullMethodName                     = "/Ayana.v1.RoleManager/SetCredential"
+	RoleManager_DeleteCredential_FullMethodName                  = "/Ayana.v1.RoleManager/DeleteCredential"
+	RoleManager_GetSystemRoles_FullMethodName                    = "/Ayana.v1.RoleManager/GetSystemRoles"
+	RoleManager_GetSystemRole_FullMethodName                     = "/Ayana.v1.RoleManager/GetSystemRole"
+	RoleManager_CloneSystemRole_FullMethodName                   = "/Ayana.v1.RoleManager/CloneSystemRole"
+	RoleManager_PublishRole_FullMethodName                       = "/Ayana.v1.RoleManager/PublishRole"
+	RoleManager_UnpublishRole_FullMethodName                     = "/Ayana.v1.RoleManager/UnpublishRole"
+	RoleManager_GetSharedRoles_FullMethodName                    = "/Ayana.v1.RoleManager/GetSharedRoles"
+	RoleManager_GetSharedRole_FullMethodName                     = "/Ayana.v1.RoleManager/GetSharedRole"
+	RoleManager_ImportSharedRole_FullMethodName                  = "/Ayana.v1.RoleManager/ImportSharedRole"
+	RoleManager_GetRoleVersions_FullMethodName                   = "/Ayana.v1.RoleManager/GetRoleVersions"
+	RoleManager_DiffRoleVersions_FullMethodName                  = "/Ayana.v1.RoleManager/DiffRoleVersions"
+	RoleManager_RollbackRole_FullMethodName                      = "/Ayana.v1.RoleManager/RollbackRole"
+	RoleManager_GenerateRole_FullMethodName                      = "/Ayana.v1.RoleManager/GenerateRole"
+	RoleManager_GenerateRolePanel_FullMethodName                 = "/Ayana.v1.RoleManager/GenerateRolePanel"
+	RoleManager_GetModels_FullMethodName                         = "/Ayana.v1.RoleManager/GetModels"
+	RoleManager_CreateModel_FullMethodName                       = "/Ayana.v1.RoleManager/CreateModel"
+	RoleManager_SetModel_FullMethodName                          = "/Ayana.v1.RoleManager/SetModel"
+	RoleManager_DeleteModel_FullMethodName                       = "/Ayana.v1.RoleManager/DeleteModel"
+	RoleManager_AdminCreateSystemRole_FullMethodName             = "/Ayana.v1.RoleManager/AdminCreateSystemRole"
+	RoleManager_AdminSetSystemRole_FullMethodName                = "/Ayana.v1.RoleManager/AdminSetSystemRole"
+	RoleManager_AdminDeleteSystemRole_FullMethodName             = "/Ayana.v1.RoleManager/AdminDeleteSystemRole"
+	RoleManager_GetCredential_FullMethodName                     = "/Ayana.v1.RoleManager/GetCredential"
+	RoleManager_ReportCredentialUsage_FullMethodName             = "/Ayana.v1.RoleManager/ReportCredentialUsage"
+	RoleManager_GetModel_FullMethodName                          = "/Ayana.v1.RoleManager/GetModel"
 )
 
 // RoleManagerClient is the client API for RoleManager service.
@@ -37,6 +64,43 @@ type RoleManagerClient interface {
 	GetModeratorAndParticipantsByUIDs(ctx context.Context, in *GetModeratorAndParticipantsByUIDsRequest, opts ...grpc.CallOption) (*GetModeratorAndParticipantsByUIDsReply, error)
 	GetAvailableModels(ctx context.Context, in *GetAvailableModelsRequest, opts ...grpc.CallOption) (*GetAvailableModelsReply, error)
 	SetRole(ctx context.Context, in *SetRoleRequest, opts ...grpc.CallOption) (*SetRoleReply, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*CreateCredentialReply, error)
+	GetCredentials(ctx context.Context, in *GetCredentialsRequest, opts ...grpc.CallOption) (*GetCredentialsReply, error)
+	SetCredential(ctx context.Context, in *SetCredentialRequest, opts ...grpc.CallOption) (*SetCredentialReply, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialReply, error)
+	// 系统内置角色目录，kind 为空时返回全部
+	GetSystemRoles(ctx context.Context, in *GetSystemRolesRequest, opts ...grpc.CallOption) (*GetSystemRolesReply, error)
+	GetSystemRole(ctx context.Context, in *GetSystemRoleRequest, opts ...grpc.CallOption) (*GetSystemRoleReply, error)
+	// 把系统角色复制到自己的角色库，可指定凭证或密钥
+	CloneSystemRole(ctx context.Context, in *CloneSystemRoleRequest, opts ...grpc.CallOption) (*CloneSystemRoleReply, error)
+	// 发布角色到分享广场，visibility 为 public 时出现在公开列表，unlisted 时只能通过分享码访问
+	PublishRole(ctx context.Context, in *PublishRoleRequest, opts ...grpc.CallOption) (*PublishRoleReply, error)
+	UnpublishRole(ctx context.Context, in *UnpublishRoleRequest, opts ...grpc.CallOption) (*UnpublishRoleReply, error)
+	GetSharedRoles(ctx context.Context, in *GetSharedRolesRequest, opts ...grpc.CallOption) (*GetSharedRolesReply, error)
+	GetSharedRole(ctx context.Context, in *GetSharedRoleRequest, opts ...grpc.CallOption) (*GetSharedRoleReply, error)
+	ImportSharedRole(ctx context.Context, in *ImportSharedRoleRequest, opts ...grpc.CallOption) (*ImportSharedRoleReply, error)
+	// 角色的历史版本，每次修改人设都会生成新版本
+	GetRoleVersions(ctx context.Context, in *GetRoleVersionsRequest, opts ...grpc.CallOption) (*GetRoleVersionsReply, error)
+	DiffRoleVersions(ctx context.Context, in *DiffRoleVersionsRequest, opts ...grpc.CallOption) (*DiffRoleVersionsReply, error)
+	// 回滚到历史版本，会生成一个内容与该版本相同的新版本
+	RollbackRole(ctx context.Context, in *RollbackRoleRequest, opts ...grpc.CallOption) (*RollbackRoleReply, error)
+	// 根据一句话简介生成角色草稿
+	GenerateRole(ctx context.Context, in *GenerateRoleRequest, opts ...grpc.CallOption) (*GenerateRoleReply, error)
+	// 为讨论主题生成一组立场均衡的角色草稿
+	GenerateRolePanel(ctx context.Context, in *GenerateRolePanelRequest, opts ...grpc.CallOption) (*GenerateRolePanelReply, error)
+	// 模型注册表管理，仅管理员可用
+	GetModels(ctx context.Context, in *GetModelsRequest, opts ...grpc.CallOption) (*GetModelsReply, error)
+	CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelReply, error)
+	SetModel(ctx context.Context, in *SetModelRequest, opts ...grpc.CallOption) (*SetModelReply, error)
+	DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...grpc.CallOption) (*DeleteModelReply, error)
+	// 管理系统角色目录。删除只是下架，已经使用该角色的讨论仍可继续运行
+	AdminCreateSystemRole(ctx context.Context, in *AdminCreateSystemRoleRequest, opts ...grpc.CallOption) (*AdminCreateSystemRoleReply, error)
+	AdminSetSystemRole(ctx context.Context, in *AdminSetSystemRoleRequest, opts ...grpc.CallOption) (*AdminSetSystemRoleReply, error)
+	AdminDeleteSystemRole(ctx context.Context, in *AdminDeleteSystemRoleRequest, opts ...grpc.CallOption) (*AdminDeleteSystemRoleReply, error)
+	// 以下接口仅供内部服务调用，返回的密钥仍为密文
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialReply, error)
+	ReportCredentialUsage(ctx context.Context, in *ReportCredentialUsageRequest, opts ...grpc.CallOption) (*ReportCredentialUsageReply, error)
+	GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*GetModelReply, error)
 }
 
 type roleManagerClient struct {
@@ -107,6 +171,276 @@ func (c *roleManagerClient) SetRole(ctx context.Context, in *SetRoleRequest, opt
 	return out, nil
 }
 
+func (c *roleManagerClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*CreateCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCredentialReply)
+	err := c.cc.Invoke(ctx, RoleManager_CreateCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetCredentials(ctx context.Context, in *GetCredentialsRequest, opts ...grpc.CallOption) (*GetCredentialsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialsReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) SetCredential(ctx context.Context, in *SetCredentialRequest, opts ...grpc.CallOption) (*SetCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCredentialReply)
+	err := c.cc.Invoke(ctx, RoleManager_SetCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCredentialReply)
+	err := c.cc.Invoke(ctx, RoleManager_DeleteCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetSystemRoles(ctx context.Context, in *GetSystemRolesRequest, opts ...grpc.CallOption) (*GetSystemRolesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemRolesReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetSystemRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetSystemRole(ctx context.Context, in *GetSystemRoleRequest, opts ...grpc.CallOption) (*GetSystemRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetSystemRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) CloneSystemRole(ctx context.Context, in *CloneSystemRoleRequest, opts ...grpc.CallOption) (*CloneSystemRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneSystemRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_CloneSystemRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) PublishRole(ctx context.Context, in *PublishRoleRequest, opts ...grpc.CallOption) (*PublishRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_PublishRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) UnpublishRole(ctx context.Context, in *UnpublishRoleRequest, opts ...grpc.CallOption) (*UnpublishRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_UnpublishRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetSharedRoles(ctx context.Context, in *GetSharedRolesRequest, opts ...grpc.CallOption) (*GetSharedRolesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedRolesReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetSharedRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetSharedRole(ctx context.Context, in *GetSharedRoleRequest, opts ...grpc.CallOption) (*GetSharedRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetSharedRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) ImportSharedRole(ctx context.Context, in *ImportSharedRoleRequest, opts ...grpc.CallOption) (*ImportSharedRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSharedRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_ImportSharedRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetRoleVersions(ctx context.Context, in *GetRoleVersionsRequest, opts ...grpc.CallOption) (*GetRoleVersionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoleVersionsReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetRoleVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) DiffRoleVersions(ctx context.Context, in *DiffRoleVersionsRequest, opts ...grpc.CallOption) (*DiffRoleVersionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRoleVersionsReply)
+	err := c.cc.Invoke(ctx, RoleManager_DiffRoleVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) RollbackRole(ctx context.Context, in *RollbackRoleRequest, opts ...grpc.CallOption) (*RollbackRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_RollbackRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GenerateRole(ctx context.Context, in *GenerateRoleRequest, opts ...grpc.CallOption) (*GenerateRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_GenerateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GenerateRolePanel(ctx context.Context, in *GenerateRolePanelRequest, opts ...grpc.CallOption) (*GenerateRolePanelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateRolePanelReply)
+	err := c.cc.Invoke(ctx, RoleManager_GenerateRolePanel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetModels(ctx context.Context, in *GetModelsRequest, opts ...grpc.CallOption) (*GetModelsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModelsReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateModelReply)
+	err := c.cc.Invoke(ctx, RoleManager_CreateModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) SetModel(ctx context.Context, in *SetModelRequest, opts ...grpc.CallOption) (*SetModelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetModelReply)
+	err := c.cc.Invoke(ctx, RoleManager_SetModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...grpc.CallOption) (*DeleteModelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteModelReply)
+	err := c.cc.Invoke(ctx, RoleManager_DeleteModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) AdminCreateSystemRole(ctx context.Context, in *AdminCreateSystemRoleRequest, opts ...grpc.CallOption) (*AdminCreateSystemRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCreateSystemRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_AdminCreateSystemRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) AdminSetSystemRole(ctx context.Context, in *AdminSetSystemRoleRequest, opts ...grpc.CallOption) (*AdminSetSystemRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSetSystemRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_AdminSetSystemRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) AdminDeleteSystemRole(ctx context.Context, in *AdminDeleteSystemRoleRequest, opts ...grpc.CallOption) (*AdminDeleteSystemRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteSystemRoleReply)
+	err := c.cc.Invoke(ctx, RoleManager_AdminDeleteSystemRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) ReportCredentialUsage(ctx context.Context, in *ReportCredentialUsageRequest, opts ...grpc.CallOption) (*ReportCredentialUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportCredentialUsageReply)
+	err := c.cc.Invoke(ctx, RoleManager_ReportCredentialUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleManagerClient) GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*GetModelReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModelReply)
+	err := c.cc.Invoke(ctx, RoleManager_GetModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleManagerServer is the server API for RoleManager service.
 // All implementations must embed UnimplementedRoleManagerServer
 // for forward compatibility.
@@ -117,6 +451,43 @@ type RoleManagerServer interface {
 	GetModeratorAndParticipantsByUIDs(context.Context, *GetModeratorAndParticipantsByUIDsRequest) (*GetModeratorAndParticipantsByUIDsReply, error)
 	GetAvailableModels(context.Context, *GetAvailableModelsRequest) (*GetAvailableModelsReply, error)
 	SetRole(context.Context, *SetRoleRequest) (*SetRoleReply, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*CreateCredentialReply, error)
+	GetCredentials(context.Context, *GetCredentialsRequest) (*GetCredentialsReply, error)
+	SetCredential(context.Context, *SetCredentialRequest) (*SetCredentialReply, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialReply, error)
+	// 系统内置角色目录，kind 为空时返回全部
+	GetSystemRoles(context.Context, *GetSystemRolesRequest) (*GetSystemRolesReply, error)
+	GetSystemRole(context.Context, *GetSystemRoleRequest) (*GetSystemRoleReply, error)
+	// 把系统角色复制到自己的角色库，可指定凭证或密钥
+	CloneSystemRole(context.Context, *CloneSystemRoleRequest) (*CloneSystemRoleReply, error)
+	// 发布角色到分享广场，visibility 为 public 时出现在公开列表，unlisted 时只能通过分享码访问
+	PublishRole(context.Context, *PublishRoleRequest) (*PublishRoleReply, error)
+	UnpublishRole(context.Context, *UnpublishRoleRequest) (*UnpublishRoleReply, error)
+	GetSharedRoles(context.Context, *GetSharedRolesRequest) (*GetSharedRolesReply, error)
+	GetSharedRole(context.Context, *GetSharedRoleRequest) (*GetSharedRoleReply, error)
+	ImportSharedRole(context.Context, *ImportSharedRoleRequest) (*ImportSharedRoleReply, error)
+	// 角色的历史版本，每次修改人设都会生成新版本
+	GetRoleVersions(context.Context, *GetRoleVersionsRequest) (*GetRoleVersionsReply, error)
+	DiffRoleVersions(context.Context, *DiffRoleVersionsRequest) (*DiffRoleVersionsReply, error)
+	// 回滚到历史版本，会生成一个内容与该版本相同的新版本
+	RollbackRole(context.Context, *RollbackRoleRequest) (*RollbackRoleReply, error)
+	// 根据一句话简介生成角色草稿
+	GenerateRole(context.Context, *GenerateRoleRequest) (*GenerateRoleReply, error)
+	// 为讨论主题生成一组立场均衡的角色草稿
+	GenerateRolePanel(context.Context, *GenerateRolePanelRequest) (*GenerateRolePanelReply, error)
+	// 模型注册表管理，仅管理员可用
+	GetModels(context.Context, *GetModelsRequest) (*GetModelsReply, error)
+	CreateModel(context.Context, *CreateModelRequest) (*CreateModelReply, error)
+	SetModel(context.Context, *SetModelRequest) (*SetModelReply, error)
+	DeleteModel(context.Context, *DeleteModelRequest) (*DeleteModelReply, error)
+	// 管理系统角色目录。删除只是下架，已经使用该角色的讨论仍可继续运行
+	AdminCreateSystemRole(context.Context, *AdminCreateSystemRoleRequest) (*AdminCreateSystemRoleReply, error)
+	AdminSetSystemRole(context.Context, *AdminSetSystemRoleRequest) (*AdminSetSystemRoleReply, error)
+	AdminDeleteSystemRole(context.Context, *AdminDeleteSystemRoleRequest) (*AdminDeleteSystemRoleReply, error)
+	// 以下接口仅供内部服务调用，返回的密钥仍为密文
+	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialReply, error)
+	ReportCredentialUsage(context.Context, *ReportCredentialUsageRequest) (*ReportCredentialUsageReply, error)
+	GetModel(context.Context, *GetModelRequest) (*GetModelReply, error)
 	mustEmbedUnimplementedRoleManagerServer()
 }
 
@@ -145,6 +516,87 @@ func (UnimplementedRoleManagerServer) GetAvailableModels(context.Context, *GetAv
 func (UnimplementedRoleManagerServer) SetRole(context.Context, *SetRoleRequest) (*SetRoleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRole not implemented")
 }
+func (UnimplementedRoleManagerServer) CreateCredential(context.Context, *CreateCredentialRequest) (*CreateCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (UnimplementedRoleManagerServer) GetCredentials(context.Context, *GetCredentialsRequest) (*GetCredentialsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredentials not implemented")
+}
+func (UnimplementedRoleManagerServer) SetCredential(context.Context, *SetCredentialRequest) (*SetCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCredential not implemented")
+}
+func (UnimplementedRoleManagerServer) DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (UnimplementedRoleManagerServer) GetSystemRoles(context.Context, *GetSystemRolesRequest) (*GetSystemRolesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemRoles not implemented")
+}
+func (UnimplementedRoleManagerServer) GetSystemRole(context.Context, *GetSystemRoleRequest) (*GetSystemRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemRole not implemented")
+}
+func (UnimplementedRoleManagerServer) CloneSystemRole(context.Context, *CloneSystemRoleRequest) (*CloneSystemRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSystemRole not implemented")
+}
+func (UnimplementedRoleManagerServer) PublishRole(context.Context, *PublishRoleRequest) (*PublishRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishRole not implemented")
+}
+func (UnimplementedRoleManagerServer) UnpublishRole(context.Context, *UnpublishRoleRequest) (*UnpublishRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishRole not implemented")
+}
+func (UnimplementedRoleManagerServer) GetSharedRoles(context.Context, *GetSharedRolesRequest) (*GetSharedRolesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedRoles not implemented")
+}
+func (UnimplementedRoleManagerServer) GetSharedRole(context.Context, *GetSharedRoleRequest) (*GetSharedRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedRole not implemented")
+}
+func (UnimplementedRoleManagerServer) ImportSharedRole(context.Context, *ImportSharedRoleRequest) (*ImportSharedRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSharedRole not implemented")
+}
+func (UnimplementedRoleManagerServer) GetRoleVersions(context.Context, *GetRoleVersionsRequest) (*GetRoleVersionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleVersions not implemented")
+}
+func (UnimplementedRoleManagerServer) DiffRoleVersions(context.Context, *DiffRoleVersionsRequest) (*DiffRoleVersionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRoleVersions not implemented")
+}
+func (UnimplementedRoleManagerServer) RollbackRole(context.Context, *RollbackRoleRequest) (*RollbackRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRole not implemented")
+}
+func (UnimplementedRoleManagerServer) GenerateRole(context.Context, *GenerateRoleRequest) (*GenerateRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRole not implemented")
+}
+func (UnimplementedRoleManagerServer) GenerateRolePanel(context.Context, *GenerateRolePanelRequest) (*GenerateRolePanelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRolePanel not implemented")
+}
+func (UnimplementedRoleManagerServer) GetModels(context.Context, *GetModelsRequest) (*GetModelsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModels not implemented")
+}
+func (UnimplementedRoleManagerServer) CreateModel(context.Context, *CreateModelRequest) (*CreateModelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateModel not implemented")
+}
+func (UnimplementedRoleManagerServer) SetModel(context.Context, *SetModelRequest) (*SetModelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModel not implemented")
+}
+func (UnimplementedRoleManagerServer) DeleteModel(context.Context, *DeleteModelRequest) (*DeleteModelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModel not implemented")
+}
+func (UnimplementedRoleManagerServer) AdminCreateSystemRole(context.Context, *AdminCreateSystemRoleRequest) (*AdminCreateSystemRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateSystemRole not implemented")
+}
+func (UnimplementedRoleManagerServer) AdminSetSystemRole(context.Context, *AdminSetSystemRoleRequest) (*AdminSetSystemRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetSystemRole not implemented")
+}
+func (UnimplementedRoleManagerServer) AdminDeleteSystemRole(context.Context, *AdminDeleteSystemRoleRequest) (*AdminDeleteSystemRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteSystemRole not implemented")
+}
+func (UnimplementedRoleManagerServer) GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+func (UnimplementedRoleManagerServer) ReportCredentialUsage(context.Context, *ReportCredentialUsageRequest) (*ReportCredentialUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCredentialUsage not implemented")
+}
+func (UnimplementedRoleManagerServer) GetModel(context.Context, *GetModelRequest) (*GetModelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModel not implemented")
+}
 func (UnimplementedRoleManagerServer) mustEmbedUnimplementedRoleManagerServer() {}
 func (UnimplementedRoleManagerServer) testEmbeddedByValue()                     {}
 
@@ -274,36 +726,630 @@ func _RoleManager_SetRole_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// RoleManager_ServiceDesc is the grpc.ServiceDesc for RoleManager service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RoleManager_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "Ayana.v1.RoleManager",
-	HandlerType: (*RoleManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRole",
-			Handler:    _RoleManager_CreateRole_Handler,
-		},
-		{
-			MethodName: "DeleteRole",
-			Handler:    _RoleManager_DeleteRole_Handler,
-		},
-		{
-			MethodName: "GetRoles",
-			Handler:    _RoleManager_GetRoles_Handler,
-		},
-		{
-			MethodName: "GetModeratorAndParticipantsByUIDs",
-			Handler:    _RoleManager_GetModeratorAndParticipantsByUIDs_Handler,
-		},
-		{
-			MethodName: "GetAvailableModels",
-			Handler:    _RoleManager_GetAvailableModels_Handler,
-		},
-		{
-			MethodName: "SetRole",
-			Handler:    _RoleManager_SetRole_Handler,
+func _RoleManager_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_CreateCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetCredentials(ctx, req.(*GetCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_SetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).SetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_SetCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).SetCredential(ctx, req.(*SetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_DeleteCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetSystemRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetSystemRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetSystemRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetSystemRoles(ctx, req.(*GetSystemRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetSystemRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetSystemRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetSystemRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetSystemRole(ctx, req.(*GetSystemRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_CloneSystemRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSystemRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).CloneSystemRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_CloneSystemRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).CloneSystemRole(ctx, req.(*CloneSystemRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_PublishRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).PublishRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_PublishRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).PublishRole(ctx, req.(*PublishRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_UnpublishRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).UnpublishRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_UnpublishRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).UnpublishRole(ctx, req.(*UnpublishRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetSharedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetSharedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetSharedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetSharedRoles(ctx, req.(*GetSharedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetSharedRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetSharedRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetSharedRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetSharedRole(ctx, req.(*GetSharedRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_ImportSharedRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSharedRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).ImportSharedRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_ImportSharedRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).ImportSharedRole(ctx, req.(*ImportSharedRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetRoleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetRoleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetRoleVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetRoleVersions(ctx, req.(*GetRoleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_DiffRoleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRoleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).DiffRoleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_DiffRoleVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).DiffRoleVersions(ctx, req.(*DiffRoleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_RollbackRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).RollbackRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_RollbackRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).RollbackRole(ctx, req.(*RollbackRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GenerateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GenerateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GenerateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GenerateRole(ctx, req.(*GenerateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GenerateRolePanel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRolePanelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GenerateRolePanel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GenerateRolePanel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GenerateRolePanel(ctx, req.(*GenerateRolePanelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetModels(ctx, req.(*GetModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_CreateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).CreateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_CreateModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).CreateModel(ctx, req.(*CreateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_SetModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).SetModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_SetModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).SetModel(ctx, req.(*SetModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_DeleteModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).DeleteModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_DeleteModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).DeleteModel(ctx, req.(*DeleteModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_AdminCreateSystemRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateSystemRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).AdminCreateSystemRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_AdminCreateSystemRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).AdminCreateSystemRole(ctx, req.(*AdminCreateSystemRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_AdminSetSystemRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetSystemRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).AdminSetSystemRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_AdminSetSystemRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).AdminSetSystemRole(ctx, req.(*AdminSetSystemRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_AdminDeleteSystemRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteSystemRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).AdminDeleteSystemRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_AdminDeleteSystemRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).AdminDeleteSystemRole(ctx, req.(*AdminDeleteSystemRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetCredential(ctx, req.(*GetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_ReportCredentialUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCredentialUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).ReportCredentialUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_ReportCredentialUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).ReportCredentialUsage(ctx, req.(*ReportCredentialUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleManager_GetModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleManagerServer).GetModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleManager_GetModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleManagerServer).GetModel(ctx, req.(*GetModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoleManager_ServiceDesc is the grpc.ServiceDesc for RoleManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoleManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Ayana.v1.RoleManager",
+	HandlerType: (*RoleManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRole",
+			Handler:    _RoleManager_CreateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _RoleManager_DeleteRole_Handler,
+		},
+		{
+			MethodName: "GetRoles",
+			Handler:    _RoleManager_GetRoles_Handler,
+		},
+		{
+			MethodName: "GetModeratorAndParticipantsByUIDs",
+			Handler:    _RoleManager_GetModeratorAndParticipantsByUIDs_Handler,
+		},
+		{
+			MethodName: "GetAvailableModels",
+			Handler:    _RoleManager_GetAvailableModels_Handler,
+		},
+		{
+			MethodName: "SetRole",
+			Handler:    _RoleManager_SetRole_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _RoleManager_CreateCredential_Handler,
+		},
+		{
+			MethodName: "GetCredentials",
+			Handler:    _RoleManager_GetCredentials_Handler,
+		},
+		{
+			MethodName: "SetCredential",
+			Handler:    _RoleManager_SetCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _RoleManager_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "GetSystemRoles",
+			Handler:    _RoleManager_GetSystemRoles_Handler,
+		},
+		{
+			MethodName: "GetSystemRole",
+			Handler:    _RoleManager_GetSystemRole_Handler,
+		},
+		{
+			MethodName: "CloneSystemRole",
+			Handler:    _RoleManager_CloneSystemRole_Handler,
+		},
+		{
+			MethodName: "PublishRole",
+			Handler:    _RoleManager_PublishRole_Handler,
+		},
+		{
+			MethodName: "UnpublishRole",
+			Handler:    _RoleManager_UnpublishRole_Handler,
+		},
+		{
+			MethodName: "GetSharedRoles",
+			Handler:    _RoleManager_GetSharedRoles_Handler,
+		},
+		{
+			MethodName: "GetSharedRole",
+			Handler:    _RoleManager_GetSharedRole_Handler,
+		},
+		{
+			MethodName: "ImportSharedRole",
+			Handler:    _RoleManager_ImportSharedRole_Handler,
+		},
+		{
+			MethodName: "GetRoleVersions",
+			Handler:    _RoleManager_GetRoleVersions_Handler,
+		},
+		{
+			MethodName: "DiffRoleVersions",
+			Handler:    _RoleManager_DiffRoleVersions_Handler,
+		},
+		{
+			MethodName: "RollbackRole",
+			Handler:    _RoleManager_RollbackRole_Handler,
+		},
+		{
+			MethodName: "GenerateRole",
+			Handler:    _RoleManager_GenerateRole_Handler,
+		},
+		{
+			MethodName: "GenerateRolePanel",
+			Handler:    _RoleManager_GenerateRolePanel_Handler,
+		},
+		{
+			MethodName: "GetModels",
+			Handler:    _RoleManager_GetModels_Handler,
+		},
+		{
+			MethodName: "CreateModel",
+			Handler:    _RoleManager_CreateModel_Handler,
+		},
+		{
+			MethodName: "SetModel",
+			Handler:    _RoleManager_SetModel_Handler,
+		},
+		{
+			MethodName: "DeleteModel",
+			Handler:    _RoleManager_DeleteModel_Handler,
+		},
+		{
+			MethodName: "AdminCreateSystemRole",
+			Handler:    _RoleManager_AdminCreateSystemRole_Handler,
+		},
+		{
+			MethodName: "AdminSetSystemRole",
+			Handler:    _RoleManager_AdminSetSystemRole_Handler,
+		},
+		{
+			MethodName: "AdminDeleteSystemRole",
+			Handler:    _RoleManager_AdminDeleteSystemRole_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _RoleManager_GetCredential_Handler,
+		},
+		{
+			MethodName: "ReportCredentialUsage",
+			Handler:    _RoleManager_ReportCredentialUsage_Handler,
+		},
+		{
+			MethodName: "GetModel",
+			Handler:    _RoleManager_GetModel_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -19,18 +19,75 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationRoleManagerAdminCreateSystemRole = "/Ayana.v1.RoleManager/AdminCreateSystemRole"
+const OperationRoleManagerAdminDeleteSystemRole = "/Ayana.v1.RoleManager/AdminDeleteSystemRole"
+const OperationRoleManagerAdminSetSystemRole = "/Ayana.v1.RoleManager/AdminSetSystemRole"
+const OperationRoleManagerCloneSystemRole = "/Ayana.v1.RoleManager/CloneSystemRole"
+const OperationRoleManagerCreateCredential = "/Ayana.v1.RoleManager/CreateCredential"
+const OperationRoleManagerCreateModel = "/Ayana.v1.RoleManager/CreateModel"
 const OperationRoleManagerCreateRole = "/Ayana.v1.RoleManager/CreateRole"
+const OperationRoleManagerDeleteCredential = "/Ayana.v1.RoleManager/DeleteCredential"
+const OperationRoleManagerDeleteModel = "/Ayana.v1.RoleManager/DeleteModel"
 const OperationRoleManagerDeleteRole = "/Ayana.v1.RoleManager/DeleteRole"
+const OperationRoleManagerDiffRoleVersions = "/Ayana.v1.RoleManager/DiffRoleVersions"
+const OperationRoleManagerGenerateRole = "/Ayana.v1.RoleManager/GenerateRole"
+const OperationRoleManagerGenerateRolePanel = "/Ayana.v1.RoleManager/GenerateRolePanel"
 const OperationRoleManagerGetAvailableModels = "/Ayana.v1.RoleManager/GetAvailableModels"
+const OperationRoleManagerGetCredentials = "/Ayana.v1.RoleManager/GetCredentials"
+const OperationRoleManagerGetModels = "/Ayana.v1.RoleManager/GetModels"
+const OperationRoleManagerGetRoleVersions = "/Ayana.v1.RoleManager/GetRoleVersions"
 const OperationRoleManagerGetRoles = "/Ayana.v1.RoleManager/GetRoles"
+const OperationRoleManagerGetSharedRole = "/Ayana.v1.RoleManager/GetSharedRole"
+const OperationRoleManagerGetSharedRoles = "/Ayana.v1.RoleManager/GetSharedRoles"
+const OperationRoleManagerGetSystemRole = "/Ayana.v1.RoleManager/GetSystemRole"
+const OperationRoleManagerGetSystemRoles = "/Ayana.v1.RoleManager/GetSystemRoles"
+const OperationRoleManagerImportSharedRole = "/Ayana.v1.RoleManager/ImportSharedRole"
+const OperationRoleManagerPublishRole = "/Ayana.v1.RoleManager/PublishRole"
+const OperationRoleManagerRollbackRole = "/Ayana.v1.RoleManager/RollbackRole"
+const OperationRoleManagerSetCredential = "/Ayana.v1.RoleManager/SetCredential"
+const OperationRoleManagerSetModel = "/Ayana.v1.RoleManager/SetModel"
 const OperationRoleManagerSetRole = "/Ayana.v1.RoleManager/SetRole"
+const OperationRoleManagerUnpublishRole = "/Ayana.v1.RoleManager/UnpublishRole"
 
 type RoleManagerHTTPServer interface {
+	// AdminCreateSystemRole 管理系统角色目录。删除只是下架，已经使用该角色的讨论仍可继续运行
+	AdminCreateSystemRole(context.Context, *AdminCreateSystemRoleRequest) (*AdminCreateSystemRoleReply, error)
+	AdminDeleteSystemRole(context.Context, *AdminDeleteSystemRoleRequest) (*AdminDeleteSystemRoleReply, error)
+	AdminSetSystemRole(context.Context, *AdminSetSystemRoleRequest) (*AdminSetSystemRoleReply, error)
+	// CloneSystemRole 把系统角色复制到自己的角色库，可指定凭证或密钥
+	CloneSystemRole(context.Context, *CloneSystemRoleRequest) (*CloneSystemRoleReply, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*CreateCredentialReply, error)
+	CreateModel(context.Context, *CreateModelRequest) (*CreateModelReply, error)
 	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleReply, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialReply, error)
+	DeleteModel(context.Context, *DeleteModelRequest) (*DeleteModelReply, error)
 	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleReply, error)
+	DiffRoleVersions(context.Context, *DiffRoleVersionsRequest) (*DiffRoleVersionsReply, error)
+	// GenerateRole 根据一句话简介生成角色草稿
+	GenerateRole(context.Context, *GenerateRoleRequest) (*GenerateRoleReply, error)
+	// GenerateRolePanel 为讨论主题生成一组立场均衡的角色草稿
+	GenerateRolePanel(context.Context, *GenerateRolePanelRequest) (*GenerateRolePanelReply, error)
 	GetAvailableModels(context.Context, *GetAvailableModelsRequest) (*GetAvailableModelsReply, error)
+	GetCredentials(context.Context, *GetCredentialsRequest) (*GetCredentialsReply, error)
+	// GetModels 模型注册表管理，仅管理员可用
+	GetModels(context.Context, *GetModelsRequest) (*GetModelsReply, error)
+	// GetRoleVersions 角色的历史版本，每次修改人设都会生成新版本
+	GetRoleVersions(context.Context, *GetRoleVersionsRequest) (*GetRoleVersionsReply, error)
 	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
+	GetSharedRole(context.Context, *GetSharedRoleRequest) (*GetSharedRoleReply, error)
+	GetSharedRoles(context.Context, *GetSharedRolesRequest) (*GetSharedRolesReply, error)
+	GetSystemRole(context.Context, *GetSystemRoleRequest) (*GetSystemRoleReply, error)
+	// GetSystemRoles 系统内置角色目录，kind 为空时返回全部
+	GetSystemRoles(context.Context, *GetSystemRolesRequest) (*GetSystemRolesReply, error)
+	ImportSharedRole(context.Context, *ImportSharedRoleRequest) (*ImportSharedRoleReply, error)
+	// PublishRole 发布角色到分享广场，visibility 为 public 时出现在公开列表，unlisted 时只能通过分享码访问
+	PublishRole(context.Context, *PublishRoleRequest) (*PublishRoleReply, error)
+	// RollbackRole 回滚到历史版本，会生成一个内容与该版本相同的新版本
+	RollbackRole(context.Context, *RollbackRoleRequest) (*RollbackRoleReply, error)
+	SetCredential(context.Context, *SetCredentialRequest) (*SetCredentialReply, error)
+	SetModel(context.Context, *SetModelRequest) (*SetModelReply, error)
 	SetRole(context.Context, *SetRoleRequest) (*SetRoleReply, error)
+	UnpublishRole(context.Context, *UnpublishRoleRequest) (*UnpublishRoleReply, error)
 }
 
 func RegisterRoleManagerHTTPServer(s *http.Server, srv RoleManagerHTTPServer) {
@@ -40,6 +97,30 @@ func RegisterRoleManagerHTTPServer(s *http.Server, srv RoleManagerHTTPServer) {
 	r.POST("/role/getting", _RoleManager_GetRoles0_HTTP_Handler(srv))
 	r.POST("/role/model/getting", _RoleManager_GetAvailableModels0_HTTP_Handler(srv))
 	r.POST("/role/setting", _RoleManager_SetRole0_HTTP_Handler(srv))
+	r.POST("/role/credential/creating", _RoleManager_CreateCredential0_HTTP_Handler(srv))
+	r.POST("/role/credential/getting", _RoleManager_GetCredentials0_HTTP_Handler(srv))
+	r.POST("/role/credential/setting", _RoleManager_SetCredential0_HTTP_Handler(srv))
+	r.POST("/role/credential/deleting", _RoleManager_DeleteCredential0_HTTP_Handler(srv))
+	r.POST("/role/system/getting", _RoleManager_GetSystemRoles0_HTTP_Handler(srv))
+	r.POST("/role/system/previewing", _RoleManager_GetSystemRole0_HTTP_Handler(srv))
+	r.POST("/role/system/cloning", _RoleManager_CloneSystemRole0_HTTP_Handler(srv))
+	r.POST("/role/publishing", _RoleManager_PublishRole0_HTTP_Handler(srv))
+	r.POST("/role/unpublishing", _RoleManager_UnpublishRole0_HTTP_Handler(srv))
+	r.POST("/role/gallery/getting", _RoleManager_GetSharedRoles0_HTTP_Handler(srv))
+	r.POST("/role/gallery/previewing", _RoleManager_GetSharedRole0_HTTP_Handler(srv))
+	r.POST("/role/gallery/importing", _RoleManager_ImportSharedRole0_HTTP_Handler(srv))
+	r.POST("/role/version/getting", _RoleManager_GetRoleVersions0_HTTP_Handler(srv))
+	r.POST("/role/version/diffing", _RoleManager_DiffRoleVersions0_HTTP_Handler(srv))
+	r.POST("/role/version/rollback", _RoleManager_RollbackRole0_HTTP_Handler(srv))
+	r.POST("/role/generating", _RoleManager_GenerateRole0_HTTP_Handler(srv))
+	r.POST("/role/panel/generating", _RoleManager_GenerateRolePanel0_HTTP_Handler(srv))
+	r.POST("/role/admin/model/getting", _RoleManager_GetModels0_HTTP_Handler(srv))
+	r.POST("/role/admin/model/creating", _RoleManager_CreateModel0_HTTP_Handler(srv))
+	r.POST("/role/admin/model/setting", _RoleManager_SetModel0_HTTP_Handler(srv))
+	r.POST("/role/admin/model/deleting", _RoleManager_DeleteModel0_HTTP_Handler(srv))
+	r.POST("/role/admin/system/role/creating", _RoleManager_AdminCreateSystemRole0_HTTP_Handler(srv))
+	r.POST("/role/admin/system/role/setting", _RoleManager_AdminSetSystemRole0_HTTP_Handler(srv))
+	r.POST("/role/admin/system/role/deleting", _RoleManager_AdminDeleteSystemRole0_HTTP_Handler(srv))
 }
 
 func _RoleManager_CreateRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
@@ -152,12 +233,564 @@ func _RoleManager_SetRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http
 	}
 }
 
+func _RoleManager_CreateCredential0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCredentialRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerCreateCredential)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCredential(ctx, req.(*CreateCredentialRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCredentialReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetCredentials0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCredentialsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetCredentials)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCredentials(ctx, req.(*GetCredentialsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCredentialsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_SetCredential0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetCredentialRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerSetCredential)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetCredential(ctx, req.(*SetCredentialRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetCredentialReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_DeleteCredential0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCredentialRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerDeleteCredential)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCredentialReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetSystemRoles0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSystemRolesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetSystemRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSystemRoles(ctx, req.(*GetSystemRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSystemRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetSystemRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSystemRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetSystemRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSystemRole(ctx, req.(*GetSystemRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSystemRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_CloneSystemRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CloneSystemRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerCloneSystemRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CloneSystemRole(ctx, req.(*CloneSystemRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CloneSystemRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_PublishRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerPublishRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishRole(ctx, req.(*PublishRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublishRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_UnpublishRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpublishRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerUnpublishRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnpublishRole(ctx, req.(*UnpublishRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpublishRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetSharedRoles0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSharedRolesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetSharedRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSharedRoles(ctx, req.(*GetSharedRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSharedRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetSharedRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSharedRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetSharedRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSharedRole(ctx, req.(*GetSharedRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSharedRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_ImportSharedRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportSharedRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerImportSharedRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportSharedRole(ctx, req.(*ImportSharedRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportSharedRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetRoleVersions0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRoleVersionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetRoleVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRoleVersions(ctx, req.(*GetRoleVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRoleVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_DiffRoleVersions0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffRoleVersionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerDiffRoleVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffRoleVersions(ctx, req.(*DiffRoleVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffRoleVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_RollbackRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerRollbackRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackRole(ctx, req.(*RollbackRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GenerateRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GenerateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGenerateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GenerateRole(ctx, req.(*GenerateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GenerateRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GenerateRolePanel0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GenerateRolePanelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGenerateRolePanel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GenerateRolePanel(ctx, req.(*GenerateRolePanelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GenerateRolePanelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_GetModels0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetModelsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerGetModels)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetModels(ctx, req.(*GetModelsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetModelsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_CreateModel0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateModelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerCreateModel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateModel(ctx, req.(*CreateModelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateModelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_SetModel0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetModelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerSetModel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetModel(ctx, req.(*SetModelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetModelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_DeleteModel0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteModelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerDeleteModel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteModel(ctx, req.(*DeleteModelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteModelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_AdminCreateSystemRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCreateSystemRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerAdminCreateSystemRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCreateSystemRole(ctx, req.(*AdminCreateSystemRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCreateSystemRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_AdminSetSystemRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSetSystemRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerAdminSetSystemRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSetSystemRole(ctx, req.(*AdminSetSystemRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSetSystemRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleManager_AdminDeleteSystemRole0_HTTP_Handler(srv RoleManagerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDeleteSystemRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleManagerAdminDeleteSystemRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDeleteSystemRole(ctx, req.(*AdminDeleteSystemRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDeleteSystemRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type RoleManagerHTTPClient interface {
+	AdminCreateSystemRole(ctx context.Context, req *AdminCreateSystemRoleRequest, opts ...http.CallOption) (rsp *AdminCreateSystemRoleReply, err error)
+	AdminDeleteSystemRole(ctx context.Context, req *AdminDeleteSystemRoleRequest, opts ...http.CallOption) (rsp *AdminDeleteSystemRoleReply, err error)
+	AdminSetSystemRole(ctx context.Context, req *AdminSetSystemRoleRequest, opts ...http.CallOption) (rsp *AdminSetSystemRoleReply, err error)
+	CloneSystemRole(ctx context.Context, req *CloneSystemRoleRequest, opts ...http.CallOption) (rsp *CloneSystemRoleReply, err error)
+	CreateCredential(ctx context.Context, req *CreateCredentialRequest, opts ...http.CallOption) (rsp *CreateCredentialReply, err error)
+	CreateModel(ctx context.Context, req *CreateModelRequest, opts ...http.CallOption) (rsp *CreateModelReply, err error)
 	CreateRole(ctx context.Context, req *CreateRoleRequest, opts ...http.CallOption) (rsp *CreateRoleReply, err error)
+	DeleteCredential(ctx context.Context, req *DeleteCredentialRequest, opts ...http.CallOption) (rsp *DeleteCredentialReply, err error)
+	DeleteModel(ctx context.Context, req *DeleteModelRequest, opts ...http.CallOption) (rsp *DeleteModelReply, err error)
 	DeleteRole(ctx context.Context, req *DeleteRoleRequest, opts ...http.CallOption) (rsp *DeleteRoleReply, err error)
+	DiffRoleVersions(ctx context.Context, req *DiffRoleVersionsRequest, opts ...http.CallOption) (rsp *DiffRoleVersionsReply, err error)
+	GenerateRole(ctx context.Context, req *GenerateRoleRequest, opts ...http.CallOption) (rsp *GenerateRoleReply, err error)
+	GenerateRolePanel(ctx context.Context, req *GenerateRolePanelRequest, opts ...http.CallOption) (rsp *GenerateRolePanelReply, err error)
 	GetAvailableModels(ctx context.Context, req *GetAvailableModelsRequest, opts ...http.CallOption) (rsp *GetAvailableModelsReply, err error)
+	GetCredentials(ctx context.Context, req *GetCredentialsRequest, opts ...http.CallOption) (rsp *GetCredentialsReply, err error)
+	GetModels(ctx context.Context, req *GetModelsRequest, opts ...http.CallOption) (rsp *GetModelsReply, err error)
+	GetRoleVersions(ctx context.Context, req *GetRoleVersionsRequest, opts ...http.CallOption) (rsp *GetRoleVersionsReply, err error)
 	GetRoles(ctx context.Context, req *GetRolesRequest, opts ...http.CallOption) (rsp *GetRolesReply, err error)
+	GetSharedRole(ctx context.Context, req *GetSharedRoleRequest, opts ...http.CallOption) (rsp *GetSharedRoleReply, err error)
+	GetSharedRoles(ctx context.Context, req *GetSharedRolesRequest, opts ...http.CallOption) (rsp *GetSharedRolesReply, err error)
+	GetSystemRole(ctx context.Context, req *GetSystemRoleRequest, opts ...http.CallOption) (rsp *GetSystemRoleReply, err error)
+	GetSystemRoles(ctx context.Context, req *GetSystemRolesRequest, opts ...http.CallOption) (rsp *GetSystemRolesReply, err error)
+	ImportSharedRole(ctx context.Context, req *ImportSharedRoleRequest, opts ...http.CallOption) (rsp *ImportSharedRoleReply, err error)
+	PublishRole(ctx context.Context, req *PublishRoleRequest, opts ...http.CallOption) (rsp *PublishRoleReply, err error)
+	RollbackRole(ctx context.Context, req *RollbackRoleRequest, opts ...http.CallOption) (rsp *RollbackRoleReply, err error)
+	SetCredential(ctx context.Context, req *SetCredentialRequest, opts ...http.CallOption) (rsp *SetCredentialReply, err error)
+	SetModel(ctx context.Context, req *SetModelRequest, opts ...http.CallOption) (rsp *SetModelReply, err error)
 	SetRole(ctx context.Context, req *SetRoleRequest, opts ...http.CallOption) (rsp *SetRoleReply, err error)
+	UnpublishRole(ctx context.Context, req *UnpublishRoleRequest, opts ...http.CallOption) (rsp *UnpublishRoleReply, err error)
 }
 
 type RoleManagerHTTPClientImpl struct {
@@ -168,6 +801,84 @@ func NewRoleManagerHTTPClient(client *http.Client) RoleManagerHTTPClient {
 	return &RoleManagerHTTPClientImpl{client}
 }
 
+func (c *RoleManagerHTTPClientImpl) AdminCreateSystemRole(ctx context.Context, in *AdminCreateSystemRoleRequest, opts ...http.CallOption) (*AdminCreateSystemRoleReply, error) {
+	var out AdminCreateSystemRoleReply
+	pattern := "/role/admin/system/role/creating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerAdminCreateSystemRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) AdminDeleteSystemRole(ctx context.Context, in *AdminDeleteSystemRoleRequest, opts ...http.CallOption) (*AdminDeleteSystemRoleReply, error) {
+	var out AdminDeleteSystemRoleReply
+	pattern := "/role/admin/system/role/deleting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerAdminDeleteSystemRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) AdminSetSystemRole(ctx context.Context, in *AdminSetSystemRoleRequest, opts ...http.CallOption) (*AdminSetSystemRoleReply, error) {
+	var out AdminSetSystemRoleReply
+	pattern := "/role/admin/system/role/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerAdminSetSystemRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) CloneSystemRole(ctx context.Context, in *CloneSystemRoleRequest, opts ...http.CallOption) (*CloneSystemRoleReply, error) {
+	var out CloneSystemRoleReply
+	pattern := "/role/system/cloning"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerCloneSystemRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...http.CallOption) (*CreateCredentialReply, error) {
+	var out CreateCredentialReply
+	pattern := "/role/credential/creating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerCreateCredential))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...http.CallOption) (*CreateModelReply, error) {
+	var out CreateModelReply
+	pattern := "/role/admin/model/creating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerCreateModel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RoleManagerHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...http.CallOption) (*CreateRoleReply, error) {
 	var out CreateRoleReply
 	pattern := "/role/creating"
@@ -181,6 +892,32 @@ func (c *RoleManagerHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRo
 	return &out, nil
 }
 
+func (c *RoleManagerHTTPClientImpl) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...http.CallOption) (*DeleteCredentialReply, error) {
+	var out DeleteCredentialReply
+	pattern := "/role/credential/deleting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerDeleteCredential))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) DeleteModel(ctx context.Context, in *DeleteModelRequest, opts ...http.CallOption) (*DeleteModelReply, error) {
+	var out DeleteModelReply
+	pattern := "/role/admin/model/deleting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerDeleteModel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RoleManagerHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...http.CallOption) (*DeleteRoleReply, error) {
 	var out DeleteRoleReply
 	pattern := "/role/deleting"
@@ -194,6 +931,45 @@ func (c *RoleManagerHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRo
 	return &out, nil
 }
 
+func (c *RoleManagerHTTPClientImpl) DiffRoleVersions(ctx context.Context, in *DiffRoleVersionsRequest, opts ...http.CallOption) (*DiffRoleVersionsReply, error) {
+	var out DiffRoleVersionsReply
+	pattern := "/role/version/diffing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerDiffRoleVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GenerateRole(ctx context.Context, in *GenerateRoleRequest, opts ...http.CallOption) (*GenerateRoleReply, error) {
+	var out GenerateRoleReply
+	pattern := "/role/generating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGenerateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GenerateRolePanel(ctx context.Context, in *GenerateRolePanelRequest, opts ...http.CallOption) (*GenerateRolePanelReply, error) {
+	var out GenerateRolePanelReply
+	pattern := "/role/panel/generating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGenerateRolePanel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RoleManagerHTTPClientImpl) GetAvailableModels(ctx context.Context, in *GetAvailableModelsRequest, opts ...http.CallOption) (*GetAvailableModelsReply, error) {
 	var out GetAvailableModelsReply
 	pattern := "/role/model/getting"
@@ -207,6 +983,45 @@ func (c *RoleManagerHTTPClientImpl) GetAvailableModels(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *RoleManagerHTTPClientImpl) GetCredentials(ctx context.Context, in *GetCredentialsRequest, opts ...http.CallOption) (*GetCredentialsReply, error) {
+	var out GetCredentialsReply
+	pattern := "/role/credential/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetCredentials))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GetModels(ctx context.Context, in *GetModelsRequest, opts ...http.CallOption) (*GetModelsReply, error) {
+	var out GetModelsReply
+	pattern := "/role/admin/model/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetModels))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GetRoleVersions(ctx context.Context, in *GetRoleVersionsRequest, opts ...http.CallOption) (*GetRoleVersionsReply, error) {
+	var out GetRoleVersionsReply
+	pattern := "/role/version/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetRoleVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RoleManagerHTTPClientImpl) GetRoles(ctx context.Context, in *GetRolesRequest, opts ...http.CallOption) (*GetRolesReply, error) {
 	var out GetRolesReply
 	pattern := "/role/getting"
@@ -220,6 +1035,123 @@ func (c *RoleManagerHTTPClientImpl) GetRoles(ctx context.Context, in *GetRolesRe
 	return &out, nil
 }
 
+func (c *RoleManagerHTTPClientImpl) GetSharedRole(ctx context.Context, in *GetSharedRoleRequest, opts ...http.CallOption) (*GetSharedRoleReply, error) {
+	var out GetSharedRoleReply
+	pattern := "/role/gallery/previewing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetSharedRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GetSharedRoles(ctx context.Context, in *GetSharedRolesRequest, opts ...http.CallOption) (*GetSharedRolesReply, error) {
+	var out GetSharedRolesReply
+	pattern := "/role/gallery/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetSharedRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GetSystemRole(ctx context.Context, in *GetSystemRoleRequest, opts ...http.CallOption) (*GetSystemRoleReply, error) {
+	var out GetSystemRoleReply
+	pattern := "/role/system/previewing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetSystemRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) GetSystemRoles(ctx context.Context, in *GetSystemRolesRequest, opts ...http.CallOption) (*GetSystemRolesReply, error) {
+	var out GetSystemRolesReply
+	pattern := "/role/system/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerGetSystemRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) ImportSharedRole(ctx context.Context, in *ImportSharedRoleRequest, opts ...http.CallOption) (*ImportSharedRoleReply, error) {
+	var out ImportSharedRoleReply
+	pattern := "/role/gallery/importing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerImportSharedRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) PublishRole(ctx context.Context, in *PublishRoleRequest, opts ...http.CallOption) (*PublishRoleReply, error) {
+	var out PublishRoleReply
+	pattern := "/role/publishing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerPublishRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) RollbackRole(ctx context.Context, in *RollbackRoleRequest, opts ...http.CallOption) (*RollbackRoleReply, error) {
+	var out RollbackRoleReply
+	pattern := "/role/version/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerRollbackRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) SetCredential(ctx context.Context, in *SetCredentialRequest, opts ...http.CallOption) (*SetCredentialReply, error) {
+	var out SetCredentialReply
+	pattern := "/role/credential/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerSetCredential))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RoleManagerHTTPClientImpl) SetModel(ctx context.Context, in *SetModelRequest, opts ...http.CallOption) (*SetModelReply, error) {
+	var out SetModelReply
+	pattern := "/role/admin/model/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerSetModel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RoleManagerHTTPClientImpl) SetRole(ctx context.Context, in *SetRoleRequest, opts ...http.CallOption) (*SetRoleReply, error) {
 	var out SetRoleReply
 	pattern := "/role/setting"
@@ -232,3 +1164,16 @@ func (c *RoleManagerHTTPClientImpl) SetRole(ctx context.Context, in *SetRoleRequ
 	}
 	return &out, nil
 }
+
+func (c *RoleManagerHTTPClientImpl) UnpublishRole(ctx context.Context, in *UnpublishRoleRequest, opts ...http.CallOption) (*UnpublishRoleReply, error) {
+	var out UnpublishRoleReply
+	pattern := "/role/unpublishing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleManagerUnpublishRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
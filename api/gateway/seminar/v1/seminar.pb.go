@@ -31,6 +31,8 @@ type TopicMetadata struct {
 	Moderator    string      `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
 	Participants []string    `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
 	Documents    []*Document `protobuf:"bytes,5,rep,name=documents,proto3" json:"documents,omitempty"`
+	Title        string      `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	TitleImage   string      `protobuf:"bytes,7,opt,name=title_image,json=titleImage,proto3" json:"title_image,omitempty"`
 }
 
 func (x *TopicMetadata) Reset() {
@@ -98,14 +100,30 @@ func (x *TopicMetadata) GetDocuments() []*Document {
 	return nil
 }
 
+func (x *TopicMetadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TopicMetadata) GetTitleImage() string {
+	if x != nil {
+		return x.TitleImage
+	}
+	return ""
+}
+
 type Speech struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid     string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	RoleUid string `protobuf:"bytes,2,opt,name=role_uid,json=roleUid,proto3" json:"role_uid,omitempty"`
-	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Uid         string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoleUid     string `protobuf:"bytes,2,opt,name=role_uid,json=roleUid,proto3" json:"role_uid,omitempty"`
+	Content     string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	RoleVersion int32  `protobuf:"varint,4,opt,name=role_version,json=roleVersion,proto3" json:"role_version,omitempty"`
+	RunUid      string `protobuf:"bytes,5,opt,name=run_uid,json=runUid,proto3" json:"run_uid,omitempty"`
 }
 
 func (x *Speech) Reset() {
@@ -159,19 +177,37 @@ func (x *Speech) GetContent() string {
 	return ""
 }
 
+func (x *Speech) GetRoleVersion() int32 {
+	if x != nil {
+		return x.RoleVersion
+	}
+	return 0
+}
+
+func (x *Speech) GetRunUid() string {
+	if x != nil {
+		return x.RunUid
+	}
+	return ""
+}
+
 type Topic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid          string      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Participants []string    `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	Speeches     []*Speech   `protobuf:"bytes,3,rep,name=speeches,proto3" json:"speeches,omitempty"`
-	Documents    []*Document `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
-	Title        string      `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	TitleImage   string      `protobuf:"bytes,6,opt,name=title_image,json=titleImage,proto3" json:"title_image,omitempty"`
-	Content      string      `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
-	Moderator    string      `protobuf:"bytes,8,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Uid                      string           `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Participants             []string         `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Speeches                 []*Speech        `protobuf:"bytes,3,rep,name=speeches,proto3" json:"speeches,omitempty"`
+	Documents                []*Document      `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	Title                    string           `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	TitleImage               string           `protobuf:"bytes,6,opt,name=title_image,json=titleImage,proto3" json:"title_image,omitempty"`
+	Content                  string           `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Moderator                string           `protobuf:"bytes,8,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	McpServers               []string         `protobuf:"bytes,9,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	RoleTools                []*RoleTools     `protobuf:"bytes,10,rep,name=role_tools,json=roleTools,proto3" json:"role_tools,omitempty"`
+	RoleDocuments            []*RoleDocuments `protobuf:"bytes,11,rep,name=role_documents,json=roleDocuments,proto3" json:"role_documents,omitempty"`
+	ModeratorSharedDocuments bool             `protobuf:"varint,12,opt,name=moderator_shared_documents,json=moderatorSharedDocuments,proto3" json:"moderator_shared_documents,omitempty"`
 }
 
 func (x *Topic) Reset() {
@@ -260,6 +296,34 @@ func (x *Topic) GetModerator() string {
 	return ""
 }
 
+func (x *Topic) GetMcpServers() []string {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *Topic) GetRoleTools() []*RoleTools {
+	if x != nil {
+		return x.RoleTools
+	}
+	return nil
+}
+
+func (x *Topic) GetRoleDocuments() []*RoleDocuments {
+	if x != nil {
+		return x.RoleDocuments
+	}
+	return nil
+}
+
+func (x *Topic) GetModeratorSharedDocuments() bool {
+	if x != nil {
+		return x.ModeratorSharedDocuments
+	}
+	return false
+}
+
 type Document struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -331,6 +395,14 @@ type CreateTopicRequest struct {
 	Moderator    string   `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
 	Participants []string `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
 	Documents    []string `protobuf:"bytes,5,rep,name=documents,proto3" json:"documents,omitempty"`
+	// 讨论使用的 MCP 服务器，为空时不使用工具
+	McpServers []string `protobuf:"bytes,6,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	// 未出现在列表中的角色可以使用所选服务器的全部工具
+	RoleTools []*RoleTools `protobuf:"bytes,7,rep,name=role_tools,json=roleTools,proto3" json:"role_tools,omitempty"`
+	// documents 是所有参与者共享的资料，role_documents 是只有对应角色能检索到的私有资料
+	RoleDocuments []*RoleDocuments `protobuf:"bytes,8,rep,name=role_documents,json=roleDocuments,proto3" json:"role_documents,omitempty"`
+	// 主持人是否也能检索共享资料
+	ModeratorSharedDocuments bool `protobuf:"varint,9,opt,name=moderator_shared_documents,json=moderatorSharedDocuments,proto3" json:"moderator_shared_documents,omitempty"`
 }
 
 func (x *CreateTopicRequest) Reset() {
@@ -398,6 +470,141 @@ func (x *CreateTopicRequest) GetDocuments() []string {
 	return nil
 }
 
+func (x *CreateTopicRequest) GetMcpServers() []string {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *CreateTopicRequest) GetRoleTools() []*RoleTools {
+	if x != nil {
+		return x.RoleTools
+	}
+	return nil
+}
+
+func (x *CreateTopicRequest) GetRoleDocuments() []*RoleDocuments {
+	if x != nil {
+		return x.RoleDocuments
+	}
+	return nil
+}
+
+func (x *CreateTopicRequest) GetModeratorSharedDocuments() bool {
+	if x != nil {
+		return x.ModeratorSharedDocuments
+	}
+	return false
+}
+
+type RoleDocuments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleUid   string   `protobuf:"bytes,1,opt,name=role_uid,json=roleUid,proto3" json:"role_uid,omitempty"`
+	Documents []string `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *RoleDocuments) Reset() {
+	*x = RoleDocuments{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDocuments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDocuments) ProtoMessage() {}
+
+func (x *RoleDocuments) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDocuments.ProtoReflect.Descriptor instead.
+func (*RoleDocuments) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoleDocuments) GetRoleUid() string {
+	if x != nil {
+		return x.RoleUid
+	}
+	return ""
+}
+
+func (x *RoleDocuments) GetDocuments() []string {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+// 角色在讨论中允许使用的工具
+type RoleTools struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleUid string   `protobuf:"bytes,1,opt,name=role_uid,json=roleUid,proto3" json:"role_uid,omitempty"`
+	Tools   []string `protobuf:"bytes,2,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *RoleTools) Reset() {
+	*x = RoleTools{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleTools) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleTools) ProtoMessage() {}
+
+func (x *RoleTools) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleTools.ProtoReflect.Descriptor instead.
+func (*RoleTools) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoleTools) GetRoleUid() string {
+	if x != nil {
+		return x.RoleUid
+	}
+	return ""
+}
+
+func (x *RoleTools) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
 type CreateTopicReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -408,7 +615,7 @@ type CreateTopicReply struct {
 
 func (x *CreateTopicReply) Reset() {
 	*x = CreateTopicReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[5]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +627,7 @@ func (x *CreateTopicReply) String() string {
 func (*CreateTopicReply) ProtoMessage() {}
 
 func (x *CreateTopicReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[5]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +640,7 @@ func (x *CreateTopicReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTopicReply.ProtoReflect.Descriptor instead.
 func (*CreateTopicReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{5}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateTopicReply) GetUid() string {
@@ -453,7 +660,7 @@ type DeleteTopicRequest struct {
 
 func (x *DeleteTopicRequest) Reset() {
 	*x = DeleteTopicRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[6]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +672,7 @@ func (x *DeleteTopicRequest) String() string {
 func (*DeleteTopicRequest) ProtoMessage() {}
 
 func (x *DeleteTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[6]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +685,7 @@ func (x *DeleteTopicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTopicRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTopicRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{6}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteTopicRequest) GetUid() string {
@@ -498,7 +705,7 @@ type DeleteTopicReply struct {
 
 func (x *DeleteTopicReply) Reset() {
 	*x = DeleteTopicReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[7]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +717,7 @@ func (x *DeleteTopicReply) String() string {
 func (*DeleteTopicReply) ProtoMessage() {}
 
 func (x *DeleteTopicReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[7]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +730,7 @@ func (x *DeleteTopicReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTopicReply.ProtoReflect.Descriptor instead.
 func (*DeleteTopicReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{7}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteTopicReply) GetMessage() string {
@@ -544,7 +751,7 @@ type StartTopicRequest struct {
 
 func (x *StartTopicRequest) Reset() {
 	*x = StartTopicRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[8]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +763,7 @@ func (x *StartTopicRequest) String() string {
 func (*StartTopicRequest) ProtoMessage() {}
 
 func (x *StartTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[8]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +776,7 @@ func (x *StartTopicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTopicRequest.ProtoReflect.Descriptor instead.
 func (*StartTopicRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{8}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StartTopicRequest) GetTopicId() string {
@@ -596,7 +803,7 @@ type StartTopicReply struct {
 
 func (x *StartTopicReply) Reset() {
 	*x = StartTopicReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[9]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +815,7 @@ func (x *StartTopicReply) String() string {
 func (*StartTopicReply) ProtoMessage() {}
 
 func (x *StartTopicReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[9]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +828,7 @@ func (x *StartTopicReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTopicReply.ProtoReflect.Descriptor instead.
 func (*StartTopicReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{9}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StartTopicReply) GetMessage() string {
@@ -641,7 +848,7 @@ type StopTopicRequest struct {
 
 func (x *StopTopicRequest) Reset() {
 	*x = StopTopicRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[10]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -653,7 +860,7 @@ func (x *StopTopicRequest) String() string {
 func (*StopTopicRequest) ProtoMessage() {}
 
 func (x *StopTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[10]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +873,7 @@ func (x *StopTopicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopTopicRequest.ProtoReflect.Descriptor instead.
 func (*StopTopicRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{10}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StopTopicRequest) GetTopicId() string {
@@ -686,7 +893,7 @@ type StopTopicReply struct {
 
 func (x *StopTopicReply) Reset() {
 	*x = StopTopicReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[11]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +905,7 @@ func (x *StopTopicReply) String() string {
 func (*StopTopicReply) ProtoMessage() {}
 
 func (x *StopTopicReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[11]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +918,7 @@ func (x *StopTopicReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopTopicReply.ProtoReflect.Descriptor instead.
 func (*StopTopicReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{11}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StopTopicReply) GetMessage() string {
@@ -721,34 +928,31 @@ func (x *StopTopicReply) GetMessage() string {
 	return ""
 }
 
-type StreamOutputReply struct {
+type InterjectTopicRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Content:
-	//
-	//	*StreamOutputReply_Reasoning
-	//	*StreamOutputReply_Text
-	Content isStreamOutputReply_Content `protobuf_oneof:"content"`
-	RoleUID string                      `protobuf:"bytes,3,opt,name=roleUID,proto3" json:"roleUID,omitempty"`
+	TopicId string `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Phone   string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
 }
 
-func (x *StreamOutputReply) Reset() {
-	*x = StreamOutputReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[12]
+func (x *InterjectTopicRequest) Reset() {
+	*x = InterjectTopicRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamOutputReply) String() string {
+func (x *InterjectTopicRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamOutputReply) ProtoMessage() {}
+func (*InterjectTopicRequest) ProtoMessage() {}
 
-func (x *StreamOutputReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[12]
+func (x *InterjectTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,78 +963,55 @@ func (x *StreamOutputReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamOutputReply.ProtoReflect.Descriptor instead.
-func (*StreamOutputReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{12}
-}
-
-func (m *StreamOutputReply) GetContent() isStreamOutputReply_Content {
-	if m != nil {
-		return m.Content
-	}
-	return nil
+// Deprecated: Use InterjectTopicRequest.ProtoReflect.Descriptor instead.
+func (*InterjectTopicRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *StreamOutputReply) GetReasoning() string {
-	if x, ok := x.GetContent().(*StreamOutputReply_Reasoning); ok {
-		return x.Reasoning
+func (x *InterjectTopicRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
 	}
 	return ""
 }
 
-func (x *StreamOutputReply) GetText() string {
-	if x, ok := x.GetContent().(*StreamOutputReply_Text); ok {
-		return x.Text
+func (x *InterjectTopicRequest) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *StreamOutputReply) GetRoleUID() string {
+func (x *InterjectTopicRequest) GetPhone() string {
 	if x != nil {
-		return x.RoleUID
+		return x.Phone
 	}
 	return ""
 }
 
-type isStreamOutputReply_Content interface {
-	isStreamOutputReply_Content()
-}
-
-type StreamOutputReply_Reasoning struct {
-	Reasoning string `protobuf:"bytes,1,opt,name=reasoning,proto3,oneof"`
-}
-
-type StreamOutputReply_Text struct {
-	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
-}
-
-func (*StreamOutputReply_Reasoning) isStreamOutputReply_Content() {}
-
-func (*StreamOutputReply_Text) isStreamOutputReply_Content() {}
-
-type GetTopicsMetadataRequest struct {
+type InterjectTopicReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetTopicsMetadataRequest) Reset() {
-	*x = GetTopicsMetadataRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[13]
+func (x *InterjectTopicReply) Reset() {
+	*x = InterjectTopicReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopicsMetadataRequest) String() string {
+func (x *InterjectTopicReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicsMetadataRequest) ProtoMessage() {}
+func (*InterjectTopicReply) ProtoMessage() {}
 
-func (x *GetTopicsMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[13]
+func (x *InterjectTopicReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,9 +1022,142 @@ func (x *GetTopicsMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicsMetadataRequest.ProtoReflect.Descriptor instead.
-func (*GetTopicsMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use InterjectTopicReply.ProtoReflect.Descriptor instead.
+func (*InterjectTopicReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InterjectTopicReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StreamOutputReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//	*StreamOutputReply_Reasoning
+	//	*StreamOutputReply_Text
+	Content isStreamOutputReply_Content `protobuf_oneof:"content"`
+	RoleUID string                      `protobuf:"bytes,3,opt,name=roleUID,proto3" json:"roleUID,omitempty"`
+}
+
+func (x *StreamOutputReply) Reset() {
+	*x = StreamOutputReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOutputReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOutputReply) ProtoMessage() {}
+
+func (x *StreamOutputReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOutputReply.ProtoReflect.Descriptor instead.
+func (*StreamOutputReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *StreamOutputReply) GetContent() isStreamOutputReply_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *StreamOutputReply) GetReasoning() string {
+	if x, ok := x.GetContent().(*StreamOutputReply_Reasoning); ok {
+		return x.Reasoning
+	}
+	return ""
+}
+
+func (x *StreamOutputReply) GetText() string {
+	if x, ok := x.GetContent().(*StreamOutputReply_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *StreamOutputReply) GetRoleUID() string {
+	if x != nil {
+		return x.RoleUID
+	}
+	return ""
+}
+
+type isStreamOutputReply_Content interface {
+	isStreamOutputReply_Content()
+}
+
+type StreamOutputReply_Reasoning struct {
+	Reasoning string `protobuf:"bytes,1,opt,name=reasoning,proto3,oneof"`
+}
+
+type StreamOutputReply_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+func (*StreamOutputReply_Reasoning) isStreamOutputReply_Content() {}
+
+func (*StreamOutputReply_Text) isStreamOutputReply_Content() {}
+
+type GetTopicsMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	// 为空时列出个人的资源，否则列出该工作区的资源
+	WorkspaceUid string `protobuf:"bytes,2,opt,name=workspaceUid,proto3" json:"workspaceUid,omitempty"`
+}
+
+func (x *GetTopicsMetadataRequest) Reset() {
+	*x = GetTopicsMetadataRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicsMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicsMetadataRequest) ProtoMessage() {}
+
+func (x *GetTopicsMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicsMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetTopicsMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetTopicsMetadataRequest) GetPhone() string {
@@ -853,6 +1167,13 @@ func (x *GetTopicsMetadataRequest) GetPhone() string {
 	return ""
 }
 
+func (x *GetTopicsMetadataRequest) GetWorkspaceUid() string {
+	if x != nil {
+		return x.WorkspaceUid
+	}
+	return ""
+}
+
 type GetTopicsMetadataReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -863,7 +1184,7 @@ type GetTopicsMetadataReply struct {
 
 func (x *GetTopicsMetadataReply) Reset() {
 	*x = GetTopicsMetadataReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[14]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -875,7 +1196,7 @@ func (x *GetTopicsMetadataReply) String() string {
 func (*GetTopicsMetadataReply) ProtoMessage() {}
 
 func (x *GetTopicsMetadataReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[14]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1209,7 @@ func (x *GetTopicsMetadataReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopicsMetadataReply.ProtoReflect.Descriptor instead.
 func (*GetTopicsMetadataReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{14}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetTopicsMetadataReply) GetTopics() []*TopicMetadata {
@@ -909,7 +1230,7 @@ type GetTopicRequest struct {
 
 func (x *GetTopicRequest) Reset() {
 	*x = GetTopicRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[15]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1242,7 @@ func (x *GetTopicRequest) String() string {
 func (*GetTopicRequest) ProtoMessage() {}
 
 func (x *GetTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[15]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1255,7 @@ func (x *GetTopicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopicRequest.ProtoReflect.Descriptor instead.
 func (*GetTopicRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{15}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetTopicRequest) GetUid() string {
@@ -954,7 +1275,7 @@ type GetTopicReply struct {
 
 func (x *GetTopicReply) Reset() {
 	*x = GetTopicReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[16]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -966,7 +1287,7 @@ func (x *GetTopicReply) String() string {
 func (*GetTopicReply) ProtoMessage() {}
 
 func (x *GetTopicReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[16]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1300,7 @@ func (x *GetTopicReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopicReply.ProtoReflect.Descriptor instead.
 func (*GetTopicReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{16}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetTopicReply) GetTopic() *Topic {
@@ -1002,7 +1323,7 @@ type UploadDocumentRequest struct {
 
 func (x *UploadDocumentRequest) Reset() {
 	*x = UploadDocumentRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[17]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1014,7 +1335,7 @@ func (x *UploadDocumentRequest) String() string {
 func (*UploadDocumentRequest) ProtoMessage() {}
 
 func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[17]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1348,7 @@ func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
 func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{17}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UploadDocumentRequest) GetFilename() string {
@@ -1068,7 +1389,7 @@ type UploadDocumentReply struct {
 
 func (x *UploadDocumentReply) Reset() {
 	*x = UploadDocumentReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[18]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1080,7 +1401,7 @@ func (x *UploadDocumentReply) String() string {
 func (*UploadDocumentReply) ProtoMessage() {}
 
 func (x *UploadDocumentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[18]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,7 +1414,7 @@ func (x *UploadDocumentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadDocumentReply.ProtoReflect.Descriptor instead.
 func (*UploadDocumentReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{18}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UploadDocumentReply) GetMessage() string {
@@ -1109,11 +1430,13 @@ type GetDocumentsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	// 为空时列出个人的资源，否则列出该工作区的资源
+	WorkspaceUid string `protobuf:"bytes,2,opt,name=workspaceUid,proto3" json:"workspaceUid,omitempty"`
 }
 
 func (x *GetDocumentsRequest) Reset() {
 	*x = GetDocumentsRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[19]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1125,7 +1448,7 @@ func (x *GetDocumentsRequest) String() string {
 func (*GetDocumentsRequest) ProtoMessage() {}
 
 func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[19]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,7 +1461,7 @@ func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*GetDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{19}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetDocumentsRequest) GetPhone() string {
@@ -1148,6 +1471,13 @@ func (x *GetDocumentsRequest) GetPhone() string {
 	return ""
 }
 
+func (x *GetDocumentsRequest) GetWorkspaceUid() string {
+	if x != nil {
+		return x.WorkspaceUid
+	}
+	return ""
+}
+
 type GetDocumentsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1158,7 +1488,7 @@ type GetDocumentsReply struct {
 
 func (x *GetDocumentsReply) Reset() {
 	*x = GetDocumentsReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[20]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1170,7 +1500,7 @@ func (x *GetDocumentsReply) String() string {
 func (*GetDocumentsReply) ProtoMessage() {}
 
 func (x *GetDocumentsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[20]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +1513,7 @@ func (x *GetDocumentsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsReply.ProtoReflect.Descriptor instead.
 func (*GetDocumentsReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{20}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetDocumentsReply) GetDocuments() []*Document {
@@ -1206,7 +1536,7 @@ type AddMCPServerReqeust struct {
 
 func (x *AddMCPServerReqeust) Reset() {
 	*x = AddMCPServerReqeust{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[21]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1218,7 +1548,7 @@ func (x *AddMCPServerReqeust) String() string {
 func (*AddMCPServerReqeust) ProtoMessage() {}
 
 func (x *AddMCPServerReqeust) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[21]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1561,7 @@ func (x *AddMCPServerReqeust) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMCPServerReqeust.ProtoReflect.Descriptor instead.
 func (*AddMCPServerReqeust) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{21}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AddMCPServerReqeust) GetName() string {
@@ -1272,7 +1602,7 @@ type AddMCPServerReply struct {
 
 func (x *AddMCPServerReply) Reset() {
 	*x = AddMCPServerReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[22]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1284,7 +1614,7 @@ func (x *AddMCPServerReply) String() string {
 func (*AddMCPServerReply) ProtoMessage() {}
 
 func (x *AddMCPServerReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[22]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,7 +1627,7 @@ func (x *AddMCPServerReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMCPServerReply.ProtoReflect.Descriptor instead.
 func (*AddMCPServerReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{22}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AddMCPServerReply) GetMessage() string {
@@ -1313,11 +1643,13 @@ type GetMCPServersRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	// 为空时列出个人的资源，否则列出该工作区的资源
+	WorkspaceUid string `protobuf:"bytes,2,opt,name=workspaceUid,proto3" json:"workspaceUid,omitempty"`
 }
 
 func (x *GetMCPServersRequest) Reset() {
 	*x = GetMCPServersRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[23]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1329,7 +1661,7 @@ func (x *GetMCPServersRequest) String() string {
 func (*GetMCPServersRequest) ProtoMessage() {}
 
 func (x *GetMCPServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[23]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,7 +1674,7 @@ func (x *GetMCPServersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMCPServersRequest.ProtoReflect.Descriptor instead.
 func (*GetMCPServersRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{23}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetMCPServersRequest) GetPhone() string {
@@ -1352,6 +1684,13 @@ func (x *GetMCPServersRequest) GetPhone() string {
 	return ""
 }
 
+func (x *GetMCPServersRequest) GetWorkspaceUid() string {
+	if x != nil {
+		return x.WorkspaceUid
+	}
+	return ""
+}
+
 type MCPServer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1367,7 +1706,7 @@ type MCPServer struct {
 
 func (x *MCPServer) Reset() {
 	*x = MCPServer{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[24]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1379,7 +1718,7 @@ func (x *MCPServer) String() string {
 func (*MCPServer) ProtoMessage() {}
 
 func (x *MCPServer) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[24]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1731,7 @@ func (x *MCPServer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MCPServer.ProtoReflect.Descriptor instead.
 func (*MCPServer) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{24}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MCPServer) GetUid() string {
@@ -1447,7 +1786,7 @@ type GetMCPServersReply struct {
 
 func (x *GetMCPServersReply) Reset() {
 	*x = GetMCPServersReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[25]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +1798,7 @@ func (x *GetMCPServersReply) String() string {
 func (*GetMCPServersReply) ProtoMessage() {}
 
 func (x *GetMCPServersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[25]
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +1811,7 @@ func (x *GetMCPServersReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMCPServersReply.ProtoReflect.Descriptor instead.
 func (*GetMCPServersReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{25}
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetMCPServersReply) GetServers() []*MCPServer {
@@ -1482,29 +1821,30 @@ func (x *GetMCPServersReply) GetServers() []*MCPServer {
 	return nil
 }
 
-type CheckMCPServerHealthReqeust struct {
+type MCPTool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *CheckMCPServerHealthReqeust) Reset() {
-	*x = CheckMCPServerHealthReqeust{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[26]
+func (x *MCPTool) Reset() {
+	*x = MCPTool{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckMCPServerHealthReqeust) String() string {
+func (x *MCPTool) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckMCPServerHealthReqeust) ProtoMessage() {}
+func (*MCPTool) ProtoMessage() {}
 
-func (x *CheckMCPServerHealthReqeust) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[26]
+func (x *MCPTool) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,42 +1855,49 @@ func (x *CheckMCPServerHealthReqeust) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckMCPServerHealthReqeust.ProtoReflect.Descriptor instead.
-func (*CheckMCPServerHealthReqeust) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MCPTool.ProtoReflect.Descriptor instead.
+func (*MCPTool) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CheckMCPServerHealthReqeust) GetUrl() string {
+func (x *MCPTool) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
 	return ""
 }
 
-// 0: unhealthy, 1: healthy
-type CheckMCPServerHealthReply struct {
+func (x *MCPTool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetMCPServerToolsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Health int32 `protobuf:"varint,1,opt,name=health,proto3" json:"health,omitempty"`
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
 }
 
-func (x *CheckMCPServerHealthReply) Reset() {
-	*x = CheckMCPServerHealthReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[27]
+func (x *GetMCPServerToolsRequest) Reset() {
+	*x = GetMCPServerToolsRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckMCPServerHealthReply) String() string {
+func (x *GetMCPServerToolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckMCPServerHealthReply) ProtoMessage() {}
+func (*GetMCPServerToolsRequest) ProtoMessage() {}
 
-func (x *CheckMCPServerHealthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[27]
+func (x *GetMCPServerToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,42 +1908,1791 @@ func (x *CheckMCPServerHealthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckMCPServerHealthReply.ProtoReflect.Descriptor instead.
-func (*CheckMCPServerHealthReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetMCPServerToolsRequest.ProtoReflect.Descriptor instead.
+func (*GetMCPServerToolsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMCPServerToolsRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GetMCPServerToolsRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type GetMCPServerToolsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tools []*MCPTool `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *GetMCPServerToolsReply) Reset() {
+	*x = GetMCPServerToolsReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMCPServerToolsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMCPServerToolsReply) ProtoMessage() {}
+
+func (x *GetMCPServerToolsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMCPServerToolsReply.ProtoReflect.Descriptor instead.
+func (*GetMCPServerToolsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetMCPServerToolsReply) GetTools() []*MCPTool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type SetTopicToolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId    string       `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+	Phone      string       `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	McpServers []string     `protobuf:"bytes,3,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	RoleTools  []*RoleTools `protobuf:"bytes,4,rep,name=role_tools,json=roleTools,proto3" json:"role_tools,omitempty"`
+}
+
+func (x *SetTopicToolsRequest) Reset() {
+	*x = SetTopicToolsRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTopicToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTopicToolsRequest) ProtoMessage() {}
+
+func (x *SetTopicToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTopicToolsRequest.ProtoReflect.Descriptor instead.
+func (*SetTopicToolsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetTopicToolsRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *SetTopicToolsRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SetTopicToolsRequest) GetMcpServers() []string {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *SetTopicToolsRequest) GetRoleTools() []*RoleTools {
+	if x != nil {
+		return x.RoleTools
+	}
+	return nil
+}
+
+type SetTopicToolsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetTopicToolsReply) Reset() {
+	*x = SetTopicToolsReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTopicToolsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTopicToolsReply) ProtoMessage() {}
+
+func (x *SetTopicToolsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTopicToolsReply.ProtoReflect.Descriptor instead.
+func (*SetTopicToolsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetTopicToolsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// role_documents 整体替换讨论中各角色的私有资料，共享资料不变
+type SetTopicDocumentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId                  string           `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+	Phone                    string           `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	RoleDocuments            []*RoleDocuments `protobuf:"bytes,3,rep,name=role_documents,json=roleDocuments,proto3" json:"role_documents,omitempty"`
+	ModeratorSharedDocuments bool             `protobuf:"varint,4,opt,name=moderator_shared_documents,json=moderatorSharedDocuments,proto3" json:"moderator_shared_documents,omitempty"`
+}
+
+func (x *SetTopicDocumentsRequest) Reset() {
+	*x = SetTopicDocumentsRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTopicDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTopicDocumentsRequest) ProtoMessage() {}
+
+func (x *SetTopicDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTopicDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*SetTopicDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTopicDocumentsRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *SetTopicDocumentsRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SetTopicDocumentsRequest) GetRoleDocuments() []*RoleDocuments {
+	if x != nil {
+		return x.RoleDocuments
+	}
+	return nil
+}
+
+func (x *SetTopicDocumentsRequest) GetModeratorSharedDocuments() bool {
+	if x != nil {
+		return x.ModeratorSharedDocuments
+	}
+	return false
+}
+
+type SetTopicDocumentsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetTopicDocumentsReply) Reset() {
+	*x = SetTopicDocumentsReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTopicDocumentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTopicDocumentsReply) ProtoMessage() {}
+
+func (x *SetTopicDocumentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTopicDocumentsReply.ProtoReflect.Descriptor instead.
+func (*SetTopicDocumentsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetTopicDocumentsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CheckMCPServerHealthReqeust struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *CheckMCPServerHealthReqeust) Reset() {
+	*x = CheckMCPServerHealthReqeust{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMCPServerHealthReqeust) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMCPServerHealthReqeust) ProtoMessage() {}
+
+func (x *CheckMCPServerHealthReqeust) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMCPServerHealthReqeust.ProtoReflect.Descriptor instead.
+func (*CheckMCPServerHealthReqeust) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckMCPServerHealthReqeust) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// 0: unhealthy, 1: healthy
+type CheckMCPServerHealthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Health int32 `protobuf:"varint,1,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *CheckMCPServerHealthReply) Reset() {
+	*x = CheckMCPServerHealthReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMCPServerHealthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMCPServerHealthReply) ProtoMessage() {}
+
+func (x *CheckMCPServerHealthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMCPServerHealthReply.ProtoReflect.Descriptor instead.
+func (*CheckMCPServerHealthReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckMCPServerHealthReply) GetHealth() int32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+type DeleteMCPServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *DeleteMCPServerRequest) Reset() {
+	*x = DeleteMCPServerRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMCPServerRequest) ProtoMessage() {}
+
+func (x *DeleteMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteMCPServerRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *DeleteMCPServerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type DeleteMCPServerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteMCPServerReply) Reset() {
+	*x = DeleteMCPServerReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMCPServerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMCPServerReply) ProtoMessage() {}
+
+func (x *DeleteMCPServerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMCPServerReply.ProtoReflect.Descriptor instead.
+func (*DeleteMCPServerReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteMCPServerReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EnableMCPServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Url   string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Phone string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *EnableMCPServerRequest) Reset() {
+	*x = EnableMCPServerRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableMCPServerRequest) ProtoMessage() {}
+
+func (x *EnableMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*EnableMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EnableMCPServerRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *EnableMCPServerRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EnableMCPServerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type EnableMCPServerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *EnableMCPServerReply) Reset() {
+	*x = EnableMCPServerReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableMCPServerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableMCPServerReply) ProtoMessage() {}
+
+func (x *EnableMCPServerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableMCPServerReply.ProtoReflect.Descriptor instead.
+func (*EnableMCPServerReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *EnableMCPServerReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type DisableMCPServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *DisableMCPServerRequest) Reset() {
+	*x = DisableMCPServerRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMCPServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMCPServerRequest) ProtoMessage() {}
+
+func (x *DisableMCPServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMCPServerRequest.ProtoReflect.Descriptor instead.
+func (*DisableMCPServerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DisableMCPServerRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *DisableMCPServerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type DisableMCPServerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DisableMCPServerReply) Reset() {
+	*x = DisableMCPServerReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMCPServerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMCPServerReply) ProtoMessage() {}
+
+func (x *DisableMCPServerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMCPServerReply.ProtoReflect.Descriptor instead.
+func (*DisableMCPServerReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DisableMCPServerReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UsageSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromptTokens     int64   `protobuf:"varint,1,opt,name=promptTokens,proto3" json:"promptTokens,omitempty"`
+	CompletionTokens int64   `protobuf:"varint,2,opt,name=completionTokens,proto3" json:"completionTokens,omitempty"`
+	Cost             float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *UsageSummary) Reset() {
+	*x = UsageSummary{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSummary) ProtoMessage() {}
+
+func (x *UsageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSummary.ProtoReflect.Descriptor instead.
+func (*UsageSummary) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UsageSummary) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type ModelUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model string        `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Kind  string        `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Usage *UsageSummary `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *ModelUsage) Reset() {
+	*x = ModelUsage{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelUsage) ProtoMessage() {}
+
+func (x *ModelUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelUsage.ProtoReflect.Descriptor instead.
+func (*ModelUsage) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ModelUsage) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ModelUsage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ModelUsage) GetUsage() *UsageSummary {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetUsageRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type GetUsageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Daily             *UsageSummary `protobuf:"bytes,1,opt,name=daily,proto3" json:"daily,omitempty"`
+	Monthly           *UsageSummary `protobuf:"bytes,2,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	Models            []*ModelUsage `protobuf:"bytes,3,rep,name=models,proto3" json:"models,omitempty"`
+	DailyTokenQuota   int64         `protobuf:"varint,4,opt,name=dailyTokenQuota,proto3" json:"dailyTokenQuota,omitempty"`
+	MonthlyTokenQuota int64         `protobuf:"varint,5,opt,name=monthlyTokenQuota,proto3" json:"monthlyTokenQuota,omitempty"`
+	DailyCostQuota    float64       `protobuf:"fixed64,6,opt,name=dailyCostQuota,proto3" json:"dailyCostQuota,omitempty"`
+	MonthlyCostQuota  float64       `protobuf:"fixed64,7,opt,name=monthlyCostQuota,proto3" json:"monthlyCostQuota,omitempty"`
+	Currency          string        `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *GetUsageReply) Reset() {
+	*x = GetUsageReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReply) ProtoMessage() {}
+
+func (x *GetUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReply.ProtoReflect.Descriptor instead.
+func (*GetUsageReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUsageReply) GetDaily() *UsageSummary {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetUsageReply) GetMonthly() *UsageSummary {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+func (x *GetUsageReply) GetModels() []*ModelUsage {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *GetUsageReply) GetDailyTokenQuota() int64 {
+	if x != nil {
+		return x.DailyTokenQuota
+	}
+	return 0
+}
+
+func (x *GetUsageReply) GetMonthlyTokenQuota() int64 {
+	if x != nil {
+		return x.MonthlyTokenQuota
+	}
+	return 0
+}
+
+func (x *GetUsageReply) GetDailyCostQuota() float64 {
+	if x != nil {
+		return x.DailyCostQuota
+	}
+	return 0
+}
+
+func (x *GetUsageReply) GetMonthlyCostQuota() float64 {
+	if x != nil {
+		return x.MonthlyCostQuota
+	}
+	return 0
+}
+
+func (x *GetUsageReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CheckQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *CheckQuotaRequest) Reset() {
+	*x = CheckQuotaRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaRequest) ProtoMessage() {}
+
+func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CheckQuotaRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type CheckQuotaReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckQuotaReply) Reset() {
+	*x = CheckQuotaReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaReply) ProtoMessage() {}
+
+func (x *CheckQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaReply.ProtoReflect.Descriptor instead.
+func (*CheckQuotaReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{50}
+}
+
+// kind 为用量类型，如 generate；estimated 表示模型未返回 usage，token 数按文本长度估算
+type RecordUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone            string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Kind             string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Model            string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	PromptTokens     int64  `protobuf:"varint,4,opt,name=promptTokens,proto3" json:"promptTokens,omitempty"`
+	CompletionTokens int64  `protobuf:"varint,5,opt,name=completionTokens,proto3" json:"completionTokens,omitempty"`
+	Estimated        bool   `protobuf:"varint,6,opt,name=estimated,proto3" json:"estimated,omitempty"`
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RecordUsageRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *RecordUsageRequest) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *RecordUsageRequest) GetEstimated() bool {
+	if x != nil {
+		return x.Estimated
+	}
+	return false
+}
+
+type RecordUsageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecordUsageReply) Reset() {
+	*x = RecordUsageReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageReply) ProtoMessage() {}
+
+func (x *RecordUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageReply.ProtoReflect.Descriptor instead.
+func (*RecordUsageReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{52}
+}
+
+type EstimateTopicCostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId  string `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+	Speeches int32  `protobuf:"varint,2,opt,name=speeches,proto3" json:"speeches,omitempty"`
+	Phone    string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *EstimateTopicCostRequest) Reset() {
+	*x = EstimateTopicCostRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateTopicCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateTopicCostRequest) ProtoMessage() {}
+
+func (x *EstimateTopicCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateTopicCostRequest.ProtoReflect.Descriptor instead.
+func (*EstimateTopicCostRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *EstimateTopicCostRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *EstimateTopicCostRequest) GetSpeeches() int32 {
+	if x != nil {
+		return x.Speeches
+	}
+	return 0
+}
+
+func (x *EstimateTopicCostRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type EstimateTopicCostReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Speeches         int32   `protobuf:"varint,1,opt,name=speeches,proto3" json:"speeches,omitempty"`
+	PromptTokens     int64   `protobuf:"varint,2,opt,name=promptTokens,proto3" json:"promptTokens,omitempty"`
+	CompletionTokens int64   `protobuf:"varint,3,opt,name=completionTokens,proto3" json:"completionTokens,omitempty"`
+	Cost             float64 `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Currency         string  `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *EstimateTopicCostReply) Reset() {
+	*x = EstimateTopicCostReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateTopicCostReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateTopicCostReply) ProtoMessage() {}
+
+func (x *EstimateTopicCostReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateTopicCostReply.ProtoReflect.Descriptor instead.
+func (*EstimateTopicCostReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *EstimateTopicCostReply) GetSpeeches() int32 {
+	if x != nil {
+		return x.Speeches
+	}
+	return 0
+}
+
+func (x *EstimateTopicCostReply) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *EstimateTopicCostReply) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *EstimateTopicCostReply) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *EstimateTopicCostReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type TestCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *TestCredentialRequest) Reset() {
+	*x = TestCredentialRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCredentialRequest) ProtoMessage() {}
+
+func (x *TestCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCredentialRequest.ProtoReflect.Descriptor instead.
+func (*TestCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TestCredentialRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TestCredentialRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type TestCredentialReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok        bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LatencyMs int64  `protobuf:"varint,3,opt,name=latencyMs,proto3" json:"latencyMs,omitempty"`
+}
+
+func (x *TestCredentialReply) Reset() {
+	*x = TestCredentialReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCredentialReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCredentialReply) ProtoMessage() {}
+
+func (x *TestCredentialReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCredentialReply.ProtoReflect.Descriptor instead.
+func (*TestCredentialReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TestCredentialReply) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TestCredentialReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TestCredentialReply) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type RunRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleUid     string `protobuf:"bytes,1,opt,name=roleUid,proto3" json:"roleUid,omitempty"`
+	RoleVersion int32  `protobuf:"varint,2,opt,name=roleVersion,proto3" json:"roleVersion,omitempty"`
+	RoleType    string `protobuf:"bytes,3,opt,name=roleType,proto3" json:"roleType,omitempty"`
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Avatar      string `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Model       string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+}
+
+func (x *RunRole) Reset() {
+	*x = RunRole{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRole) ProtoMessage() {}
+
+func (x *RunRole) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRole.ProtoReflect.Descriptor instead.
+func (*RunRole) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RunRole) GetRoleUid() string {
+	if x != nil {
+		return x.RoleUid
+	}
+	return ""
+}
+
+func (x *RunRole) GetRoleVersion() int32 {
+	if x != nil {
+		return x.RoleVersion
+	}
+	return 0
+}
+
+func (x *RunRole) GetRoleType() string {
+	if x != nil {
+		return x.RoleType
+	}
+	return ""
+}
+
+func (x *RunRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RunRole) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *RunRole) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type TopicRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string     `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	StartedAt int64      `protobuf:"varint,2,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	EndedAt   int64      `protobuf:"varint,3,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	Roles     []*RunRole `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *TopicRun) Reset() {
+	*x = TopicRun{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicRun) ProtoMessage() {}
+
+func (x *TopicRun) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicRun.ProtoReflect.Descriptor instead.
+func (*TopicRun) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TopicRun) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TopicRun) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *TopicRun) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *TopicRun) GetRoles() []*RunRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GetTopicRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId string `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+	Phone   string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *GetTopicRunsRequest) Reset() {
+	*x = GetTopicRunsRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicRunsRequest) ProtoMessage() {}
+
+func (x *GetTopicRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicRunsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopicRunsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetTopicRunsRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *GetTopicRunsRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type GetTopicRunsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []*TopicRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *GetTopicRunsReply) Reset() {
+	*x = GetTopicRunsReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicRunsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicRunsReply) ProtoMessage() {}
+
+func (x *GetTopicRunsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicRunsReply.ProtoReflect.Descriptor instead.
+func (*GetTopicRunsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTopicRunsReply) GetRuns() []*TopicRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// 未保存的角色草稿，密钥可以直接填写或引用凭证
+type PreviewDraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Provider     string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model        string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	CredentialId string `protobuf:"bytes,5,opt,name=credentialId,proto3" json:"credentialId,omitempty"`
+	ApiKey       string `protobuf:"bytes,6,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+}
+
+func (x *PreviewDraft) Reset() {
+	*x = PreviewDraft{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDraft) ProtoMessage() {}
+
+func (x *PreviewDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDraft.ProtoReflect.Descriptor instead.
+func (*PreviewDraft) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PreviewDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *CheckMCPServerHealthReply) GetHealth() int32 {
+func (x *PreviewDraft) GetDescription() string {
 	if x != nil {
-		return x.Health
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-type DeleteMCPServerRequest struct {
+func (x *PreviewDraft) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PreviewDraft) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *PreviewDraft) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *PreviewDraft) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type PreviewRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
-}
-
-func (x *DeleteMCPServerRequest) Reset() {
-	*x = DeleteMCPServerRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[28]
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	// roleUid 和 draft 二选一，roleUid 可以是系统角色
+	RoleUid string        `protobuf:"bytes,2,opt,name=roleUid,proto3" json:"roleUid,omitempty"`
+	Draft   *PreviewDraft `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+	Prompt  string        `protobuf:"bytes,4,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// participant 使用讨论参与者的系统提示词，persona 只使用角色描述，默认 participant
+	Mode     string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	UseTools bool   `protobuf:"varint,6,opt,name=useTools,proto3" json:"useTools,omitempty"`
+}
+
+func (x *PreviewRoleRequest) Reset() {
+	*x = PreviewRoleRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMCPServerRequest) String() string {
+func (x *PreviewRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMCPServerRequest) ProtoMessage() {}
+func (*PreviewRoleRequest) ProtoMessage() {}
 
-func (x *DeleteMCPServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[28]
+func (x *PreviewRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,48 +3703,78 @@ func (x *DeleteMCPServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMCPServerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMCPServerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PreviewRoleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRoleRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DeleteMCPServerRequest) GetUid() string {
+func (x *PreviewRoleRequest) GetPhone() string {
 	if x != nil {
-		return x.Uid
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *DeleteMCPServerRequest) GetPhone() string {
+func (x *PreviewRoleRequest) GetRoleUid() string {
 	if x != nil {
-		return x.Phone
+		return x.RoleUid
 	}
 	return ""
 }
 
-type DeleteMCPServerReply struct {
+func (x *PreviewRoleRequest) GetDraft() *PreviewDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *PreviewRoleRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *PreviewRoleRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PreviewRoleRequest) GetUseTools() bool {
+	if x != nil {
+		return x.UseTools
+	}
+	return false
+}
+
+type PreviewRoleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// reasoning、text、tool 或 end
+	ContentType string `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content     string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *DeleteMCPServerReply) Reset() {
-	*x = DeleteMCPServerReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[29]
+func (x *PreviewRoleReply) Reset() {
+	*x = PreviewRoleReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMCPServerReply) String() string {
+func (x *PreviewRoleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMCPServerReply) ProtoMessage() {}
+func (*PreviewRoleReply) ProtoMessage() {}
 
-func (x *DeleteMCPServerReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[29]
+func (x *PreviewRoleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,43 +3785,49 @@ func (x *DeleteMCPServerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMCPServerReply.ProtoReflect.Descriptor instead.
-func (*DeleteMCPServerReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PreviewRoleReply.ProtoReflect.Descriptor instead.
+func (*PreviewRoleReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteMCPServerReply) GetMessage() string {
+func (x *PreviewRoleReply) GetContentType() string {
 	if x != nil {
-		return x.Message
+		return x.ContentType
 	}
 	return ""
 }
 
-type EnableMCPServerRequest struct {
+func (x *PreviewRoleReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AdminGetUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Url   string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Phone string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	// 被查看的用户或工作区所有者标识
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
 }
 
-func (x *EnableMCPServerRequest) Reset() {
-	*x = EnableMCPServerRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[30]
+func (x *AdminGetUsageRequest) Reset() {
+	*x = AdminGetUsageRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnableMCPServerRequest) String() string {
+func (x *AdminGetUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableMCPServerRequest) ProtoMessage() {}
+func (*AdminGetUsageRequest) ProtoMessage() {}
 
-func (x *EnableMCPServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[30]
+func (x *AdminGetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,55 +3838,90 @@ func (x *EnableMCPServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableMCPServerRequest.ProtoReflect.Descriptor instead.
-func (*EnableMCPServerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use AdminGetUsageRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *EnableMCPServerRequest) GetUid() string {
+func (x *AdminGetUsageRequest) GetPhone() string {
 	if x != nil {
-		return x.Uid
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *EnableMCPServerRequest) GetUrl() string {
+type AdminGetRunningTopicsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *AdminGetRunningTopicsRequest) Reset() {
+	*x = AdminGetRunningTopicsRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetRunningTopicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetRunningTopicsRequest) ProtoMessage() {}
+
+func (x *AdminGetRunningTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[65]
 	if x != nil {
-		return x.Url
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EnableMCPServerRequest) GetPhone() string {
+// Deprecated: Use AdminGetRunningTopicsRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetRunningTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AdminGetRunningTopicsRequest) GetPhone() string {
 	if x != nil {
 		return x.Phone
 	}
 	return ""
 }
 
-type EnableMCPServerReply struct {
+type RunningTopic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	TopicId   string `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+	RunUid    string `protobuf:"bytes,2,opt,name=runUid,proto3" json:"runUid,omitempty"`
+	Phone     string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Content   string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	StartedAt int64  `protobuf:"varint,5,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
 }
 
-func (x *EnableMCPServerReply) Reset() {
-	*x = EnableMCPServerReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[31]
+func (x *RunningTopic) Reset() {
+	*x = RunningTopic{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnableMCPServerReply) String() string {
+func (x *RunningTopic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableMCPServerReply) ProtoMessage() {}
+func (*RunningTopic) ProtoMessage() {}
 
-func (x *EnableMCPServerReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[31]
+func (x *RunningTopic) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,42 +3932,69 @@ func (x *EnableMCPServerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableMCPServerReply.ProtoReflect.Descriptor instead.
-func (*EnableMCPServerReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use RunningTopic.ProtoReflect.Descriptor instead.
+func (*RunningTopic) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *EnableMCPServerReply) GetStatus() int32 {
+func (x *RunningTopic) GetTopicId() string {
 	if x != nil {
-		return x.Status
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *RunningTopic) GetRunUid() string {
+	if x != nil {
+		return x.RunUid
+	}
+	return ""
+}
+
+func (x *RunningTopic) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RunningTopic) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RunningTopic) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
 	}
 	return 0
 }
 
-type DisableMCPServerRequest struct {
+type AdminGetRunningTopicsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Topics []*RunningTopic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
 
-func (x *DisableMCPServerRequest) Reset() {
-	*x = DisableMCPServerRequest{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[32]
+func (x *AdminGetRunningTopicsReply) Reset() {
+	*x = AdminGetRunningTopicsReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisableMCPServerRequest) String() string {
+func (x *AdminGetRunningTopicsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableMCPServerRequest) ProtoMessage() {}
+func (*AdminGetRunningTopicsReply) ProtoMessage() {}
 
-func (x *DisableMCPServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[32]
+func (x *AdminGetRunningTopicsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,26 +4005,64 @@ func (x *DisableMCPServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableMCPServerRequest.ProtoReflect.Descriptor instead.
-func (*DisableMCPServerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AdminGetRunningTopicsReply.ProtoReflect.Descriptor instead.
+func (*AdminGetRunningTopicsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DisableMCPServerRequest) GetUid() string {
+func (x *AdminGetRunningTopicsReply) GetTopics() []*RunningTopic {
 	if x != nil {
-		return x.Uid
+		return x.Topics
 	}
-	return ""
+	return nil
 }
 
-func (x *DisableMCPServerRequest) GetPhone() string {
+type AdminStopTopicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId string `protobuf:"bytes,1,opt,name=topicId,proto3" json:"topicId,omitempty"`
+}
+
+func (x *AdminStopTopicRequest) Reset() {
+	*x = AdminStopTopicRequest{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminStopTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminStopTopicRequest) ProtoMessage() {}
+
+func (x *AdminStopTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[68]
 	if x != nil {
-		return x.Phone
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminStopTopicRequest.ProtoReflect.Descriptor instead.
+func (*AdminStopTopicRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AdminStopTopicRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
 	}
 	return ""
 }
 
-type DisableMCPServerReply struct {
+type AdminStopTopicReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1838,21 +4070,21 @@ type DisableMCPServerReply struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *DisableMCPServerReply) Reset() {
-	*x = DisableMCPServerReply{}
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[33]
+func (x *AdminStopTopicReply) Reset() {
+	*x = AdminStopTopicReply{}
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisableMCPServerReply) String() string {
+func (x *AdminStopTopicReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableMCPServerReply) ProtoMessage() {}
+func (*AdminStopTopicReply) ProtoMessage() {}
 
-func (x *DisableMCPServerReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[33]
+func (x *AdminStopTopicReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_seminar_v1_seminar_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,12 +4095,12 @@ func (x *DisableMCPServerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableMCPServerReply.ProtoReflect.Descriptor instead.
-func (*DisableMCPServerReply) Descriptor() ([]byte, []int) {
-	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use AdminStopTopicReply.ProtoReflect.Descriptor instead.
+func (*AdminStopTopicReply) Descriptor() ([]byte, []int) {
+	return file_gateway_seminar_v1_seminar_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *DisableMCPServerReply) GetMessage() string {
+func (x *AdminStopTopicReply) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -1882,7 +4114,7 @@ var file_gateway_seminar_v1_seminar_proto_rawDesc = []byte{
 	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x08, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x54,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x01, 0x0a, 0x0d, 0x54,
 	0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
 	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18,
 	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1893,266 +4125,631 @@ var file_gateway_seminar_v1_seminar_proto_rawDesc = []byte{
 	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x6f,
 	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x06,
-	0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65,
-	0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x8c, 0x02,
-	0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a,
-	0x08, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x63,
-	0x68, 0x52, 0x08, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x64,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x57, 0x0a, 0x08,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x24, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x22, 0x26, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x10, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2b, 0x0a,
-	0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x53, 0x74,
-	0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x6e, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x09, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x2f, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x73, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x8b,
-	0x01, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2f, 0x0a, 0x13,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x30, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x06, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x6c,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x55, 0x69,
+	0x64, 0x22, 0xdf, 0x03, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x65, 0x65, 0x63, 0x68, 0x52, 0x08, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f,
 	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x78, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x65, 0x75, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x41,
-	0x64, 0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x63, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x63, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65,
+	0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf7, 0x02, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x63, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x63, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x09, 0x72,
+	0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x3c, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x24,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
 	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x4d, 0x43, 0x50,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41,
-	0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x1b, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x65, 0x75, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x33, 0x0a, 0x19, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x22, 0x40, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22,
+	0x2b, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x10,
+	0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6e, 0x0a, 0x11, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1e, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x42,
+	0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64,
+	0x22, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x23, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a,
+	0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x78, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x65, 0x75, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d,
+	0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x4d,
+	0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x43,
+	0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x07,
+	0x4d, 0x43, 0x50, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6f, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x22, 0x41, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x74,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x43, 0x50, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x74,
+	0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x63, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x63, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x6f,
+	0x6c, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x6f,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12,
+	0x3e, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x3c, 0x0a, 0x1a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x18, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x32, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x2f, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x65, 0x75, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x40, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22,
+	0x2e, 0x0a, 0x14, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x41, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
 	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x43, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43,
-	0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xca,
-	0x0c, 0x0a, 0x07, 0x53, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x12, 0x6b, 0x0a, 0x0b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17,
-	0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x7d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x41,
+	0x6e, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x72, 0x0a, 0x0c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x0a, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x2c, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0xe5, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x79, 0x61, 0x6e,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x79, 0x61, 0x6e,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x26, 0x0a, 0x0e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x43,
+	0x6f, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x29, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc2,
+	0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x66, 0x0a, 0x18, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22,
+	0xb4, 0x01, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x3f, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x7d,
+	0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x75, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x75, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x45, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x75, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e,
+	0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41,
+	0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x54, 0x6f,
+	0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x65, 0x54, 0x6f,
+	0x6f, 0x6c, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x22, 0x34, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xed, 0x18, 0x0a, 0x07, 0x53,
+	0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x12, 0x6b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x73, 0x65, 0x6d,
+	0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x7d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x41,
 	0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f,
-	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x61, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x19, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
-	0x2a, 0x22, 0x16, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x2f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x6b, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f,
-	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x65,
-	0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x2e, 0x41, 0x79,
-	0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x73,
-	0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x73, 0x74, 0x6f,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x54, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x70, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22,
-	0x19, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x67, 0x0a, 0x0c, 0x41, 0x64,
-	0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x41, 0x79, 0x61,
-	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x65, 0x75, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01,
-	0x2a, 0x22, 0x10, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f,
-	0x61, 0x64, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f,
-	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x67, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x25, 0x2e, 0x41,
-	0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x65,
-	0x75, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x73, 0x65, 0x6d, 0x69,
+	0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x61, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19,
+	0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x41, 0x79, 0x61, 0x6e,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f,
+	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x67, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x6b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x73, 0x65, 0x6d, 0x69,
+	0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x46, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x09, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e,
+	0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x78,
+	0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x1f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x73, 0x65,
+	0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x41, 0x79,
+	0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x70,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x67, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4d,
+	0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x65, 0x75, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x43,
+	0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61,
+	0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x61, 0x64, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63,
+	0x70, 0x2f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x25, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x65, 0x75, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x41, 0x79, 0x61, 0x6e,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x73, 0x65, 0x6d, 0x69,
+	0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x73,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x20, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13,
+	0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x73, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
 	0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63,
-	0x70, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x73, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x41, 0x79,
-	0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x43, 0x50,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e,
-	0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x73, 0x0a,
-	0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x12, 0x20, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f,
-	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x41, 0x79, 0x61, 0x6e,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f,
-	0x6d, 0x63, 0x70, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x21, 0x5a, 0x1f, 0x41,
-	0x79, 0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x41,
+	0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d,
+	0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x73, 0x65,
+	0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
+	0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x41, 0x79,
+	0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e,
+	0x61, 0x72, 0x2f, 0x6d, 0x63, 0x70, 0x2f, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x67, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x76, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c,
+	0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x74,
+	0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x86, 0x01, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x61, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x19, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x41,
+	0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a,
+	0x22, 0x16, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x2f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x7f, 0x0a, 0x11, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
+	0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0b, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
+	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x72, 0x75,
+	0x6e, 0x73, 0x2f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x0a, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a,
+	0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e,
+	0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x26, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x2f, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x7a, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f,
+	0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01,
+	0x2a, 0x22, 0x1d, 0x2f, 0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x12, 0x78, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
+	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x21, 0x5a, 0x1f, 0x41, 0x79,
+	0x61, 0x6e, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f,
+	0x73, 0x65, 0x6d, 0x69, 0x6e, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2167,86 +4764,165 @@ func file_gateway_seminar_v1_seminar_proto_rawDescGZIP() []byte {
 	return file_gateway_seminar_v1_seminar_proto_rawDescData
 }
 
-var file_gateway_seminar_v1_seminar_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_gateway_seminar_v1_seminar_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_gateway_seminar_v1_seminar_proto_goTypes = []any{
-	(*TopicMetadata)(nil),               // 0: Ayana.v1.TopicMetadata
-	(*Speech)(nil),                      // 1: Ayana.v1.Speech
-	(*Topic)(nil),                       // 2: Ayana.v1.Topic
-	(*Document)(nil),                    // 3: Ayana.v1.Document
-	(*CreateTopicRequest)(nil),          // 4: Ayana.v1.CreateTopicRequest
-	(*CreateTopicReply)(nil),            // 5: Ayana.v1.CreateTopicReply
-	(*DeleteTopicRequest)(nil),          // 6: Ayana.v1.DeleteTopicRequest
-	(*DeleteTopicReply)(nil),            // 7: Ayana.v1.DeleteTopicReply
-	(*StartTopicRequest)(nil),           // 8: Ayana.v1.StartTopicRequest
-	(*StartTopicReply)(nil),             // 9: Ayana.v1.StartTopicReply
-	(*StopTopicRequest)(nil),            // 10: Ayana.v1.StopTopicRequest
-	(*StopTopicReply)(nil),              // 11: Ayana.v1.StopTopicReply
-	(*StreamOutputReply)(nil),           // 12: Ayana.v1.StreamOutputReply
-	(*GetTopicsMetadataRequest)(nil),    // 13: Ayana.v1.GetTopicsMetadataRequest
-	(*GetTopicsMetadataReply)(nil),      // 14: Ayana.v1.GetTopicsMetadataReply
-	(*GetTopicRequest)(nil),             // 15: Ayana.v1.GetTopicRequest
-	(*GetTopicReply)(nil),               // 16: Ayana.v1.GetTopicReply
-	(*UploadDocumentRequest)(nil),       // 17: Ayana.v1.UploadDocumentRequest
-	(*UploadDocumentReply)(nil),         // 18: Ayana.v1.UploadDocumentReply
-	(*GetDocumentsRequest)(nil),         // 19: Ayana.v1.GetDocumentsRequest
-	(*GetDocumentsReply)(nil),           // 20: Ayana.v1.GetDocumentsReply
-	(*AddMCPServerReqeust)(nil),         // 21: Ayana.v1.AddMCPServerReqeust
-	(*AddMCPServerReply)(nil),           // 22: Ayana.v1.AddMCPServerReply
-	(*GetMCPServersRequest)(nil),        // 23: Ayana.v1.GetMCPServersRequest
-	(*MCPServer)(nil),                   // 24: Ayana.v1.MCPServer
-	(*GetMCPServersReply)(nil),          // 25: Ayana.v1.GetMCPServersReply
-	(*CheckMCPServerHealthReqeust)(nil), // 26: Ayana.v1.CheckMCPServerHealthReqeust
-	(*CheckMCPServerHealthReply)(nil),   // 27: Ayana.v1.CheckMCPServerHealthReply
-	(*DeleteMCPServerRequest)(nil),      // 28: Ayana.v1.DeleteMCPServerRequest
-	(*DeleteMCPServerReply)(nil),        // 29: Ayana.v1.DeleteMCPServerReply
-	(*EnableMCPServerRequest)(nil),      // 30: Ayana.v1.EnableMCPServerRequest
-	(*EnableMCPServerReply)(nil),        // 31: Ayana.v1.EnableMCPServerReply
-	(*DisableMCPServerRequest)(nil),     // 32: Ayana.v1.DisableMCPServerRequest
-	(*DisableMCPServerReply)(nil),       // 33: Ayana.v1.DisableMCPServerReply
+	(*TopicMetadata)(nil),                // 0: Ayana.v1.TopicMetadata
+	(*Speech)(nil),                       // 1: Ayana.v1.Speech
+	(*Topic)(nil),                        // 2: Ayana.v1.Topic
+	(*Document)(nil),                     // 3: Ayana.v1.Document
+	(*CreateTopicRequest)(nil),           // 4: Ayana.v1.CreateTopicRequest
+	(*RoleDocuments)(nil),                // 5: Ayana.v1.RoleDocuments
+	(*RoleTools)(nil),                    // 6: Ayana.v1.RoleTools
+	(*CreateTopicReply)(nil),             // 7: Ayana.v1.CreateTopicReply
+	(*DeleteTopicRequest)(nil),           // 8: Ayana.v1.DeleteTopicRequest
+	(*DeleteTopicReply)(nil),             // 9: Ayana.v1.DeleteTopicReply
+	(*StartTopicRequest)(nil),            // 10: Ayana.v1.StartTopicRequest
+	(*StartTopicReply)(nil),              // 11: Ayana.v1.StartTopicReply
+	(*StopTopicRequest)(nil),             // 12: Ayana.v1.StopTopicRequest
+	(*StopTopicReply)(nil),               // 13: Ayana.v1.StopTopicReply
+	(*InterjectTopicRequest)(nil),        // 14: Ayana.v1.InterjectTopicRequest
+	(*InterjectTopicReply)(nil),          // 15: Ayana.v1.InterjectTopicReply
+	(*StreamOutputReply)(nil),            // 16: Ayana.v1.StreamOutputReply
+	(*GetTopicsMetadataRequest)(nil),     // 17: Ayana.v1.GetTopicsMetadataRequest
+	(*GetTopicsMetadataReply)(nil),       // 18: Ayana.v1.GetTopicsMetadataReply
+	(*GetTopicRequest)(nil),              // 19: Ayana.v1.GetTopicRequest
+	(*GetTopicReply)(nil),                // 20: Ayana.v1.GetTopicReply
+	(*UploadDocumentRequest)(nil),        // 21: Ayana.v1.UploadDocumentRequest
+	(*UploadDocumentReply)(nil),          // 22: Ayana.v1.UploadDocumentReply
+	(*GetDocumentsRequest)(nil),          // 23: Ayana.v1.GetDocumentsRequest
+	(*GetDocumentsReply)(nil),            // 24: Ayana.v1.GetDocumentsReply
+	(*AddMCPServerReqeust)(nil),          // 25: Ayana.v1.AddMCPServerReqeust
+	(*AddMCPServerReply)(nil),            // 26: Ayana.v1.AddMCPServerReply
+	(*GetMCPServersRequest)(nil),         // 27: Ayana.v1.GetMCPServersRequest
+	(*MCPServer)(nil),                    // 28: Ayana.v1.MCPServer
+	(*GetMCPServersReply)(nil),           // 29: Ayana.v1.GetMCPServersReply
+	(*MCPTool)(nil),                      // 30: Ayana.v1.MCPTool
+	(*GetMCPServerToolsRequest)(nil),     // 31: Ayana.v1.GetMCPServerToolsRequest
+	(*GetMCPServerToolsReply)(nil),       // 32: Ayana.v1.GetMCPServerToolsReply
+	(*SetTopicToolsRequest)(nil),         // 33: Ayana.v1.SetTopicToolsRequest
+	(*SetTopicToolsReply)(nil),           // 34: Ayana.v1.SetTopicToolsReply
+	(*SetTopicDocumentsRequest)(nil),     // 35: Ayana.v1.SetTopicDocumentsRequest
+	(*SetTopicDocumentsReply)(nil),       // 36: Ayana.v1.SetTopicDocumentsReply
+	(*CheckMCPServerHealthReqeust)(nil),  // 37: Ayana.v1.CheckMCPServerHealthReqeust
+	(*CheckMCPServerHealthReply)(nil),    // 38: Ayana.v1.CheckMCPServerHealthReply
+	(*DeleteMCPServerRequest)(nil),       // 39: Ayana.v1.DeleteMCPServerRequest
+	(*DeleteMCPServerReply)(nil),         // 40: Ayana.v1.DeleteMCPServerReply
+	(*EnableMCPServerRequest)(nil),       // 41: Ayana.v1.EnableMCPServerRequest
+	(*EnableMCPServerReply)(nil),         // 42: Ayana.v1.EnableMCPServerReply
+	(*DisableMCPServerRequest)(nil),      // 43: Ayana.v1.DisableMCPServerRequest
+	(*DisableMCPServerReply)(nil),        // 44: Ayana.v1.DisableMCPServerReply
+	(*UsageSummary)(nil),                 // 45: Ayana.v1.UsageSummary
+	(*ModelUsage)(nil),                   // 46: Ayana.v1.ModelUsage
+	(*GetUsageRequest)(nil),              // 47: Ayana.v1.GetUsageRequest
+	(*GetUsageReply)(nil),                // 48: Ayana.v1.GetUsageReply
+	(*CheckQuotaRequest)(nil),            // 49: Ayana.v1.CheckQuotaRequest
+	(*CheckQuotaReply)(nil),              // 50: Ayana.v1.CheckQuotaReply
+	(*RecordUsageRequest)(nil),           // 51: Ayana.v1.RecordUsageRequest
+	(*RecordUsageReply)(nil),             // 52: Ayana.v1.RecordUsageReply
+	(*EstimateTopicCostRequest)(nil),     // 53: Ayana.v1.EstimateTopicCostRequest
+	(*EstimateTopicCostReply)(nil),       // 54: Ayana.v1.EstimateTopicCostReply
+	(*TestCredentialRequest)(nil),        // 55: Ayana.v1.TestCredentialRequest
+	(*TestCredentialReply)(nil),          // 56: Ayana.v1.TestCredentialReply
+	(*RunRole)(nil),                      // 57: Ayana.v1.RunRole
+	(*TopicRun)(nil),                     // 58: Ayana.v1.TopicRun
+	(*GetTopicRunsRequest)(nil),          // 59: Ayana.v1.GetTopicRunsRequest
+	(*GetTopicRunsReply)(nil),            // 60: Ayana.v1.GetTopicRunsReply
+	(*PreviewDraft)(nil),                 // 61: Ayana.v1.PreviewDraft
+	(*PreviewRoleRequest)(nil),           // 62: Ayana.v1.PreviewRoleRequest
+	(*PreviewRoleReply)(nil),             // 63: Ayana.v1.PreviewRoleReply
+	(*AdminGetUsageRequest)(nil),         // 64: Ayana.v1.AdminGetUsageRequest
+	(*AdminGetRunningTopicsRequest)(nil), // 65: Ayana.v1.AdminGetRunningTopicsRequest
+	(*RunningTopic)(nil),                 // 66: Ayana.v1.RunningTopic
+	(*AdminGetRunningTopicsReply)(nil),   // 67: Ayana.v1.AdminGetRunningTopicsReply
+	(*AdminStopTopicRequest)(nil),        // 68: Ayana.v1.AdminStopTopicRequest
+	(*AdminStopTopicReply)(nil),          // 69: Ayana.v1.AdminStopTopicReply
 }
 var file_gateway_seminar_v1_seminar_proto_depIdxs = []int32{
 	3,  // 0: Ayana.v1.TopicMetadata.documents:type_name -> Ayana.v1.Document
 	1,  // 1: Ayana.v1.Topic.speeches:type_name -> Ayana.v1.Speech
 	3,  // 2: Ayana.v1.Topic.documents:type_name -> Ayana.v1.Document
-	0,  // 3: Ayana.v1.GetTopicsMetadataReply.topics:type_name -> Ayana.v1.TopicMetadata
-	2,  // 4: Ayana.v1.GetTopicReply.topic:type_name -> Ayana.v1.Topic
-	3,  // 5: Ayana.v1.GetDocumentsReply.documents:type_name -> Ayana.v1.Document
-	24, // 6: Ayana.v1.GetMCPServersReply.servers:type_name -> Ayana.v1.MCPServer
-	4,  // 7: Ayana.v1.Seminar.CreateTopic:input_type -> Ayana.v1.CreateTopicRequest
-	13, // 8: Ayana.v1.Seminar.GetTopicsMetadata:input_type -> Ayana.v1.GetTopicsMetadataRequest
-	15, // 9: Ayana.v1.Seminar.GetTopic:input_type -> Ayana.v1.GetTopicRequest
-	6,  // 10: Ayana.v1.Seminar.DeleteTopic:input_type -> Ayana.v1.DeleteTopicRequest
-	8,  // 11: Ayana.v1.Seminar.StartTopic:input_type -> Ayana.v1.StartTopicRequest
-	10, // 12: Ayana.v1.Seminar.StopTopic:input_type -> Ayana.v1.StopTopicRequest
-	8,  // 13: Ayana.v1.Seminar.ResumeTopic:input_type -> Ayana.v1.StartTopicRequest
-	17, // 14: Ayana.v1.Seminar.UploadDocument:input_type -> Ayana.v1.UploadDocumentRequest
-	19, // 15: Ayana.v1.Seminar.GetDocuments:input_type -> Ayana.v1.GetDocumentsRequest
-	21, // 16: Ayana.v1.Seminar.AddMCPServer:input_type -> Ayana.v1.AddMCPServerReqeust
-	23, // 17: Ayana.v1.Seminar.GetMCPServers:input_type -> Ayana.v1.GetMCPServersRequest
-	26, // 18: Ayana.v1.Seminar.CheckMCPServerHealth:input_type -> Ayana.v1.CheckMCPServerHealthReqeust
-	28, // 19: Ayana.v1.Seminar.DeleteMCPServer:input_type -> Ayana.v1.DeleteMCPServerRequest
-	30, // 20: Ayana.v1.Seminar.EnableMCPServer:input_type -> Ayana.v1.EnableMCPServerRequest
-	32, // 21: Ayana.v1.Seminar.DisableMCPServer:input_type -> Ayana.v1.DisableMCPServerRequest
-	5,  // 22: Ayana.v1.Seminar.CreateTopic:output_type -> Ayana.v1.CreateTopicReply
-	14, // 23: Ayana.v1.Seminar.GetTopicsMetadata:output_type -> Ayana.v1.GetTopicsMetadataReply
-	16, // 24: Ayana.v1.Seminar.GetTopic:output_type -> Ayana.v1.GetTopicReply
-	7,  // 25: Ayana.v1.Seminar.DeleteTopic:output_type -> Ayana.v1.DeleteTopicReply
-	9,  // 26: Ayana.v1.Seminar.StartTopic:output_type -> Ayana.v1.StartTopicReply
-	11, // 27: Ayana.v1.Seminar.StopTopic:output_type -> Ayana.v1.StopTopicReply
-	12, // 28: Ayana.v1.Seminar.ResumeTopic:output_type -> Ayana.v1.StreamOutputReply
-	18, // 29: Ayana.v1.Seminar.UploadDocument:output_type -> Ayana.v1.UploadDocumentReply
-	20, // 30: Ayana.v1.Seminar.GetDocuments:output_type -> Ayana.v1.GetDocumentsReply
-	22, // 31: Ayana.v1.Seminar.AddMCPServer:output_type -> Ayana.v1.AddMCPServerReply
-	25, // 32: Ayana.v1.Seminar.GetMCPServers:output_type -> Ayana.v1.GetMCPServersReply
-	27, // 33: Ayana.v1.Seminar.CheckMCPServerHealth:output_type -> Ayana.v1.CheckMCPServerHealthReply
-	29, // 34: Ayana.v1.Seminar.DeleteMCPServer:output_type -> Ayana.v1.DeleteMCPServerReply
-	31, // 35: Ayana.v1.Seminar.EnableMCPServer:output_type -> Ayana.v1.EnableMCPServerReply
-	33, // 36: Ayana.v1.Seminar.DisableMCPServer:output_type -> Ayana.v1.DisableMCPServerReply
-	22, // [22:37] is the sub-list for method output_type
-	7,  // [7:22] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	6,  // 3: Ayana.v1.Topic.role_tools:type_name -> Ayana.v1.RoleTools
+	5,  // 4: Ayana.v1.Topic.role_documents:type_name -> Ayana.v1.RoleDocuments
+	6,  // 5: Ayana.v1.CreateTopicRequest.role_tools:type_name -> Ayana.v1.RoleTools
+	5,  // 6: Ayana.v1.CreateTopicRequest.role_documents:type_name -> Ayana.v1.RoleDocuments
+	0,  // 7: Ayana.v1.GetTopicsMetadataReply.topics:type_name -> Ayana.v1.TopicMetadata
+	2,  // 8: Ayana.v1.GetTopicReply.topic:type_name -> Ayana.v1.Topic
+	3,  // 9: Ayana.v1.GetDocumentsReply.documents:type_name -> Ayana.v1.Document
+	28, // 10: Ayana.v1.GetMCPServersReply.servers:type_name -> Ayana.v1.MCPServer
+	30, // 11: Ayana.v1.GetMCPServerToolsReply.tools:type_name -> Ayana.v1.MCPTool
+	6,  // 12: Ayana.v1.SetTopicToolsRequest.role_tools:type_name -> Ayana.v1.RoleTools
+	5,  // 13: Ayana.v1.SetTopicDocumentsRequest.role_documents:type_name -> Ayana.v1.RoleDocuments
+	45, // 14: Ayana.v1.ModelUsage.usage:type_name -> Ayana.v1.UsageSummary
+	45, // 15: Ayana.v1.GetUsageReply.daily:type_name -> Ayana.v1.UsageSummary
+	45, // 16: Ayana.v1.GetUsageReply.monthly:type_name -> Ayana.v1.UsageSummary
+	46, // 17: Ayana.v1.GetUsageReply.models:type_name -> Ayana.v1.ModelUsage
+	57, // 18: Ayana.v1.TopicRun.roles:type_name -> Ayana.v1.RunRole
+	58, // 19: Ayana.v1.GetTopicRunsReply.runs:type_name -> Ayana.v1.TopicRun
+	61, // 20: Ayana.v1.PreviewRoleRequest.draft:type_name -> Ayana.v1.PreviewDraft
+	66, // 21: Ayana.v1.AdminGetRunningTopicsReply.topics:type_name -> Ayana.v1.RunningTopic
+	4,  // 22: Ayana.v1.Seminar.CreateTopic:input_type -> Ayana.v1.CreateTopicRequest
+	17, // 23: Ayana.v1.Seminar.GetTopicsMetadata:input_type -> Ayana.v1.GetTopicsMetadataRequest
+	19, // 24: Ayana.v1.Seminar.GetTopic:input_type -> Ayana.v1.GetTopicRequest
+	8,  // 25: Ayana.v1.Seminar.DeleteTopic:input_type -> Ayana.v1.DeleteTopicRequest
+	10, // 26: Ayana.v1.Seminar.StartTopic:input_type -> Ayana.v1.StartTopicRequest
+	12, // 27: Ayana.v1.Seminar.StopTopic:input_type -> Ayana.v1.StopTopicRequest
+	10, // 28: Ayana.v1.Seminar.ResumeTopic:input_type -> Ayana.v1.StartTopicRequest
+	14, // 29: Ayana.v1.Seminar.InterjectTopic:input_type -> Ayana.v1.InterjectTopicRequest
+	21, // 30: Ayana.v1.Seminar.UploadDocument:input_type -> Ayana.v1.UploadDocumentRequest
+	23, // 31: Ayana.v1.Seminar.GetDocuments:input_type -> Ayana.v1.GetDocumentsRequest
+	25, // 32: Ayana.v1.Seminar.AddMCPServer:input_type -> Ayana.v1.AddMCPServerReqeust
+	27, // 33: Ayana.v1.Seminar.GetMCPServers:input_type -> Ayana.v1.GetMCPServersRequest
+	37, // 34: Ayana.v1.Seminar.CheckMCPServerHealth:input_type -> Ayana.v1.CheckMCPServerHealthReqeust
+	39, // 35: Ayana.v1.Seminar.DeleteMCPServer:input_type -> Ayana.v1.DeleteMCPServerRequest
+	41, // 36: Ayana.v1.Seminar.EnableMCPServer:input_type -> Ayana.v1.EnableMCPServerRequest
+	43, // 37: Ayana.v1.Seminar.DisableMCPServer:input_type -> Ayana.v1.DisableMCPServerRequest
+	31, // 38: Ayana.v1.Seminar.GetMCPServerTools:input_type -> Ayana.v1.GetMCPServerToolsRequest
+	33, // 39: Ayana.v1.Seminar.SetTopicTools:input_type -> Ayana.v1.SetTopicToolsRequest
+	35, // 40: Ayana.v1.Seminar.SetTopicDocuments:input_type -> Ayana.v1.SetTopicDocumentsRequest
+	47, // 41: Ayana.v1.Seminar.GetUsage:input_type -> Ayana.v1.GetUsageRequest
+	53, // 42: Ayana.v1.Seminar.EstimateTopicCost:input_type -> Ayana.v1.EstimateTopicCostRequest
+	62, // 43: Ayana.v1.Seminar.PreviewRole:input_type -> Ayana.v1.PreviewRoleRequest
+	59, // 44: Ayana.v1.Seminar.GetTopicRuns:input_type -> Ayana.v1.GetTopicRunsRequest
+	49, // 45: Ayana.v1.Seminar.CheckQuota:input_type -> Ayana.v1.CheckQuotaRequest
+	51, // 46: Ayana.v1.Seminar.RecordUsage:input_type -> Ayana.v1.RecordUsageRequest
+	64, // 47: Ayana.v1.Seminar.AdminGetUsage:input_type -> Ayana.v1.AdminGetUsageRequest
+	65, // 48: Ayana.v1.Seminar.AdminGetRunningTopics:input_type -> Ayana.v1.AdminGetRunningTopicsRequest
+	68, // 49: Ayana.v1.Seminar.AdminStopTopic:input_type -> Ayana.v1.AdminStopTopicRequest
+	55, // 50: Ayana.v1.Seminar.TestCredential:input_type -> Ayana.v1.TestCredentialRequest
+	7,  // 51: Ayana.v1.Seminar.CreateTopic:output_type -> Ayana.v1.CreateTopicReply
+	18, // 52: Ayana.v1.Seminar.GetTopicsMetadata:output_type -> Ayana.v1.GetTopicsMetadataReply
+	20, // 53: Ayana.v1.Seminar.GetTopic:output_type -> Ayana.v1.GetTopicReply
+	9,  // 54: Ayana.v1.Seminar.DeleteTopic:output_type -> Ayana.v1.DeleteTopicReply
+	11, // 55: Ayana.v1.Seminar.StartTopic:output_type -> Ayana.v1.StartTopicReply
+	13, // 56: Ayana.v1.Seminar.StopTopic:output_type -> Ayana.v1.StopTopicReply
+	16, // 57: Ayana.v1.Seminar.ResumeTopic:output_type -> Ayana.v1.StreamOutputReply
+	15, // 58: Ayana.v1.Seminar.InterjectTopic:output_type -> Ayana.v1.InterjectTopicReply
+	22, // 59: Ayana.v1.Seminar.UploadDocument:output_type -> Ayana.v1.UploadDocumentReply
+	24, // 60: Ayana.v1.Seminar.GetDocuments:output_type -> Ayana.v1.GetDocumentsReply
+	26, // 61: Ayana.v1.Seminar.AddMCPServer:output_type -> Ayana.v1.AddMCPServerReply
+	29, // 62: Ayana.v1.Seminar.GetMCPServers:output_type -> Ayana.v1.GetMCPServersReply
+	38, // 63: Ayana.v1.Seminar.CheckMCPServerHealth:output_type -> Ayana.v1.CheckMCPServerHealthReply
+	40, // 64: Ayana.v1.Seminar.DeleteMCPServer:output_type -> Ayana.v1.DeleteMCPServerReply
+	42, // 65: Ayana.v1.Seminar.EnableMCPServer:output_type -> Ayana.v1.EnableMCPServerReply
+	44, // 66: Ayana.v1.Seminar.DisableMCPServer:output_type -> Ayana.v1.DisableMCPServerReply
+	32, // 67: Ayana.v1.Seminar.GetMCPServerTools:output_type -> Ayana.v1.GetMCPServerToolsReply
+	34, // 68: Ayana.v1.Seminar.SetTopicTools:output_type -> Ayana.v1.SetTopicToolsReply
+	36, // 69: Ayana.v1.Seminar.SetTopicDocuments:output_type -> Ayana.v1.SetTopicDocumentsReply
+	48, // 70: Ayana.v1.Seminar.GetUsage:output_type -> Ayana.v1.GetUsageReply
+	54, // 71: Ayana.v1.Seminar.EstimateTopicCost:output_type -> Ayana.v1.EstimateTopicCostReply
+	63, // 72: Ayana.v1.Seminar.PreviewRole:output_type -> Ayana.v1.PreviewRoleReply
+	60, // 73: Ayana.v1.Seminar.GetTopicRuns:output_type -> Ayana.v1.GetTopicRunsReply
+	50, // 74: Ayana.v1.Seminar.CheckQuota:output_type -> Ayana.v1.CheckQuotaReply
+	52, // 75: Ayana.v1.Seminar.RecordUsage:output_type -> Ayana.v1.RecordUsageReply
+	48, // 76: Ayana.v1.Seminar.AdminGetUsage:output_type -> Ayana.v1.GetUsageReply
+	67, // 77: Ayana.v1.Seminar.AdminGetRunningTopics:output_type -> Ayana.v1.AdminGetRunningTopicsReply
+	69, // 78: Ayana.v1.Seminar.AdminStopTopic:output_type -> Ayana.v1.AdminStopTopicReply
+	56, // 79: Ayana.v1.Seminar.TestCredential:output_type -> Ayana.v1.TestCredentialReply
+	51, // [51:80] is the sub-list for method output_type
+	22, // [22:51] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_gateway_seminar_v1_seminar_proto_init() }
@@ -2254,7 +4930,7 @@ func file_gateway_seminar_v1_seminar_proto_init() {
 	if File_gateway_seminar_v1_seminar_proto != nil {
 		return
 	}
-	file_gateway_seminar_v1_seminar_proto_msgTypes[12].OneofWrappers = []any{
+	file_gateway_seminar_v1_seminar_proto_msgTypes[16].OneofWrappers = []any{
 		(*StreamOutputReply_Reasoning)(nil),
 		(*StreamOutputReply_Text)(nil),
 	}
@@ -2264,7 +4940,7 @@ func file_gateway_seminar_v1_seminar_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gateway_seminar_v1_seminar_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,21 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Seminar_CreateTopic_FullMethodName          = "/Ayana.v1.Seminar/CreateTopic"
-	Seminar_GetTopicsMetadata_FullMethodName    = "/Ayana.v1.Seminar/GetTopicsMetadata"
-	Seminar_GetTopic_FullMethodName             = "/Ayana.v1.Seminar/GetTopic"
-	Seminar_DeleteTopic_FullMethodName          = "/Ayana.v1.Seminar/DeleteTopic"
-	Seminar_StartTopic_FullMethodName           = "/Ayana.v1.Seminar/StartTopic"
-	Seminar_StopTopic_FullMethodName            = "/Ayana.v1.Seminar/StopTopic"
-	Seminar_ResumeTopic_FullMethodName          = "/Ayana.v1.Seminar/ResumeTopic"
-	Seminar_UploadDocument_FullMethodName       = "/Ayana.v1.Seminar/UploadDocument"
-	Seminar_GetDocuments_FullMethodName         = "/Ayana.v1.Seminar/GetDocuments"
-	Seminar_AddMCPServer_FullMethodName         = "/Ayana.v1.Seminar/AddMCPServer"
-	Seminar_GetMCPServers_FullMethodName        = "/Ayana.v1.Seminar/GetMCPServers"
-	Seminar_CheckMCPServerHealth_FullMethodName = "/Ayana.v1.Seminar/CheckMCPServerHealth"
-	Seminar_DeleteMCPServer_FullMethodName      = "/Ayana.v1.Seminar/DeleteMCPServer"
-	Seminar_EnableMCPServer_FullMethodName      = "/Ayana.v1.Seminar/EnableMCPServer"
-	Seminar_DisableMCPServer_FullMethodName     = "/Ayana.v1.Seminar/DisableMCPServer"
+	Seminar_CreateTopic_FullMethodName           = "/Ayana.v1.Seminar/CreateTopic"
+	Seminar_GetTopicsMetadata_FullMethodName     = "/Ayana.v1.Seminar/GetTopicsMetadata"
+	Seminar_GetTopic_FullMethodName              = "/Ayana.v1.Seminar/GetTopic"
+	Seminar_DeleteTopic_FullMethodName           = "/Ayana.v1.Seminar/DeleteTopic"
+	Seminar_StartTopic_FullMethodName            = "/Ayana.v1.Seminar/StartTopic"
+	Seminar_StopTopic_FullMethodName             = "/Ayana.v1.Seminar/StopTopic"
+	Seminar_ResumeTopic_FullMethodName           = "/Ayana.v1.Seminar/ResumeTopic"
+	Seminar_InterjectTopic_FullMethodName        = "/Ayana.v1.Seminar/InterjectTopic"
+	Seminar_UploadDocument_FullMethodName        = "/Ayana.v1.Seminar/UploadDocument"
+	Seminar_GetDocuments_FullMethodName          = "/Ayana.v1.Seminar/GetDocuments"
+	Seminar_AddMCPServer_FullMethodName          = "/Ayana.v1.Seminar/AddMCPServer"
+	Seminar_GetMCPServers_FullMethodName         = "/Ayana.v1.Seminar/GetMCPServers"
+	Seminar_CheckMCPServerHealth_FullMethodName  = "/Ayana.v1.Seminar/CheckMCPServerHealth"
+	Seminar_DeleteMCPServer_FullMethodName       = "/Ayana.v1.Seminar/DeleteMCPServer"
+	Seminar_EnableMCPServer_FullMethodName       = "/Ayana.v1.Seminar/EnableMCPServer"
+	Seminar_DisableMCPServer_FullMethodName      = "/Ayana.v1.Seminar/DisableMCPServer"
+	Seminar_GetMCPServerTools_FullMethodName     = "/Ayana.v1.Seminar/GetMCPServerTools"
+	Seminar_SetTopicTools_FullMethodName         = "/Ayana.v1.Seminar/SetTopicTools"
+	Seminar_SetTopicDocuments_FullMethodName     = "/Ayana.v1.Seminar/SetTopicDocuments"
+	Seminar_GetUsage_FullMethodName              = "/Ayana.v1.Seminar/GetUsage"
+	Seminar_EstimateTopicCost_FullMethodName     = "/Ayana.v1.Seminar/EstimateTopicCost"
+	Seminar_PreviewRole_FullMethodName           = "/Ayana.v1.Seminar/PreviewRole"
+	Seminar_GetTopicRuns_FullMethodName          = "/Ayana.v1.Seminar/GetTopicRuns"
+	Seminar_CheckQuota_FullMethodName            = "/Ayana.v1.Seminar/CheckQuota"
+	Seminar_RecordUsage_FullMethodName           = "/Ayana.v1.Seminar/RecordUsage"
+	Seminar_AdminGetUsage_FullMethodName         = "/Ayana.v1.Seminar/AdminGetUsage"
+	Seminar_AdminGetRunningTopics_FullMethodName = "/Ayana.v1.Seminar/AdminGetRunningTopics"
+	Seminar_AdminStopTopic_FullMethodName        = "/Ayana.v1.Seminar/AdminStopTopic"
+	Seminar_TestCredential_FullMethodName        = "/Ayana.v1.Seminar/TestCredential"
 )
 
 // SeminarClient is the client API for Seminar service.
@@ -49,6 +63,8 @@ type SeminarClient interface {
 	StartTopic(ctx context.Context, in *StartTopicRequest, opts ...grpc.CallOption) (*StartTopicReply, error)
 	StopTopic(ctx context.Context, in *StopTopicRequest, opts ...grpc.CallOption) (*StopTopicReply, error)
 	ResumeTopic(ctx context.Context, in *StartTopicRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamOutputReply], error)
+	// InterjectTopic 用户在讨论进行中插话，由正在运行该讨论的实例处理
+	InterjectTopic(ctx context.Context, in *InterjectTopicRequest, opts ...grpc.CallOption) (*InterjectTopicReply, error)
 	UploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentRequest, UploadDocumentReply], error)
 	GetDocuments(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsReply, error)
 	AddMCPServer(ctx context.Context, in *AddMCPServerReqeust, opts ...grpc.CallOption) (*AddMCPServerReply, error)
@@ -57,6 +73,30 @@ type SeminarClient interface {
 	DeleteMCPServer(ctx context.Context, in *DeleteMCPServerRequest, opts ...grpc.CallOption) (*DeleteMCPServerReply, error)
 	EnableMCPServer(ctx context.Context, in *EnableMCPServerRequest, opts ...grpc.CallOption) (*EnableMCPServerReply, error)
 	DisableMCPServer(ctx context.Context, in *DisableMCPServerRequest, opts ...grpc.CallOption) (*DisableMCPServerReply, error)
+	// 列出 MCP 服务器提供的工具，用于为讨论中的角色配置工具白名单
+	GetMCPServerTools(ctx context.Context, in *GetMCPServerToolsRequest, opts ...grpc.CallOption) (*GetMCPServerToolsReply, error)
+	// 修改讨论使用的 MCP 服务器和各角色的工具白名单，下次开始讨论时生效
+	SetTopicTools(ctx context.Context, in *SetTopicToolsRequest, opts ...grpc.CallOption) (*SetTopicToolsReply, error)
+	// 修改各角色的私有资料和主持人能否检索共享资料，下次开始讨论时生效
+	SetTopicDocuments(ctx context.Context, in *SetTopicDocumentsRequest, opts ...grpc.CallOption) (*SetTopicDocumentsReply, error)
+	// 获取用户当日、当月的模型用量和配额
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error)
+	// 在开始讨论前按发言次数估算用量和费用，speeches 为 0 时按讨论的最大发言次数估算
+	EstimateTopicCost(ctx context.Context, in *EstimateTopicCostRequest, opts ...grpc.CallOption) (*EstimateTopicCostReply, error)
+	// 单轮试聊角色，流式返回，网关以 SSE 的形式转发
+	PreviewRole(ctx context.Context, in *PreviewRoleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PreviewRoleReply], error)
+	// 讨论的每次运行及其使用的角色版本快照
+	GetTopicRuns(ctx context.Context, in *GetTopicRunsRequest, opts ...grpc.CallOption) (*GetTopicRunsReply, error)
+	// 以下两个接口供角色服务使用平台密钥生成角色时检查配额和记录用量，不对外暴露
+	CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error)
+	RecordUsage(ctx context.Context, in *RecordUsageRequest, opts ...grpc.CallOption) (*RecordUsageReply, error)
+	// 以下为管理接口，可以查看和操作任意用户的数据
+	AdminGetUsage(ctx context.Context, in *AdminGetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error)
+	// 列出正在运行的讨论，phone 为空时返回所有用户的
+	AdminGetRunningTopics(ctx context.Context, in *AdminGetRunningTopicsRequest, opts ...grpc.CallOption) (*AdminGetRunningTopicsReply, error)
+	AdminStopTopic(ctx context.Context, in *AdminStopTopicRequest, opts ...grpc.CallOption) (*AdminStopTopicReply, error)
+	// 使用凭证请求一次模型列表，检查地址和密钥是否可用
+	TestCredential(ctx context.Context, in *TestCredentialRequest, opts ...grpc.CallOption) (*TestCredentialReply, error)
 }
 
 type seminarClient struct {
@@ -146,6 +186,16 @@ func (c *seminarClient) ResumeTopic(ctx context.Context, in *StartTopicRequest,
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Seminar_ResumeTopicClient = grpc.ServerStreamingClient[StreamOutputReply]
 
+func (c *seminarClient) InterjectTopic(ctx context.Context, in *InterjectTopicRequest, opts ...grpc.CallOption) (*InterjectTopicReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterjectTopicReply)
+	err := c.cc.Invoke(ctx, Seminar_InterjectTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *seminarClient) UploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentRequest, UploadDocumentReply], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Seminar_ServiceDesc.Streams[1], Seminar_UploadDocument_FullMethodName, cOpts...)
@@ -229,6 +279,145 @@ func (c *seminarClient) DisableMCPServer(ctx context.Context, in *DisableMCPServ
 	return out, nil
 }
 
+func (c *seminarClient) GetMCPServerTools(ctx context.Context, in *GetMCPServerToolsRequest, opts ...grpc.CallOption) (*GetMCPServerToolsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMCPServerToolsReply)
+	err := c.cc.Invoke(ctx, Seminar_GetMCPServerTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) SetTopicTools(ctx context.Context, in *SetTopicToolsRequest, opts ...grpc.CallOption) (*SetTopicToolsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTopicToolsReply)
+	err := c.cc.Invoke(ctx, Seminar_SetTopicTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) SetTopicDocuments(ctx context.Context, in *SetTopicDocumentsRequest, opts ...grpc.CallOption) (*SetTopicDocumentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTopicDocumentsReply)
+	err := c.cc.Invoke(ctx, Seminar_SetTopicDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageReply)
+	err := c.cc.Invoke(ctx, Seminar_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) EstimateTopicCost(ctx context.Context, in *EstimateTopicCostRequest, opts ...grpc.CallOption) (*EstimateTopicCostReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstimateTopicCostReply)
+	err := c.cc.Invoke(ctx, Seminar_EstimateTopicCost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) PreviewRole(ctx context.Context, in *PreviewRoleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PreviewRoleReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Seminar_ServiceDesc.Streams[2], Seminar_PreviewRole_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PreviewRoleRequest, PreviewRoleReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Seminar_PreviewRoleClient = grpc.ServerStreamingClient[PreviewRoleReply]
+
+func (c *seminarClient) GetTopicRuns(ctx context.Context, in *GetTopicRunsRequest, opts ...grpc.CallOption) (*GetTopicRunsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicRunsReply)
+	err := c.cc.Invoke(ctx, Seminar_GetTopicRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckQuotaReply)
+	err := c.cc.Invoke(ctx, Seminar_CheckQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) RecordUsage(ctx context.Context, in *RecordUsageRequest, opts ...grpc.CallOption) (*RecordUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordUsageReply)
+	err := c.cc.Invoke(ctx, Seminar_RecordUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) AdminGetUsage(ctx context.Context, in *AdminGetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageReply)
+	err := c.cc.Invoke(ctx, Seminar_AdminGetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) AdminGetRunningTopics(ctx context.Context, in *AdminGetRunningTopicsRequest, opts ...grpc.CallOption) (*AdminGetRunningTopicsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetRunningTopicsReply)
+	err := c.cc.Invoke(ctx, Seminar_AdminGetRunningTopics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) AdminStopTopic(ctx context.Context, in *AdminStopTopicRequest, opts ...grpc.CallOption) (*AdminStopTopicReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminStopTopicReply)
+	err := c.cc.Invoke(ctx, Seminar_AdminStopTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seminarClient) TestCredential(ctx context.Context, in *TestCredentialRequest, opts ...grpc.CallOption) (*TestCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestCredentialReply)
+	err := c.cc.Invoke(ctx, Seminar_TestCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SeminarServer is the server API for Seminar service.
 // All implementations must embed UnimplementedSeminarServer
 // for forward compatibility.
@@ -242,6 +431,8 @@ type SeminarServer interface {
 	StartTopic(context.Context, *StartTopicRequest) (*StartTopicReply, error)
 	StopTopic(context.Context, *StopTopicRequest) (*StopTopicReply, error)
 	ResumeTopic(*StartTopicRequest, grpc.ServerStreamingServer[StreamOutputReply]) error
+	// InterjectTopic 用户在讨论进行中插话，由正在运行该讨论的实例处理
+	InterjectTopic(context.Context, *InterjectTopicRequest) (*InterjectTopicReply, error)
 	UploadDocument(grpc.ClientStreamingServer[UploadDocumentRequest, UploadDocumentReply]) error
 	GetDocuments(context.Context, *GetDocumentsRequest) (*GetDocumentsReply, error)
 	AddMCPServer(context.Context, *AddMCPServerReqeust) (*AddMCPServerReply, error)
@@ -250,6 +441,30 @@ type SeminarServer interface {
 	DeleteMCPServer(context.Context, *DeleteMCPServerRequest) (*DeleteMCPServerReply, error)
 	EnableMCPServer(context.Context, *EnableMCPServerRequest) (*EnableMCPServerReply, error)
 	DisableMCPServer(context.Context, *DisableMCPServerRequest) (*DisableMCPServerReply, error)
+	// 列出 MCP 服务器提供的工具，用于为讨论中的角色配置工具白名单
+	GetMCPServerTools(context.Context, *GetMCPServerToolsRequest) (*GetMCPServerToolsReply, error)
+	// 修改讨论使用的 MCP 服务器和各角色的工具白名单，下次开始讨论时生效
+	SetTopicTools(context.Context, *SetTopicToolsRequest) (*SetTopicToolsReply, error)
+	// 修改各角色的私有资料和主持人能否检索共享资料，下次开始讨论时生效
+	SetTopicDocuments(context.Context, *SetTopicDocumentsRequest) (*SetTopicDocumentsReply, error)
+	// 获取用户当日、当月的模型用量和配额
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageReply, error)
+	// 在开始讨论前按发言次数估算用量和费用，speeches 为 0 时按讨论的最大发言次数估算
+	EstimateTopicCost(context.Context, *EstimateTopicCostRequest) (*EstimateTopicCostReply, error)
+	// 单轮试聊角色，流式返回，网关以 SSE 的形式转发
+	PreviewRole(*PreviewRoleRequest, grpc.ServerStreamingServer[PreviewRoleReply]) error
+	// 讨论的每次运行及其使用的角色版本快照
+	GetTopicRuns(context.Context, *GetTopicRunsRequest) (*GetTopicRunsReply, error)
+	// 以下两个接口供角色服务使用平台密钥生成角色时检查配额和记录用量，不对外暴露
+	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
+	RecordUsage(context.Context, *RecordUsageRequest) (*RecordUsageReply, error)
+	// 以下为管理接口，可以查看和操作任意用户的数据
+	AdminGetUsage(context.Context, *AdminGetUsageRequest) (*GetUsageReply, error)
+	// 列出正在运行的讨论，phone 为空时返回所有用户的
+	AdminGetRunningTopics(context.Context, *AdminGetRunningTopicsRequest) (*AdminGetRunningTopicsReply, error)
+	AdminStopTopic(context.Context, *AdminStopTopicRequest) (*AdminStopTopicReply, error)
+	// 使用凭证请求一次模型列表，检查地址和密钥是否可用
+	TestCredential(context.Context, *TestCredentialRequest) (*TestCredentialReply, error)
 	mustEmbedUnimplementedSeminarServer()
 }
 
@@ -281,6 +496,9 @@ func (UnimplementedSeminarServer) StopTopic(context.Context, *StopTopicRequest)
 func (UnimplementedSeminarServer) ResumeTopic(*StartTopicRequest, grpc.ServerStreamingServer[StreamOutputReply]) error {
 	return status.Errorf(codes.Unimplemented, "method ResumeTopic not implemented")
 }
+func (UnimplementedSeminarServer) InterjectTopic(context.Context, *InterjectTopicRequest) (*InterjectTopicReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterjectTopic not implemented")
+}
 func (UnimplementedSeminarServer) UploadDocument(grpc.ClientStreamingServer[UploadDocumentRequest, UploadDocumentReply]) error {
 	return status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
 }
@@ -305,6 +523,45 @@ func (UnimplementedSeminarServer) EnableMCPServer(context.Context, *EnableMCPSer
 func (UnimplementedSeminarServer) DisableMCPServer(context.Context, *DisableMCPServerRequest) (*DisableMCPServerReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableMCPServer not implemented")
 }
+func (UnimplementedSeminarServer) GetMCPServerTools(context.Context, *GetMCPServerToolsRequest) (*GetMCPServerToolsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMCPServerTools not implemented")
+}
+func (UnimplementedSeminarServer) SetTopicTools(context.Context, *SetTopicToolsRequest) (*SetTopicToolsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopicTools not implemented")
+}
+func (UnimplementedSeminarServer) SetTopicDocuments(context.Context, *SetTopicDocumentsRequest) (*SetTopicDocumentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopicDocuments not implemented")
+}
+func (UnimplementedSeminarServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedSeminarServer) EstimateTopicCost(context.Context, *EstimateTopicCostRequest) (*EstimateTopicCostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTopicCost not implemented")
+}
+func (UnimplementedSeminarServer) PreviewRole(*PreviewRoleRequest, grpc.ServerStreamingServer[PreviewRoleReply]) error {
+	return status.Errorf(codes.Unimplemented, "method PreviewRole not implemented")
+}
+func (UnimplementedSeminarServer) GetTopicRuns(context.Context, *GetTopicRunsRequest) (*GetTopicRunsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRuns not implemented")
+}
+func (UnimplementedSeminarServer) CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQuota not implemented")
+}
+func (UnimplementedSeminarServer) RecordUsage(context.Context, *RecordUsageRequest) (*RecordUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordUsage not implemented")
+}
+func (UnimplementedSeminarServer) AdminGetUsage(context.Context, *AdminGetUsageRequest) (*GetUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetUsage not implemented")
+}
+func (UnimplementedSeminarServer) AdminGetRunningTopics(context.Context, *AdminGetRunningTopicsRequest) (*AdminGetRunningTopicsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetRunningTopics not implemented")
+}
+func (UnimplementedSeminarServer) AdminStopTopic(context.Context, *AdminStopTopicRequest) (*AdminStopTopicReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminStopTopic not implemented")
+}
+func (UnimplementedSeminarServer) TestCredential(context.Context, *TestCredentialRequest) (*TestCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCredential not implemented")
+}
 func (UnimplementedSeminarServer) mustEmbedUnimplementedSeminarServer() {}
 func (UnimplementedSeminarServer) testEmbeddedByValue()                 {}
 
@@ -445,6 +702,24 @@ func _Seminar_ResumeTopic_Handler(srv interface{}, stream grpc.ServerStream) err
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Seminar_ResumeTopicServer = grpc.ServerStreamingServer[StreamOutputReply]
 
+func _Seminar_InterjectTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterjectTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).InterjectTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_InterjectTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).InterjectTopic(ctx, req.(*InterjectTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Seminar_UploadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SeminarServer).UploadDocument(&grpc.GenericServerStream[UploadDocumentRequest, UploadDocumentReply]{ServerStream: stream})
 }
@@ -578,6 +853,233 @@ func _Seminar_DisableMCPServer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seminar_GetMCPServerTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMCPServerToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).GetMCPServerTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_GetMCPServerTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).GetMCPServerTools(ctx, req.(*GetMCPServerToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_SetTopicTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTopicToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).SetTopicTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_SetTopicTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).SetTopicTools(ctx, req.(*SetTopicToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_SetTopicDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTopicDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).SetTopicDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_SetTopicDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).SetTopicDocuments(ctx, req.(*SetTopicDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_EstimateTopicCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateTopicCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).EstimateTopicCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_EstimateTopicCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).EstimateTopicCost(ctx, req.(*EstimateTopicCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_PreviewRole_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PreviewRoleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeminarServer).PreviewRole(m, &grpc.GenericServerStream[PreviewRoleRequest, PreviewRoleReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Seminar_PreviewRoleServer = grpc.ServerStreamingServer[PreviewRoleReply]
+
+func _Seminar_GetTopicRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).GetTopicRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_GetTopicRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).GetTopicRuns(ctx, req.(*GetTopicRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).CheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_CheckQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_RecordUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).RecordUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_RecordUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).RecordUsage(ctx, req.(*RecordUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_AdminGetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).AdminGetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_AdminGetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).AdminGetUsage(ctx, req.(*AdminGetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_AdminGetRunningTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetRunningTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).AdminGetRunningTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_AdminGetRunningTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).AdminGetRunningTopics(ctx, req.(*AdminGetRunningTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_AdminStopTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStopTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).AdminStopTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_AdminStopTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).AdminStopTopic(ctx, req.(*AdminStopTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seminar_TestCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeminarServer).TestCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seminar_TestCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeminarServer).TestCredential(ctx, req.(*TestCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Seminar_ServiceDesc is the grpc.ServiceDesc for Seminar service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -609,6 +1111,10 @@ var Seminar_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopTopic",
 			Handler:    _Seminar_StopTopic_Handler,
 		},
+		{
+			MethodName: "InterjectTopic",
+			Handler:    _Seminar_InterjectTopic_Handler,
+		},
 		{
 			MethodName: "GetDocuments",
 			Handler:    _Seminar_GetDocuments_Handler,
@@ -637,6 +1143,54 @@ var Seminar_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisableMCPServer",
 			Handler:    _Seminar_DisableMCPServer_Handler,
 		},
+		{
+			MethodName: "GetMCPServerTools",
+			Handler:    _Seminar_GetMCPServerTools_Handler,
+		},
+		{
+			MethodName: "SetTopicTools",
+			Handler:    _Seminar_SetTopicTools_Handler,
+		},
+		{
+			MethodName: "SetTopicDocuments",
+			Handler:    _Seminar_SetTopicDocuments_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Seminar_GetUsage_Handler,
+		},
+		{
+			MethodName: "EstimateTopicCost",
+			Handler:    _Seminar_EstimateTopicCost_Handler,
+		},
+		{
+			MethodName: "GetTopicRuns",
+			Handler:    _Seminar_GetTopicRuns_Handler,
+		},
+		{
+			MethodName: "CheckQuota",
+			Handler:    _Seminar_CheckQuota_Handler,
+		},
+		{
+			MethodName: "RecordUsage",
+			Handler:    _Seminar_RecordUsage_Handler,
+		},
+		{
+			MethodName: "AdminGetUsage",
+			Handler:    _Seminar_AdminGetUsage_Handler,
+		},
+		{
+			MethodName: "AdminGetRunningTopics",
+			Handler:    _Seminar_AdminGetRunningTopics_Handler,
+		},
+		{
+			MethodName: "AdminStopTopic",
+			Handler:    _Seminar_AdminStopTopic_Handler,
+		},
+		{
+			MethodName: "TestCredential",
+			Handler:    _Seminar_TestCredential_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -649,6 +1203,11 @@ var Seminar_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Seminar_UploadDocument_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PreviewRole",
+			Handler:       _Seminar_PreviewRole_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gateway/seminar/v1/seminar.proto",
 }
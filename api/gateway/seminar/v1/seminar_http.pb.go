@@ -20,33 +20,65 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationSeminarAddMCPServer = "/Ayana.v1.Seminar/AddMCPServer"
+const OperationSeminarAdminGetRunningTopics = "/Ayana.v1.Seminar/AdminGetRunningTopics"
+const OperationSeminarAdminGetUsage = "/Ayana.v1.Seminar/AdminGetUsage"
+const OperationSeminarAdminStopTopic = "/Ayana.v1.Seminar/AdminStopTopic"
 const OperationSeminarCheckMCPServerHealth = "/Ayana.v1.Seminar/CheckMCPServerHealth"
 const OperationSeminarCreateTopic = "/Ayana.v1.Seminar/CreateTopic"
 const OperationSeminarDeleteMCPServer = "/Ayana.v1.Seminar/DeleteMCPServer"
 const OperationSeminarDeleteTopic = "/Ayana.v1.Seminar/DeleteTopic"
 const OperationSeminarDisableMCPServer = "/Ayana.v1.Seminar/DisableMCPServer"
 const OperationSeminarEnableMCPServer = "/Ayana.v1.Seminar/EnableMCPServer"
+const OperationSeminarEstimateTopicCost = "/Ayana.v1.Seminar/EstimateTopicCost"
 const OperationSeminarGetDocuments = "/Ayana.v1.Seminar/GetDocuments"
+const OperationSeminarGetMCPServerTools = "/Ayana.v1.Seminar/GetMCPServerTools"
 const OperationSeminarGetMCPServers = "/Ayana.v1.Seminar/GetMCPServers"
 const OperationSeminarGetTopic = "/Ayana.v1.Seminar/GetTopic"
+const OperationSeminarGetTopicRuns = "/Ayana.v1.Seminar/GetTopicRuns"
 const OperationSeminarGetTopicsMetadata = "/Ayana.v1.Seminar/GetTopicsMetadata"
+const OperationSeminarGetUsage = "/Ayana.v1.Seminar/GetUsage"
+const OperationSeminarInterjectTopic = "/Ayana.v1.Seminar/InterjectTopic"
+const OperationSeminarSetTopicDocuments = "/Ayana.v1.Seminar/SetTopicDocuments"
+const OperationSeminarSetTopicTools = "/Ayana.v1.Seminar/SetTopicTools"
 const OperationSeminarStopTopic = "/Ayana.v1.Seminar/StopTopic"
+const OperationSeminarTestCredential = "/Ayana.v1.Seminar/TestCredential"
 
 type SeminarHTTPServer interface {
 	AddMCPServer(context.Context, *AddMCPServerReqeust) (*AddMCPServerReply, error)
+	// AdminGetRunningTopics 列出正在运行的讨论，phone 为空时返回所有用户的
+	AdminGetRunningTopics(context.Context, *AdminGetRunningTopicsRequest) (*AdminGetRunningTopicsReply, error)
+	// AdminGetUsage 以下为管理接口，可以查看和操作任意用户的数据
+	AdminGetUsage(context.Context, *AdminGetUsageRequest) (*GetUsageReply, error)
+	AdminStopTopic(context.Context, *AdminStopTopicRequest) (*AdminStopTopicReply, error)
 	CheckMCPServerHealth(context.Context, *CheckMCPServerHealthReqeust) (*CheckMCPServerHealthReply, error)
 	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicReply, error)
 	DeleteMCPServer(context.Context, *DeleteMCPServerRequest) (*DeleteMCPServerReply, error)
 	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicReply, error)
 	DisableMCPServer(context.Context, *DisableMCPServerRequest) (*DisableMCPServerReply, error)
 	EnableMCPServer(context.Context, *EnableMCPServerRequest) (*EnableMCPServerReply, error)
+	// EstimateTopicCost 在开始讨论前按发言次数估算用量和费用，speeches 为 0 时按讨论的最大发言次数估算
+	EstimateTopicCost(context.Context, *EstimateTopicCostRequest) (*EstimateTopicCostReply, error)
 	GetDocuments(context.Context, *GetDocumentsRequest) (*GetDocumentsReply, error)
+	// GetMCPServerTools 列出 MCP 服务器提供的工具，用于为讨论中的角色配置工具白名单
+	GetMCPServerTools(context.Context, *GetMCPServerToolsRequest) (*GetMCPServerToolsReply, error)
 	GetMCPServers(context.Context, *GetMCPServersRequest) (*GetMCPServersReply, error)
 	// GetTopic 获取讨论主题的详细信息，进入讨论时加载
 	GetTopic(context.Context, *GetTopicRequest) (*GetTopicReply, error)
+	// GetTopicRuns 讨论的每次运行及其使用的角色版本快照
+	GetTopicRuns(context.Context, *GetTopicRunsRequest) (*GetTopicRunsReply, error)
 	// GetTopicsMetadata 获取用户所有讨论主题的元信息，用于前端展示
 	GetTopicsMetadata(context.Context, *GetTopicsMetadataRequest) (*GetTopicsMetadataReply, error)
+	// GetUsage 获取用户当日、当月的模型用量和配额
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageReply, error)
+	// InterjectTopic InterjectTopic 用户在讨论进行中插话，由正在运行该讨论的实例处理
+	InterjectTopic(context.Context, *InterjectTopicRequest) (*InterjectTopicReply, error)
+	// SetTopicDocuments 修改各角色的私有资料和主持人能否检索共享资料，下次开始讨论时生效
+	SetTopicDocuments(context.Context, *SetTopicDocumentsRequest) (*SetTopicDocumentsReply, error)
+	// SetTopicTools 修改讨论使用的 MCP 服务器和各角色的工具白名单，下次开始讨论时生效
+	SetTopicTools(context.Context, *SetTopicToolsRequest) (*SetTopicToolsReply, error)
 	StopTopic(context.Context, *StopTopicRequest) (*StopTopicReply, error)
+	// TestCredential 使用凭证请求一次模型列表，检查地址和密钥是否可用
+	TestCredential(context.Context, *TestCredentialRequest) (*TestCredentialReply, error)
 }
 
 func RegisterSeminarHTTPServer(s *http.Server, srv SeminarHTTPServer) {
@@ -56,6 +88,7 @@ func RegisterSeminarHTTPServer(s *http.Server, srv SeminarHTTPServer) {
 	r.POST("/seminar/topic/getting", _Seminar_GetTopic0_HTTP_Handler(srv))
 	r.POST("/seminar/topic/deleting", _Seminar_DeleteTopic0_HTTP_Handler(srv))
 	r.POST("/seminar/topic/stopping", _Seminar_StopTopic0_HTTP_Handler(srv))
+	r.POST("/seminar/topic/interjecting", _Seminar_InterjectTopic0_HTTP_Handler(srv))
 	r.POST("/seminar/document/getting", _Seminar_GetDocuments0_HTTP_Handler(srv))
 	r.POST("/seminar/mcp/add", _Seminar_AddMCPServer0_HTTP_Handler(srv))
 	r.POST("/seminar/mcp/getting", _Seminar_GetMCPServers0_HTTP_Handler(srv))
@@ -63,6 +96,16 @@ func RegisterSeminarHTTPServer(s *http.Server, srv SeminarHTTPServer) {
 	r.POST("/seminar/mcp/delete", _Seminar_DeleteMCPServer0_HTTP_Handler(srv))
 	r.POST("/seminar/mcp/enable", _Seminar_EnableMCPServer0_HTTP_Handler(srv))
 	r.POST("/seminar/mcp/disable", _Seminar_DisableMCPServer0_HTTP_Handler(srv))
+	r.POST("/seminar/mcp/tools/getting", _Seminar_GetMCPServerTools0_HTTP_Handler(srv))
+	r.POST("/seminar/topic/tools/setting", _Seminar_SetTopicTools0_HTTP_Handler(srv))
+	r.POST("/seminar/topic/documents/setting", _Seminar_SetTopicDocuments0_HTTP_Handler(srv))
+	r.POST("/seminar/usage/getting", _Seminar_GetUsage0_HTTP_Handler(srv))
+	r.POST("/seminar/topic/estimating", _Seminar_EstimateTopicCost0_HTTP_Handler(srv))
+	r.POST("/seminar/topic/runs/getting", _Seminar_GetTopicRuns0_HTTP_Handler(srv))
+	r.GET("/seminar/admin/usage", _Seminar_AdminGetUsage0_HTTP_Handler(srv))
+	r.GET("/seminar/admin/topics/running", _Seminar_AdminGetRunningTopics0_HTTP_Handler(srv))
+	r.POST("/seminar/admin/topic/stopping", _Seminar_AdminStopTopic0_HTTP_Handler(srv))
+	r.POST("/seminar/credential/testing", _Seminar_TestCredential0_HTTP_Handler(srv))
 }
 
 func _Seminar_CreateTopic0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
@@ -175,6 +218,28 @@ func _Seminar_StopTopic0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _Seminar_InterjectTopic0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InterjectTopicRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarInterjectTopic)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InterjectTopic(ctx, req.(*InterjectTopicRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InterjectTopicReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Seminar_GetDocuments0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetDocumentsRequest
@@ -329,19 +394,244 @@ func _Seminar_DisableMCPServer0_HTTP_Handler(srv SeminarHTTPServer) func(ctx htt
 	}
 }
 
+func _Seminar_GetMCPServerTools0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMCPServerToolsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarGetMCPServerTools)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMCPServerTools(ctx, req.(*GetMCPServerToolsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMCPServerToolsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_SetTopicTools0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetTopicToolsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarSetTopicTools)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetTopicTools(ctx, req.(*SetTopicToolsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetTopicToolsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_SetTopicDocuments0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetTopicDocumentsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarSetTopicDocuments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetTopicDocuments(ctx, req.(*SetTopicDocumentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetTopicDocumentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_GetUsage0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarGetUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsage(ctx, req.(*GetUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_EstimateTopicCost0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EstimateTopicCostRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarEstimateTopicCost)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EstimateTopicCost(ctx, req.(*EstimateTopicCostRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EstimateTopicCostReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_GetTopicRuns0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTopicRunsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarGetTopicRuns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTopicRuns(ctx, req.(*GetTopicRunsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTopicRunsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_AdminGetUsage0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminGetUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarAdminGetUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminGetUsage(ctx, req.(*AdminGetUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_AdminGetRunningTopics0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminGetRunningTopicsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarAdminGetRunningTopics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminGetRunningTopics(ctx, req.(*AdminGetRunningTopicsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminGetRunningTopicsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_AdminStopTopic0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminStopTopicRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarAdminStopTopic)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminStopTopic(ctx, req.(*AdminStopTopicRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminStopTopicReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Seminar_TestCredential0_HTTP_Handler(srv SeminarHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TestCredentialRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSeminarTestCredential)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TestCredential(ctx, req.(*TestCredentialRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TestCredentialReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SeminarHTTPClient interface {
 	AddMCPServer(ctx context.Context, req *AddMCPServerReqeust, opts ...http.CallOption) (rsp *AddMCPServerReply, err error)
+	AdminGetRunningTopics(ctx context.Context, req *AdminGetRunningTopicsRequest, opts ...http.CallOption) (rsp *AdminGetRunningTopicsReply, err error)
+	AdminGetUsage(ctx context.Context, req *AdminGetUsageRequest, opts ...http.CallOption) (rsp *GetUsageReply, err error)
+	AdminStopTopic(ctx context.Context, req *AdminStopTopicRequest, opts ...http.CallOption) (rsp *AdminStopTopicReply, err error)
 	CheckMCPServerHealth(ctx context.Context, req *CheckMCPServerHealthReqeust, opts ...http.CallOption) (rsp *CheckMCPServerHealthReply, err error)
 	CreateTopic(ctx context.Context, req *CreateTopicRequest, opts ...http.CallOption) (rsp *CreateTopicReply, err error)
 	DeleteMCPServer(ctx context.Context, req *DeleteMCPServerRequest, opts ...http.CallOption) (rsp *DeleteMCPServerReply, err error)
 	DeleteTopic(ctx context.Context, req *DeleteTopicRequest, opts ...http.CallOption) (rsp *DeleteTopicReply, err error)
 	DisableMCPServer(ctx context.Context, req *DisableMCPServerRequest, opts ...http.CallOption) (rsp *DisableMCPServerReply, err error)
 	EnableMCPServer(ctx context.Context, req *EnableMCPServerRequest, opts ...http.CallOption) (rsp *EnableMCPServerReply, err error)
+	EstimateTopicCost(ctx context.Context, req *EstimateTopicCostRequest, opts ...http.CallOption) (rsp *EstimateTopicCostReply, err error)
 	GetDocuments(ctx context.Context, req *GetDocumentsRequest, opts ...http.CallOption) (rsp *GetDocumentsReply, err error)
+	GetMCPServerTools(ctx context.Context, req *GetMCPServerToolsRequest, opts ...http.CallOption) (rsp *GetMCPServerToolsReply, err error)
 	GetMCPServers(ctx context.Context, req *GetMCPServersRequest, opts ...http.CallOption) (rsp *GetMCPServersReply, err error)
 	GetTopic(ctx context.Context, req *GetTopicRequest, opts ...http.CallOption) (rsp *GetTopicReply, err error)
+	GetTopicRuns(ctx context.Context, req *GetTopicRunsRequest, opts ...http.CallOption) (rsp *GetTopicRunsReply, err error)
 	GetTopicsMetadata(ctx context.Context, req *GetTopicsMetadataRequest, opts ...http.CallOption) (rsp *GetTopicsMetadataReply, err error)
+	GetUsage(ctx context.Context, req *GetUsageRequest, opts ...http.CallOption) (rsp *GetUsageReply, err error)
+	InterjectTopic(ctx context.Context, req *InterjectTopicRequest, opts ...http.CallOption) (rsp *InterjectTopicReply, err error)
+	SetTopicDocuments(ctx context.Context, req *SetTopicDocumentsRequest, opts ...http.CallOption) (rsp *SetTopicDocumentsReply, err error)
+	SetTopicTools(ctx context.Context, req *SetTopicToolsRequest, opts ...http.CallOption) (rsp *SetTopicToolsReply, err error)
 	StopTopic(ctx context.Context, req *StopTopicRequest, opts ...http.CallOption) (rsp *StopTopicReply, err error)
+	TestCredential(ctx context.Context, req *TestCredentialRequest, opts ...http.CallOption) (rsp *TestCredentialReply, err error)
 }
 
 type SeminarHTTPClientImpl struct {
@@ -365,6 +655,45 @@ func (c *SeminarHTTPClientImpl) AddMCPServer(ctx context.Context, in *AddMCPServ
 	return &out, nil
 }
 
+func (c *SeminarHTTPClientImpl) AdminGetRunningTopics(ctx context.Context, in *AdminGetRunningTopicsRequest, opts ...http.CallOption) (*AdminGetRunningTopicsReply, error) {
+	var out AdminGetRunningTopicsReply
+	pattern := "/seminar/admin/topics/running"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSeminarAdminGetRunningTopics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SeminarHTTPClientImpl) AdminGetUsage(ctx context.Context, in *AdminGetUsageRequest, opts ...http.CallOption) (*GetUsageReply, error) {
+	var out GetUsageReply
+	pattern := "/seminar/admin/usage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSeminarAdminGetUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SeminarHTTPClientImpl) AdminStopTopic(ctx context.Context, in *AdminStopTopicRequest, opts ...http.CallOption) (*AdminStopTopicReply, error) {
+	var out AdminStopTopicReply
+	pattern := "/seminar/admin/topic/stopping"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarAdminStopTopic))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SeminarHTTPClientImpl) CheckMCPServerHealth(ctx context.Context, in *CheckMCPServerHealthReqeust, opts ...http.CallOption) (*CheckMCPServerHealthReply, error) {
 	var out CheckMCPServerHealthReply
 	pattern := "/seminar/mcp/health"
@@ -443,6 +772,19 @@ func (c *SeminarHTTPClientImpl) EnableMCPServer(ctx context.Context, in *EnableM
 	return &out, nil
 }
 
+func (c *SeminarHTTPClientImpl) EstimateTopicCost(ctx context.Context, in *EstimateTopicCostRequest, opts ...http.CallOption) (*EstimateTopicCostReply, error) {
+	var out EstimateTopicCostReply
+	pattern := "/seminar/topic/estimating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarEstimateTopicCost))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SeminarHTTPClientImpl) GetDocuments(ctx context.Context, in *GetDocumentsRequest, opts ...http.CallOption) (*GetDocumentsReply, error) {
 	var out GetDocumentsReply
 	pattern := "/seminar/document/getting"
@@ -456,6 +798,19 @@ func (c *SeminarHTTPClientImpl) GetDocuments(ctx context.Context, in *GetDocumen
 	return &out, nil
 }
 
+func (c *SeminarHTTPClientImpl) GetMCPServerTools(ctx context.Context, in *GetMCPServerToolsRequest, opts ...http.CallOption) (*GetMCPServerToolsReply, error) {
+	var out GetMCPServerToolsReply
+	pattern := "/seminar/mcp/tools/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarGetMCPServerTools))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SeminarHTTPClientImpl) GetMCPServers(ctx context.Context, in *GetMCPServersRequest, opts ...http.CallOption) (*GetMCPServersReply, error) {
 	var out GetMCPServersReply
 	pattern := "/seminar/mcp/getting"
@@ -482,6 +837,19 @@ func (c *SeminarHTTPClientImpl) GetTopic(ctx context.Context, in *GetTopicReques
 	return &out, nil
 }
 
+func (c *SeminarHTTPClientImpl) GetTopicRuns(ctx context.Context, in *GetTopicRunsRequest, opts ...http.CallOption) (*GetTopicRunsReply, error) {
+	var out GetTopicRunsReply
+	pattern := "/seminar/topic/runs/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarGetTopicRuns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SeminarHTTPClientImpl) GetTopicsMetadata(ctx context.Context, in *GetTopicsMetadataRequest, opts ...http.CallOption) (*GetTopicsMetadataReply, error) {
 	var out GetTopicsMetadataReply
 	pattern := "/seminar/topic/metadata"
@@ -495,6 +863,58 @@ func (c *SeminarHTTPClientImpl) GetTopicsMetadata(ctx context.Context, in *GetTo
 	return &out, nil
 }
 
+func (c *SeminarHTTPClientImpl) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...http.CallOption) (*GetUsageReply, error) {
+	var out GetUsageReply
+	pattern := "/seminar/usage/getting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarGetUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SeminarHTTPClientImpl) InterjectTopic(ctx context.Context, in *InterjectTopicRequest, opts ...http.CallOption) (*InterjectTopicReply, error) {
+	var out InterjectTopicReply
+	pattern := "/seminar/topic/interjecting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarInterjectTopic))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SeminarHTTPClientImpl) SetTopicDocuments(ctx context.Context, in *SetTopicDocumentsRequest, opts ...http.CallOption) (*SetTopicDocumentsReply, error) {
+	var out SetTopicDocumentsReply
+	pattern := "/seminar/topic/documents/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarSetTopicDocuments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SeminarHTTPClientImpl) SetTopicTools(ctx context.Context, in *SetTopicToolsRequest, opts ...http.CallOption) (*SetTopicToolsReply, error) {
+	var out SetTopicToolsReply
+	pattern := "/seminar/topic/tools/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarSetTopicTools))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SeminarHTTPClientImpl) StopTopic(ctx context.Context, in *StopTopicRequest, opts ...http.CallOption) (*StopTopicReply, error) {
 	var out StopTopicReply
 	pattern := "/seminar/topic/stopping"
@@ -507,3 +927,16 @@ func (c *SeminarHTTPClientImpl) StopTopic(ctx context.Context, in *StopTopicRequ
 	}
 	return &out, nil
 }
+
+func (c *SeminarHTTPClientImpl) TestCredential(ctx context.Context, in *TestCredentialRequest, opts ...http.CallOption) (*TestCredentialReply, error) {
+	var out TestCredentialReply
+	pattern := "/seminar/credential/testing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSeminarTestCredential))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
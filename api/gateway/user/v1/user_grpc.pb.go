@@ -19,11 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_Register_FullMethodName     = "/Ayana.v1.User/Register"
-	User_Login_FullMethodName        = "/Ayana.v1.User/Login"
-	User_SetProfile_FullMethodName   = "/Ayana.v1.User/SetProfile"
-	User_GetProfile_FullMethodName   = "/Ayana.v1.User/GetProfile"
-	User_RefreshToken_FullMethodName = "/Ayana.v1.User/RefreshToken"
+	User_Register_FullMethodName                  = "/Ayana.v1.User/Register"
+	User_Login_FullMethodName                     = "/Ayana.v1.User/Login"
+	User_SetProfile_FullMethodName                = "/Ayana.v1.User/SetProfile"
+	User_GetProfile_FullMethodName                = "/Ayana.v1.User/GetProfile"
+	User_RefreshToken_FullMethodName              = "/Ayana.v1.User/RefreshToken"
+	User_Logout_FullMethodName                    = "/Ayana.v1.User/Logout"
+	User_LogoutAllDevices_FullMethodName          = "/Ayana.v1.User/LogoutAllDevices"
+	User_ListSessions_FullMethodName              = "/Ayana.v1.User/ListSessions"
+	User_ChangePassword_FullMethodName            = "/Ayana.v1.User/ChangePassword"
+	User_SendResetCode_FullMethodName             = "/Ayana.v1.User/SendResetCode"
+	User_ResetPassword_FullMethodName             = "/Ayana.v1.User/ResetPassword"
+	User_CreatePersonalAccessToken_FullMethodName = "/Ayana.v1.User/CreatePersonalAccessToken"
+	User_ListPersonalAccessTokens_FullMethodName  = "/Ayana.v1.User/ListPersonalAccessTokens"
+	User_RevokePersonalAccessToken_FullMethodName = "/Ayana.v1.User/RevokePersonalAccessToken"
+	User_VerifyPersonalAccessToken_FullMethodName = "/Ayana.v1.User/VerifyPersonalAccessToken"
+	User_CreateWorkspace_FullMethodName           = "/Ayana.v1.User/CreateWorkspace"
+	User_GetWorkspaces_FullMethodName             = "/Ayana.v1.User/GetWorkspaces"
+	User_GetWorkspaceMembers_FullMethodName       = "/Ayana.v1.User/GetWorkspaceMembers"
+	User_SetWorkspaceMember_FullMethodName        = "/Ayana.v1.User/SetWorkspaceMember"
+	User_RemoveWorkspaceMember_FullMethodName     = "/Ayana.v1.User/RemoveWorkspaceMember"
+	User_CreateWorkspaceInvite_FullMethodName     = "/Ayana.v1.User/CreateWorkspaceInvite"
+	User_GetWorkspaceInvites_FullMethodName       = "/Ayana.v1.User/GetWorkspaceInvites"
+	User_RevokeWorkspaceInvite_FullMethodName     = "/Ayana.v1.User/RevokeWorkspaceInvite"
+	User_AcceptWorkspaceInvite_FullMethodName     = "/Ayana.v1.User/AcceptWorkspaceInvite"
+	User_GetWorkspaceRole_FullMethodName          = "/Ayana.v1.User/GetWorkspaceRole"
+	User_AdminGetUsers_FullMethodName             = "/Ayana.v1.User/AdminGetUsers"
+	User_AdminSetUserStatus_FullMethodName        = "/Ayana.v1.User/AdminSetUserStatus"
+	User_AdminGetAuditLogs_FullMethodName         = "/Ayana.v1.User/AdminGetAuditLogs"
+	User_RecordAuditLog_FullMethodName            = "/Ayana.v1.User/RecordAuditLog"
+	User_CheckAdmin_FullMethodName                = "/Ayana.v1.User/CheckAdmin"
+	User_OIDCLogin_FullMethodName                 = "/Ayana.v1.User/OIDCLogin"
 )
 
 // UserClient is the client API for User service.
@@ -35,6 +61,48 @@ type UserClient interface {
 	SetProfile(ctx context.Context, in *SetProfileRequest, opts ...grpc.CallOption) (*SetProfileReply, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileReply, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenReply, error)
+	// 注销会话，sessionId 为空时注销当前会话
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error)
+	LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...grpc.CallOption) (*LogoutAllDevicesReply, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	// 已登录用户凭旧密码修改密码
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error)
+	// 忘记密码时向手机号发送验证码，手机号未注册时同样返回成功
+	SendResetCode(ctx context.Context, in *SendResetCodeRequest, opts ...grpc.CallOption) (*SendResetCodeReply, error)
+	// 凭验证码重置密码，同时解除账号锁定
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error)
+	// 创建个人访问令牌，完整令牌只在创建时返回一次
+	CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenRequest, opts ...grpc.CallOption) (*CreatePersonalAccessTokenReply, error)
+	ListPersonalAccessTokens(ctx context.Context, in *ListPersonalAccessTokensRequest, opts ...grpc.CallOption) (*ListPersonalAccessTokensReply, error)
+	RevokePersonalAccessToken(ctx context.Context, in *RevokePersonalAccessTokenRequest, opts ...grpc.CallOption) (*RevokePersonalAccessTokenReply, error)
+	// 网关鉴权时校验个人访问令牌，不对外暴露
+	VerifyPersonalAccessToken(ctx context.Context, in *VerifyPersonalAccessTokenRequest, opts ...grpc.CallOption) (*VerifyPersonalAccessTokenReply, error)
+	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceReply, error)
+	// 返回当前用户加入的所有工作区及其中的角色
+	GetWorkspaces(ctx context.Context, in *GetWorkspacesRequest, opts ...grpc.CallOption) (*GetWorkspacesReply, error)
+	GetWorkspaceMembers(ctx context.Context, in *GetWorkspaceMembersRequest, opts ...grpc.CallOption) (*GetWorkspaceMembersReply, error)
+	// 修改成员角色，只有所有者可以调用
+	SetWorkspaceMember(ctx context.Context, in *SetWorkspaceMemberRequest, opts ...grpc.CallOption) (*SetWorkspaceMemberReply, error)
+	// 所有者移除成员，或者成员自己退出工作区
+	RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberReply, error)
+	// 生成邀请链接，完整令牌只在创建时返回一次
+	CreateWorkspaceInvite(ctx context.Context, in *CreateWorkspaceInviteRequest, opts ...grpc.CallOption) (*CreateWorkspaceInviteReply, error)
+	GetWorkspaceInvites(ctx context.Context, in *GetWorkspaceInvitesRequest, opts ...grpc.CallOption) (*GetWorkspaceInvitesReply, error)
+	RevokeWorkspaceInvite(ctx context.Context, in *RevokeWorkspaceInviteRequest, opts ...grpc.CallOption) (*RevokeWorkspaceInviteReply, error)
+	AcceptWorkspaceInvite(ctx context.Context, in *AcceptWorkspaceInviteRequest, opts ...grpc.CallOption) (*AcceptWorkspaceInviteReply, error)
+	// 网关确定请求所在工作区时查询调用者的角色，不对外暴露
+	GetWorkspaceRole(ctx context.Context, in *GetWorkspaceRoleRequest, opts ...grpc.CallOption) (*GetWorkspaceRoleReply, error)
+	// 以下为管理接口，网关只允许带有管理员声明的令牌调用，并记录审计日志
+	AdminGetUsers(ctx context.Context, in *AdminGetUsersRequest, opts ...grpc.CallOption) (*AdminGetUsersReply, error)
+	// 停用账号后立即注销其所有会话，之后无法登录、刷新令牌或使用个人访问令牌
+	AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...grpc.CallOption) (*AdminSetUserStatusReply, error)
+	AdminGetAuditLogs(ctx context.Context, in *AdminGetAuditLogsRequest, opts ...grpc.CallOption) (*AdminGetAuditLogsReply, error)
+	// 网关记录管理操作，不对外暴露
+	RecordAuditLog(ctx context.Context, in *RecordAuditLogRequest, opts ...grpc.CallOption) (*RecordAuditLogReply, error)
+	// 网关执行会修改数据的管理操作前确认调用者仍是管理员，不对外暴露
+	CheckAdmin(ctx context.Context, in *CheckAdminRequest, opts ...grpc.CallOption) (*CheckAdminReply, error)
+	// 网关完成 OIDC 授权码流程并校验 ID Token 后调用，关联或创建用户并签发令牌，不对外暴露
+	OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*OIDCLoginReply, error)
 }
 
 type userClient struct {
@@ -95,6 +163,266 @@ func (c *userClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest,
 	return out, nil
 }
 
+func (c *userClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutReply)
+	err := c.cc.Invoke(ctx, User_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...grpc.CallOption) (*LogoutAllDevicesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutAllDevicesReply)
+	err := c.cc.Invoke(ctx, User_LogoutAllDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, User_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordReply)
+	err := c.cc.Invoke(ctx, User_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SendResetCode(ctx context.Context, in *SendResetCodeRequest, opts ...grpc.CallOption) (*SendResetCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendResetCodeReply)
+	err := c.cc.Invoke(ctx, User_SendResetCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordReply)
+	err := c.cc.Invoke(ctx, User_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenRequest, opts ...grpc.CallOption) (*CreatePersonalAccessTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePersonalAccessTokenReply)
+	err := c.cc.Invoke(ctx, User_CreatePersonalAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListPersonalAccessTokens(ctx context.Context, in *ListPersonalAccessTokensRequest, opts ...grpc.CallOption) (*ListPersonalAccessTokensReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPersonalAccessTokensReply)
+	err := c.cc.Invoke(ctx, User_ListPersonalAccessTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokePersonalAccessToken(ctx context.Context, in *RevokePersonalAccessTokenRequest, opts ...grpc.CallOption) (*RevokePersonalAccessTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokePersonalAccessTokenReply)
+	err := c.cc.Invoke(ctx, User_RevokePersonalAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) VerifyPersonalAccessToken(ctx context.Context, in *VerifyPersonalAccessTokenRequest, opts ...grpc.CallOption) (*VerifyPersonalAccessTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPersonalAccessTokenReply)
+	err := c.cc.Invoke(ctx, User_VerifyPersonalAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceReply)
+	err := c.cc.Invoke(ctx, User_CreateWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetWorkspaces(ctx context.Context, in *GetWorkspacesRequest, opts ...grpc.CallOption) (*GetWorkspacesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspacesReply)
+	err := c.cc.Invoke(ctx, User_GetWorkspaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetWorkspaceMembers(ctx context.Context, in *GetWorkspaceMembersRequest, opts ...grpc.CallOption) (*GetWorkspaceMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceMembersReply)
+	err := c.cc.Invoke(ctx, User_GetWorkspaceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetWorkspaceMember(ctx context.Context, in *SetWorkspaceMemberRequest, opts ...grpc.CallOption) (*SetWorkspaceMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWorkspaceMemberReply)
+	err := c.cc.Invoke(ctx, User_SetWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWorkspaceMemberReply)
+	err := c.cc.Invoke(ctx, User_RemoveWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateWorkspaceInvite(ctx context.Context, in *CreateWorkspaceInviteRequest, opts ...grpc.CallOption) (*CreateWorkspaceInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceInviteReply)
+	err := c.cc.Invoke(ctx, User_CreateWorkspaceInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetWorkspaceInvites(ctx context.Context, in *GetWorkspaceInvitesRequest, opts ...grpc.CallOption) (*GetWorkspaceInvitesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceInvitesReply)
+	err := c.cc.Invoke(ctx, User_GetWorkspaceInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeWorkspaceInvite(ctx context.Context, in *RevokeWorkspaceInviteRequest, opts ...grpc.CallOption) (*RevokeWorkspaceInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeWorkspaceInviteReply)
+	err := c.cc.Invoke(ctx, User_RevokeWorkspaceInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AcceptWorkspaceInvite(ctx context.Context, in *AcceptWorkspaceInviteRequest, opts ...grpc.CallOption) (*AcceptWorkspaceInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptWorkspaceInviteReply)
+	err := c.cc.Invoke(ctx, User_AcceptWorkspaceInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetWorkspaceRole(ctx context.Context, in *GetWorkspaceRoleRequest, opts ...grpc.CallOption) (*GetWorkspaceRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceRoleReply)
+	err := c.cc.Invoke(ctx, User_GetWorkspaceRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminGetUsers(ctx context.Context, in *AdminGetUsersRequest, opts ...grpc.CallOption) (*AdminGetUsersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetUsersReply)
+	err := c.cc.Invoke(ctx, User_AdminGetUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...grpc.CallOption) (*AdminSetUserStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSetUserStatusReply)
+	err := c.cc.Invoke(ctx, User_AdminSetUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminGetAuditLogs(ctx context.Context, in *AdminGetAuditLogsRequest, opts ...grpc.CallOption) (*AdminGetAuditLogsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetAuditLogsReply)
+	err := c.cc.Invoke(ctx, User_AdminGetAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RecordAuditLog(ctx context.Context, in *RecordAuditLogRequest, opts ...grpc.CallOption) (*RecordAuditLogReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordAuditLogReply)
+	err := c.cc.Invoke(ctx, User_RecordAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckAdmin(ctx context.Context, in *CheckAdminRequest, opts ...grpc.CallOption) (*CheckAdminReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAdminReply)
+	err := c.cc.Invoke(ctx, User_CheckAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*OIDCLoginReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OIDCLoginReply)
+	err := c.cc.Invoke(ctx, User_OIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -104,6 +432,48 @@ type UserServer interface {
 	SetProfile(context.Context, *SetProfileRequest) (*SetProfileReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
+	// 注销会话，sessionId 为空时注销当前会话
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	LogoutAllDevices(context.Context, *LogoutAllDevicesRequest) (*LogoutAllDevicesReply, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
+	// 已登录用户凭旧密码修改密码
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// 忘记密码时向手机号发送验证码，手机号未注册时同样返回成功
+	SendResetCode(context.Context, *SendResetCodeRequest) (*SendResetCodeReply, error)
+	// 凭验证码重置密码，同时解除账号锁定
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	// 创建个人访问令牌，完整令牌只在创建时返回一次
+	CreatePersonalAccessToken(context.Context, *CreatePersonalAccessTokenRequest) (*CreatePersonalAccessTokenReply, error)
+	ListPersonalAccessTokens(context.Context, *ListPersonalAccessTokensRequest) (*ListPersonalAccessTokensReply, error)
+	RevokePersonalAccessToken(context.Context, *RevokePersonalAccessTokenRequest) (*RevokePersonalAccessTokenReply, error)
+	// 网关鉴权时校验个人访问令牌，不对外暴露
+	VerifyPersonalAccessToken(context.Context, *VerifyPersonalAccessTokenRequest) (*VerifyPersonalAccessTokenReply, error)
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceReply, error)
+	// 返回当前用户加入的所有工作区及其中的角色
+	GetWorkspaces(context.Context, *GetWorkspacesRequest) (*GetWorkspacesReply, error)
+	GetWorkspaceMembers(context.Context, *GetWorkspaceMembersRequest) (*GetWorkspaceMembersReply, error)
+	// 修改成员角色，只有所有者可以调用
+	SetWorkspaceMember(context.Context, *SetWorkspaceMemberRequest) (*SetWorkspaceMemberReply, error)
+	// 所有者移除成员，或者成员自己退出工作区
+	RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberReply, error)
+	// 生成邀请链接，完整令牌只在创建时返回一次
+	CreateWorkspaceInvite(context.Context, *CreateWorkspaceInviteRequest) (*CreateWorkspaceInviteReply, error)
+	GetWorkspaceInvites(context.Context, *GetWorkspaceInvitesRequest) (*GetWorkspaceInvitesReply, error)
+	RevokeWorkspaceInvite(context.Context, *RevokeWorkspaceInviteRequest) (*RevokeWorkspaceInviteReply, error)
+	AcceptWorkspaceInvite(context.Context, *AcceptWorkspaceInviteRequest) (*AcceptWorkspaceInviteReply, error)
+	// 网关确定请求所在工作区时查询调用者的角色，不对外暴露
+	GetWorkspaceRole(context.Context, *GetWorkspaceRoleRequest) (*GetWorkspaceRoleReply, error)
+	// 以下为管理接口，网关只允许带有管理员声明的令牌调用，并记录审计日志
+	AdminGetUsers(context.Context, *AdminGetUsersRequest) (*AdminGetUsersReply, error)
+	// 停用账号后立即注销其所有会话，之后无法登录、刷新令牌或使用个人访问令牌
+	AdminSetUserStatus(context.Context, *AdminSetUserStatusRequest) (*AdminSetUserStatusReply, error)
+	AdminGetAuditLogs(context.Context, *AdminGetAuditLogsRequest) (*AdminGetAuditLogsReply, error)
+	// 网关记录管理操作，不对外暴露
+	RecordAuditLog(context.Context, *RecordAuditLogRequest) (*RecordAuditLogReply, error)
+	// 网关执行会修改数据的管理操作前确认调用者仍是管理员，不对外暴露
+	CheckAdmin(context.Context, *CheckAdminRequest) (*CheckAdminReply, error)
+	// 网关完成 OIDC 授权码流程并校验 ID Token 后调用，关联或创建用户并签发令牌，不对外暴露
+	OIDCLogin(context.Context, *OIDCLoginRequest) (*OIDCLoginReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -129,6 +499,84 @@ func (UnimplementedUserServer) GetProfile(context.Context, *GetProfileRequest) (
 func (UnimplementedUserServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
 }
+func (UnimplementedUserServer) Logout(context.Context, *LogoutRequest) (*LogoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUserServer) LogoutAllDevices(context.Context, *LogoutAllDevicesRequest) (*LogoutAllDevicesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllDevices not implemented")
+}
+func (UnimplementedUserServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServer) SendResetCode(context.Context, *SendResetCodeRequest) (*SendResetCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendResetCode not implemented")
+}
+func (UnimplementedUserServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServer) CreatePersonalAccessToken(context.Context, *CreatePersonalAccessTokenRequest) (*CreatePersonalAccessTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePersonalAccessToken not implemented")
+}
+func (UnimplementedUserServer) ListPersonalAccessTokens(context.Context, *ListPersonalAccessTokensRequest) (*ListPersonalAccessTokensReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonalAccessTokens not implemented")
+}
+func (UnimplementedUserServer) RevokePersonalAccessToken(context.Context, *RevokePersonalAccessTokenRequest) (*RevokePersonalAccessTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePersonalAccessToken not implemented")
+}
+func (UnimplementedUserServer) VerifyPersonalAccessToken(context.Context, *VerifyPersonalAccessTokenRequest) (*VerifyPersonalAccessTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPersonalAccessToken not implemented")
+}
+func (UnimplementedUserServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
+}
+func (UnimplementedUserServer) GetWorkspaces(context.Context, *GetWorkspacesRequest) (*GetWorkspacesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaces not implemented")
+}
+func (UnimplementedUserServer) GetWorkspaceMembers(context.Context, *GetWorkspaceMembersRequest) (*GetWorkspaceMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceMembers not implemented")
+}
+func (UnimplementedUserServer) SetWorkspaceMember(context.Context, *SetWorkspaceMemberRequest) (*SetWorkspaceMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkspaceMember not implemented")
+}
+func (UnimplementedUserServer) RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkspaceMember not implemented")
+}
+func (UnimplementedUserServer) CreateWorkspaceInvite(context.Context, *CreateWorkspaceInviteRequest) (*CreateWorkspaceInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceInvite not implemented")
+}
+func (UnimplementedUserServer) GetWorkspaceInvites(context.Context, *GetWorkspaceInvitesRequest) (*GetWorkspaceInvitesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceInvites not implemented")
+}
+func (UnimplementedUserServer) RevokeWorkspaceInvite(context.Context, *RevokeWorkspaceInviteRequest) (*RevokeWorkspaceInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeWorkspaceInvite not implemented")
+}
+func (UnimplementedUserServer) AcceptWorkspaceInvite(context.Context, *AcceptWorkspaceInviteRequest) (*AcceptWorkspaceInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWorkspaceInvite not implemented")
+}
+func (UnimplementedUserServer) GetWorkspaceRole(context.Context, *GetWorkspaceRoleRequest) (*GetWorkspaceRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceRole not implemented")
+}
+func (UnimplementedUserServer) AdminGetUsers(context.Context, *AdminGetUsersRequest) (*AdminGetUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetUsers not implemented")
+}
+func (UnimplementedUserServer) AdminSetUserStatus(context.Context, *AdminSetUserStatusRequest) (*AdminSetUserStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetUserStatus not implemented")
+}
+func (UnimplementedUserServer) AdminGetAuditLogs(context.Context, *AdminGetAuditLogsRequest) (*AdminGetAuditLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetAuditLogs not implemented")
+}
+func (UnimplementedUserServer) RecordAuditLog(context.Context, *RecordAuditLogRequest) (*RecordAuditLogReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAuditLog not implemented")
+}
+func (UnimplementedUserServer) CheckAdmin(context.Context, *CheckAdminRequest) (*CheckAdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAdmin not implemented")
+}
+func (UnimplementedUserServer) OIDCLogin(context.Context, *OIDCLoginRequest) (*OIDCLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCLogin not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -240,32 +688,604 @@ func _User_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-// User_ServiceDesc is the grpc.ServiceDesc for User service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var User_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "Ayana.v1.User",
-	HandlerType: (*UserServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Register",
-			Handler:    _User_Register_Handler,
-		},
-		{
-			MethodName: "Login",
-			Handler:    _User_Login_Handler,
-		},
-		{
-			MethodName: "SetProfile",
-			Handler:    _User_SetProfile_Handler,
-		},
-		{
-			MethodName: "GetProfile",
-			Handler:    _User_GetProfile_Handler,
-		},
-		{
-			MethodName: "RefreshToken",
-			Handler:    _User_RefreshToken_Handler,
+func _User_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LogoutAllDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LogoutAllDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_LogoutAllDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LogoutAllDevices(ctx, req.(*LogoutAllDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendResetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendResetCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendResetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SendResetCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendResetCode(ctx, req.(*SendResetCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreatePersonalAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonalAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreatePersonalAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreatePersonalAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreatePersonalAccessToken(ctx, req.(*CreatePersonalAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListPersonalAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListPersonalAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListPersonalAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListPersonalAccessTokens(ctx, req.(*ListPersonalAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokePersonalAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePersonalAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokePersonalAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokePersonalAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokePersonalAccessToken(ctx, req.(*RevokePersonalAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_VerifyPersonalAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPersonalAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).VerifyPersonalAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_VerifyPersonalAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).VerifyPersonalAccessToken(ctx, req.(*VerifyPersonalAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetWorkspaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetWorkspaces(ctx, req.(*GetWorkspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetWorkspaceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetWorkspaceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetWorkspaceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetWorkspaceMembers(ctx, req.(*GetWorkspaceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetWorkspaceMember(ctx, req.(*SetWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RemoveWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RemoveWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RemoveWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RemoveWorkspaceMember(ctx, req.(*RemoveWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateWorkspaceInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateWorkspaceInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateWorkspaceInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateWorkspaceInvite(ctx, req.(*CreateWorkspaceInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetWorkspaceInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetWorkspaceInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetWorkspaceInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetWorkspaceInvites(ctx, req.(*GetWorkspaceInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeWorkspaceInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeWorkspaceInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeWorkspaceInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeWorkspaceInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeWorkspaceInvite(ctx, req.(*RevokeWorkspaceInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AcceptWorkspaceInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptWorkspaceInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AcceptWorkspaceInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AcceptWorkspaceInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AcceptWorkspaceInvite(ctx, req.(*AcceptWorkspaceInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetWorkspaceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetWorkspaceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetWorkspaceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetWorkspaceRole(ctx, req.(*GetWorkspaceRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminGetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminGetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminGetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminGetUsers(ctx, req.(*AdminGetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminSetUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminSetUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminSetUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminSetUserStatus(ctx, req.(*AdminSetUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminGetAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminGetAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminGetAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminGetAuditLogs(ctx, req.(*AdminGetAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RecordAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RecordAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RecordAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RecordAuditLog(ctx, req.(*RecordAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CheckAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckAdmin(ctx, req.(*CheckAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_OIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).OIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_OIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).OIDCLogin(ctx, req.(*OIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// User_ServiceDesc is the grpc.ServiceDesc for User service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var User_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Ayana.v1.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _User_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _User_Login_Handler,
+		},
+		{
+			MethodName: "SetProfile",
+			Handler:    _User_SetProfile_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _User_GetProfile_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _User_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _User_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllDevices",
+			Handler:    _User_LogoutAllDevices_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _User_ListSessions_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _User_ChangePassword_Handler,
+		},
+		{
+			MethodName: "SendResetCode",
+			Handler:    _User_SendResetCode_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _User_ResetPassword_Handler,
+		},
+		{
+			MethodName: "CreatePersonalAccessToken",
+			Handler:    _User_CreatePersonalAccessToken_Handler,
+		},
+		{
+			MethodName: "ListPersonalAccessTokens",
+			Handler:    _User_ListPersonalAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokePersonalAccessToken",
+			Handler:    _User_RevokePersonalAccessToken_Handler,
+		},
+		{
+			MethodName: "VerifyPersonalAccessToken",
+			Handler:    _User_VerifyPersonalAccessToken_Handler,
+		},
+		{
+			MethodName: "CreateWorkspace",
+			Handler:    _User_CreateWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspaces",
+			Handler:    _User_GetWorkspaces_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceMembers",
+			Handler:    _User_GetWorkspaceMembers_Handler,
+		},
+		{
+			MethodName: "SetWorkspaceMember",
+			Handler:    _User_SetWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "RemoveWorkspaceMember",
+			Handler:    _User_RemoveWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceInvite",
+			Handler:    _User_CreateWorkspaceInvite_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceInvites",
+			Handler:    _User_GetWorkspaceInvites_Handler,
+		},
+		{
+			MethodName: "RevokeWorkspaceInvite",
+			Handler:    _User_RevokeWorkspaceInvite_Handler,
+		},
+		{
+			MethodName: "AcceptWorkspaceInvite",
+			Handler:    _User_AcceptWorkspaceInvite_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceRole",
+			Handler:    _User_GetWorkspaceRole_Handler,
+		},
+		{
+			MethodName: "AdminGetUsers",
+			Handler:    _User_AdminGetUsers_Handler,
+		},
+		{
+			MethodName: "AdminSetUserStatus",
+			Handler:    _User_AdminSetUserStatus_Handler,
+		},
+		{
+			MethodName: "AdminGetAuditLogs",
+			Handler:    _User_AdminGetAuditLogs_Handler,
+		},
+		{
+			MethodName: "RecordAuditLog",
+			Handler:    _User_RecordAuditLog_Handler,
+		},
+		{
+			MethodName: "CheckAdmin",
+			Handler:    _User_CheckAdmin_Handler,
+		},
+		{
+			MethodName: "OIDCLogin",
+			Handler:    _User_OIDCLogin_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -19,18 +19,71 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserAcceptWorkspaceInvite = "/Ayana.v1.User/AcceptWorkspaceInvite"
+const OperationUserAdminGetAuditLogs = "/Ayana.v1.User/AdminGetAuditLogs"
+const OperationUserAdminGetUsers = "/Ayana.v1.User/AdminGetUsers"
+const OperationUserAdminSetUserStatus = "/Ayana.v1.User/AdminSetUserStatus"
+const OperationUserChangePassword = "/Ayana.v1.User/ChangePassword"
+const OperationUserCreatePersonalAccessToken = "/Ayana.v1.User/CreatePersonalAccessToken"
+const OperationUserCreateWorkspace = "/Ayana.v1.User/CreateWorkspace"
+const OperationUserCreateWorkspaceInvite = "/Ayana.v1.User/CreateWorkspaceInvite"
 const OperationUserGetProfile = "/Ayana.v1.User/GetProfile"
+const OperationUserGetWorkspaceInvites = "/Ayana.v1.User/GetWorkspaceInvites"
+const OperationUserGetWorkspaceMembers = "/Ayana.v1.User/GetWorkspaceMembers"
+const OperationUserGetWorkspaces = "/Ayana.v1.User/GetWorkspaces"
+const OperationUserListPersonalAccessTokens = "/Ayana.v1.User/ListPersonalAccessTokens"
+const OperationUserListSessions = "/Ayana.v1.User/ListSessions"
 const OperationUserLogin = "/Ayana.v1.User/Login"
+const OperationUserLogout = "/Ayana.v1.User/Logout"
+const OperationUserLogoutAllDevices = "/Ayana.v1.User/LogoutAllDevices"
 const OperationUserRefreshToken = "/Ayana.v1.User/RefreshToken"
 const OperationUserRegister = "/Ayana.v1.User/Register"
+const OperationUserRemoveWorkspaceMember = "/Ayana.v1.User/RemoveWorkspaceMember"
+const OperationUserResetPassword = "/Ayana.v1.User/ResetPassword"
+const OperationUserRevokePersonalAccessToken = "/Ayana.v1.User/RevokePersonalAccessToken"
+const OperationUserRevokeWorkspaceInvite = "/Ayana.v1.User/RevokeWorkspaceInvite"
+const OperationUserSendResetCode = "/Ayana.v1.User/SendResetCode"
 const OperationUserSetProfile = "/Ayana.v1.User/SetProfile"
+const OperationUserSetWorkspaceMember = "/Ayana.v1.User/SetWorkspaceMember"
 
 type UserHTTPServer interface {
+	AcceptWorkspaceInvite(context.Context, *AcceptWorkspaceInviteRequest) (*AcceptWorkspaceInviteReply, error)
+	AdminGetAuditLogs(context.Context, *AdminGetAuditLogsRequest) (*AdminGetAuditLogsReply, error)
+	// AdminGetUsers 以下为管理接口，网关只允许带有管理员声明的令牌调用，并记录审计日志
+	AdminGetUsers(context.Context, *AdminGetUsersRequest) (*AdminGetUsersReply, error)
+	// AdminSetUserStatus 停用账号后立即注销其所有会话，之后无法登录、刷新令牌或使用个人访问令牌
+	AdminSetUserStatus(context.Context, *AdminSetUserStatusRequest) (*AdminSetUserStatusReply, error)
+	// ChangePassword 已登录用户凭旧密码修改密码
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// CreatePersonalAccessToken 创建个人访问令牌，完整令牌只在创建时返回一次
+	CreatePersonalAccessToken(context.Context, *CreatePersonalAccessTokenRequest) (*CreatePersonalAccessTokenReply, error)
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceReply, error)
+	// CreateWorkspaceInvite 生成邀请链接，完整令牌只在创建时返回一次
+	CreateWorkspaceInvite(context.Context, *CreateWorkspaceInviteRequest) (*CreateWorkspaceInviteReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
+	GetWorkspaceInvites(context.Context, *GetWorkspaceInvitesRequest) (*GetWorkspaceInvitesReply, error)
+	GetWorkspaceMembers(context.Context, *GetWorkspaceMembersRequest) (*GetWorkspaceMembersReply, error)
+	// GetWorkspaces 返回当前用户加入的所有工作区及其中的角色
+	GetWorkspaces(context.Context, *GetWorkspacesRequest) (*GetWorkspacesReply, error)
+	ListPersonalAccessTokens(context.Context, *ListPersonalAccessTokensRequest) (*ListPersonalAccessTokensReply, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// Logout 注销会话，sessionId 为空时注销当前会话
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	LogoutAllDevices(context.Context, *LogoutAllDevicesRequest) (*LogoutAllDevicesReply, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	// RemoveWorkspaceMember 所有者移除成员，或者成员自己退出工作区
+	RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberReply, error)
+	// ResetPassword 凭验证码重置密码，同时解除账号锁定
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	RevokePersonalAccessToken(context.Context, *RevokePersonalAccessTokenRequest) (*RevokePersonalAccessTokenReply, error)
+	RevokeWorkspaceInvite(context.Context, *RevokeWorkspaceInviteRequest) (*RevokeWorkspaceInviteReply, error)
+	// SendResetCode 忘记密码时向手机号发送验证码，手机号未注册时同样返回成功
+	SendResetCode(context.Context, *SendResetCodeRequest) (*SendResetCodeReply, error)
 	SetProfile(context.Context, *SetProfileRequest) (*SetProfileReply, error)
+	// SetWorkspaceMember 修改成员角色，只有所有者可以调用
+	SetWorkspaceMember(context.Context, *SetWorkspaceMemberRequest) (*SetWorkspaceMemberReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
@@ -40,6 +93,27 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.POST("/user/profile/setting", _User_SetProfile0_HTTP_Handler(srv))
 	r.GET("/user/profile", _User_GetProfile0_HTTP_Handler(srv))
 	r.POST("/user/refresh", _User_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/user/logout", _User_Logout0_HTTP_Handler(srv))
+	r.POST("/user/logout/all", _User_LogoutAllDevices0_HTTP_Handler(srv))
+	r.GET("/user/sessions", _User_ListSessions0_HTTP_Handler(srv))
+	r.POST("/user/password/changing", _User_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/user/password/code", _User_SendResetCode0_HTTP_Handler(srv))
+	r.POST("/user/password/resetting", _User_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/user/token/creating", _User_CreatePersonalAccessToken0_HTTP_Handler(srv))
+	r.GET("/user/tokens", _User_ListPersonalAccessTokens0_HTTP_Handler(srv))
+	r.POST("/user/token/revoking", _User_RevokePersonalAccessToken0_HTTP_Handler(srv))
+	r.POST("/workspace/creating", _User_CreateWorkspace0_HTTP_Handler(srv))
+	r.GET("/workspaces", _User_GetWorkspaces0_HTTP_Handler(srv))
+	r.GET("/workspace/members", _User_GetWorkspaceMembers0_HTTP_Handler(srv))
+	r.POST("/workspace/member/setting", _User_SetWorkspaceMember0_HTTP_Handler(srv))
+	r.POST("/workspace/member/removing", _User_RemoveWorkspaceMember0_HTTP_Handler(srv))
+	r.POST("/workspace/invite/creating", _User_CreateWorkspaceInvite0_HTTP_Handler(srv))
+	r.GET("/workspace/invites", _User_GetWorkspaceInvites0_HTTP_Handler(srv))
+	r.POST("/workspace/invite/revoking", _User_RevokeWorkspaceInvite0_HTTP_Handler(srv))
+	r.POST("/workspace/invite/accepting", _User_AcceptWorkspaceInvite0_HTTP_Handler(srv))
+	r.GET("/user/admin/users", _User_AdminGetUsers0_HTTP_Handler(srv))
+	r.POST("/user/admin/user/status/setting", _User_AdminSetUserStatus0_HTTP_Handler(srv))
+	r.GET("/user/admin/audit/logs", _User_AdminGetAuditLogs0_HTTP_Handler(srv))
 }
 
 func _User_Register0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -149,12 +223,474 @@ func _User_RefreshToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _User_Logout0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_LogoutAllDevices0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutAllDevicesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogoutAllDevices)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAllDevices(ctx, req.(*LogoutAllDevicesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutAllDevicesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListSessions0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ChangePassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SendResetCode0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendResetCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSendResetCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendResetCode(ctx, req.(*SendResetCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendResetCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ResetPassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CreatePersonalAccessToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreatePersonalAccessTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCreatePersonalAccessToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePersonalAccessToken(ctx, req.(*CreatePersonalAccessTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreatePersonalAccessTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListPersonalAccessTokens0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPersonalAccessTokensRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListPersonalAccessTokens)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPersonalAccessTokens(ctx, req.(*ListPersonalAccessTokensRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPersonalAccessTokensReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RevokePersonalAccessToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokePersonalAccessTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRevokePersonalAccessToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokePersonalAccessToken(ctx, req.(*RevokePersonalAccessTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokePersonalAccessTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CreateWorkspace0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWorkspaceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCreateWorkspace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateWorkspaceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GetWorkspaces0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWorkspacesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetWorkspaces)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWorkspaces(ctx, req.(*GetWorkspacesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetWorkspacesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GetWorkspaceMembers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWorkspaceMembersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetWorkspaceMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWorkspaceMembers(ctx, req.(*GetWorkspaceMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetWorkspaceMembersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SetWorkspaceMember0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetWorkspaceMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSetWorkspaceMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetWorkspaceMember(ctx, req.(*SetWorkspaceMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetWorkspaceMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RemoveWorkspaceMember0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveWorkspaceMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRemoveWorkspaceMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveWorkspaceMember(ctx, req.(*RemoveWorkspaceMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveWorkspaceMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CreateWorkspaceInvite0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWorkspaceInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCreateWorkspaceInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWorkspaceInvite(ctx, req.(*CreateWorkspaceInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateWorkspaceInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GetWorkspaceInvites0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWorkspaceInvitesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetWorkspaceInvites)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWorkspaceInvites(ctx, req.(*GetWorkspaceInvitesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetWorkspaceInvitesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RevokeWorkspaceInvite0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeWorkspaceInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRevokeWorkspaceInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeWorkspaceInvite(ctx, req.(*RevokeWorkspaceInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeWorkspaceInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AcceptWorkspaceInvite0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptWorkspaceInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAcceptWorkspaceInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptWorkspaceInvite(ctx, req.(*AcceptWorkspaceInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcceptWorkspaceInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminGetUsers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminGetUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminGetUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminGetUsers(ctx, req.(*AdminGetUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminGetUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminSetUserStatus0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSetUserStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminSetUserStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSetUserStatus(ctx, req.(*AdminSetUserStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSetUserStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminGetAuditLogs0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminGetAuditLogsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminGetAuditLogs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminGetAuditLogs(ctx, req.(*AdminGetAuditLogsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminGetAuditLogsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserHTTPClient interface {
+	AcceptWorkspaceInvite(ctx context.Context, req *AcceptWorkspaceInviteRequest, opts ...http.CallOption) (rsp *AcceptWorkspaceInviteReply, err error)
+	AdminGetAuditLogs(ctx context.Context, req *AdminGetAuditLogsRequest, opts ...http.CallOption) (rsp *AdminGetAuditLogsReply, err error)
+	AdminGetUsers(ctx context.Context, req *AdminGetUsersRequest, opts ...http.CallOption) (rsp *AdminGetUsersReply, err error)
+	AdminSetUserStatus(ctx context.Context, req *AdminSetUserStatusRequest, opts ...http.CallOption) (rsp *AdminSetUserStatusReply, err error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordReply, err error)
+	CreatePersonalAccessToken(ctx context.Context, req *CreatePersonalAccessTokenRequest, opts ...http.CallOption) (rsp *CreatePersonalAccessTokenReply, err error)
+	CreateWorkspace(ctx context.Context, req *CreateWorkspaceRequest, opts ...http.CallOption) (rsp *CreateWorkspaceReply, err error)
+	CreateWorkspaceInvite(ctx context.Context, req *CreateWorkspaceInviteRequest, opts ...http.CallOption) (rsp *CreateWorkspaceInviteReply, err error)
 	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *GetProfileReply, err error)
+	GetWorkspaceInvites(ctx context.Context, req *GetWorkspaceInvitesRequest, opts ...http.CallOption) (rsp *GetWorkspaceInvitesReply, err error)
+	GetWorkspaceMembers(ctx context.Context, req *GetWorkspaceMembersRequest, opts ...http.CallOption) (rsp *GetWorkspaceMembersReply, err error)
+	GetWorkspaces(ctx context.Context, req *GetWorkspacesRequest, opts ...http.CallOption) (rsp *GetWorkspacesReply, err error)
+	ListPersonalAccessTokens(ctx context.Context, req *ListPersonalAccessTokensRequest, opts ...http.CallOption) (rsp *ListPersonalAccessTokensReply, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	LogoutAllDevices(ctx context.Context, req *LogoutAllDevicesRequest, opts ...http.CallOption) (rsp *LogoutAllDevicesReply, err error)
 	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	RemoveWorkspaceMember(ctx context.Context, req *RemoveWorkspaceMemberRequest, opts ...http.CallOption) (rsp *RemoveWorkspaceMemberReply, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
+	RevokePersonalAccessToken(ctx context.Context, req *RevokePersonalAccessTokenRequest, opts ...http.CallOption) (rsp *RevokePersonalAccessTokenReply, err error)
+	RevokeWorkspaceInvite(ctx context.Context, req *RevokeWorkspaceInviteRequest, opts ...http.CallOption) (rsp *RevokeWorkspaceInviteReply, err error)
+	SendResetCode(ctx context.Context, req *SendResetCodeRequest, opts ...http.CallOption) (rsp *SendResetCodeReply, err error)
 	SetProfile(ctx context.Context, req *SetProfileRequest, opts ...http.CallOption) (rsp *SetProfileReply, err error)
+	SetWorkspaceMember(ctx context.Context, req *SetWorkspaceMemberRequest, opts ...http.CallOption) (rsp *SetWorkspaceMemberReply, err error)
 }
 
 type UserHTTPClientImpl struct {
@@ -165,6 +701,110 @@ func NewUserHTTPClient(client *http.Client) UserHTTPClient {
 	return &UserHTTPClientImpl{client}
 }
 
+func (c *UserHTTPClientImpl) AcceptWorkspaceInvite(ctx context.Context, in *AcceptWorkspaceInviteRequest, opts ...http.CallOption) (*AcceptWorkspaceInviteReply, error) {
+	var out AcceptWorkspaceInviteReply
+	pattern := "/workspace/invite/accepting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAcceptWorkspaceInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) AdminGetAuditLogs(ctx context.Context, in *AdminGetAuditLogsRequest, opts ...http.CallOption) (*AdminGetAuditLogsReply, error) {
+	var out AdminGetAuditLogsReply
+	pattern := "/user/admin/audit/logs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminGetAuditLogs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) AdminGetUsers(ctx context.Context, in *AdminGetUsersRequest, opts ...http.CallOption) (*AdminGetUsersReply, error) {
+	var out AdminGetUsersReply
+	pattern := "/user/admin/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminGetUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...http.CallOption) (*AdminSetUserStatusReply, error) {
+	var out AdminSetUserStatusReply
+	pattern := "/user/admin/user/status/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminSetUserStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordReply, error) {
+	var out ChangePasswordReply
+	pattern := "/user/password/changing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenRequest, opts ...http.CallOption) (*CreatePersonalAccessTokenReply, error) {
+	var out CreatePersonalAccessTokenReply
+	pattern := "/user/token/creating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreatePersonalAccessToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...http.CallOption) (*CreateWorkspaceReply, error) {
+	var out CreateWorkspaceReply
+	pattern := "/workspace/creating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreateWorkspace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) CreateWorkspaceInvite(ctx context.Context, in *CreateWorkspaceInviteRequest, opts ...http.CallOption) (*CreateWorkspaceInviteReply, error) {
+	var out CreateWorkspaceInviteReply
+	pattern := "/workspace/invite/creating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreateWorkspaceInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*GetProfileReply, error) {
 	var out GetProfileReply
 	pattern := "/user/profile"
@@ -178,6 +818,71 @@ func (c *UserHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileReque
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) GetWorkspaceInvites(ctx context.Context, in *GetWorkspaceInvitesRequest, opts ...http.CallOption) (*GetWorkspaceInvitesReply, error) {
+	var out GetWorkspaceInvitesReply
+	pattern := "/workspace/invites"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetWorkspaceInvites))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) GetWorkspaceMembers(ctx context.Context, in *GetWorkspaceMembersRequest, opts ...http.CallOption) (*GetWorkspaceMembersReply, error) {
+	var out GetWorkspaceMembersReply
+	pattern := "/workspace/members"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetWorkspaceMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) GetWorkspaces(ctx context.Context, in *GetWorkspacesRequest, opts ...http.CallOption) (*GetWorkspacesReply, error) {
+	var out GetWorkspacesReply
+	pattern := "/workspaces"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetWorkspaces))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListPersonalAccessTokens(ctx context.Context, in *ListPersonalAccessTokensRequest, opts ...http.CallOption) (*ListPersonalAccessTokensReply, error) {
+	var out ListPersonalAccessTokensReply
+	pattern := "/user/tokens"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListPersonalAccessTokens))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/user/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/user/login"
@@ -191,6 +896,32 @@ func (c *UserHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts .
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/user/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesRequest, opts ...http.CallOption) (*LogoutAllDevicesReply, error) {
+	var out LogoutAllDevicesReply
+	pattern := "/user/logout/all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogoutAllDevices))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
 	var out RefreshTokenReply
 	pattern := "/user/refresh"
@@ -217,6 +948,71 @@ func (c *UserHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest,
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...http.CallOption) (*RemoveWorkspaceMemberReply, error) {
+	var out RemoveWorkspaceMemberReply
+	pattern := "/workspace/member/removing"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRemoveWorkspaceMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordReply, error) {
+	var out ResetPasswordReply
+	pattern := "/user/password/resetting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RevokePersonalAccessToken(ctx context.Context, in *RevokePersonalAccessTokenRequest, opts ...http.CallOption) (*RevokePersonalAccessTokenReply, error) {
+	var out RevokePersonalAccessTokenReply
+	pattern := "/user/token/revoking"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRevokePersonalAccessToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RevokeWorkspaceInvite(ctx context.Context, in *RevokeWorkspaceInviteRequest, opts ...http.CallOption) (*RevokeWorkspaceInviteReply, error) {
+	var out RevokeWorkspaceInviteReply
+	pattern := "/workspace/invite/revoking"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRevokeWorkspaceInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) SendResetCode(ctx context.Context, in *SendResetCodeRequest, opts ...http.CallOption) (*SendResetCodeReply, error) {
+	var out SendResetCodeReply
+	pattern := "/user/password/code"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSendResetCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) SetProfile(ctx context.Context, in *SetProfileRequest, opts ...http.CallOption) (*SetProfileReply, error) {
 	var out SetProfileReply
 	pattern := "/user/profile/setting"
@@ -229,3 +1025,16 @@ func (c *UserHTTPClientImpl) SetProfile(ctx context.Context, in *SetProfileReque
 	}
 	return &out, nil
 }
+
+func (c *UserHTTPClientImpl) SetWorkspaceMember(ctx context.Context, in *SetWorkspaceMemberRequest, opts ...http.CallOption) (*SetWorkspaceMemberReply, error) {
+	var out SetWorkspaceMemberReply
+	pattern := "/workspace/member/setting"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSetWorkspaceMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
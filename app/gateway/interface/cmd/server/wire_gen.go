@@ -28,23 +28,38 @@ func wireApp(confServer *conf.Server, confService *conf.Service, confData *conf.
 	discovery := server.NewDiscovery(registry)
 	userClient := data.NewUserServiceClient(confService, discovery)
 	roleManagerClient := data.NewRoleServiceClient(confService, discovery)
-	kafkaClient := data.NewKafkaClient(confData)
+	broker := data.NewBroker(confData)
 	seminarClient := data.NewSeminarServiceClient(confService, discovery)
-	dataData, cleanup, err := data.NewData(confData, logger, client, userClient, roleManagerClient, kafkaClient, seminarClient)
+	dataData, cleanup, err := data.NewData(confData, logger, client, userClient, roleManagerClient, broker, seminarClient)
 	if err != nil {
 		return nil, nil, err
 	}
 	roleRepo := data.NewRoleRepo(dataData, logger)
-	roleUsecase := biz.NewRoleUsecase(roleRepo, logger, roleManagerClient)
+	objectStorage, err := data.NewObjectStorage(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	imageUsecase := biz.NewImageUsecase(confData, objectStorage, logger)
+	roleUsecase := biz.NewRoleUsecase(roleRepo, logger, roleManagerClient, imageUsecase)
 	roleService := service.NewRoleService(roleUsecase)
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger, userClient)
-	userService := service.NewUserService(userUsecase)
+	userUsecase := biz.NewUserUsecase(userRepo, logger, userClient, imageUsecase)
+	oidcProviders := data.NewOIDCProviders(confServer)
+	oidcStateRepo := data.NewOIDCStateRepo(dataData)
+	oidcUsecase := biz.NewOIDCUsecase(confServer, oidcProviders, oidcStateRepo, userClient, logger)
+	workspaceUsecase := biz.NewWorkspaceUsecase(confServer, userClient, logger)
+	userService := service.NewUserService(userUsecase, oidcUsecase, workspaceUsecase)
 	seminarRepo := data.NewSeminarRepo(dataData, logger)
 	broadcastRepo := data.NewBroadcastRepo(dataData, logger)
-	seminarUsecase := biz.NewSeminarUsecase(userRepo, seminarRepo, broadcastRepo, logger, seminarClient)
+	seminarUsecase := biz.NewSeminarUsecase(userRepo, seminarRepo, broadcastRepo, logger, seminarClient, imageUsecase)
 	seminarService := service.NewSeminarService(seminarUsecase)
-	httpServer := server.NewHTTPServer(confServer, roleService, userService, seminarService, logger)
+	limiter := data.NewRateLimiter(client)
+	sessionValidator := data.NewSessionValidator(dataData)
+	accountValidator := data.NewAccountValidator(dataData)
+	personalAccessTokenValidator := data.NewPersonalAccessTokenValidator(dataData)
+	roleResolver := data.NewWorkspaceRoleResolver(dataData)
+	httpServer := server.NewHTTPServer(confServer, roleService, userService, seminarService, limiter, sessionValidator, accountValidator, personalAccessTokenValidator, roleResolver, logger)
 	registrar := server.NewRegistrar(registry)
 	app := newApp(logger, httpServer, registrar)
 	return app, func() {
@@ -15,8 +15,10 @@ type TokenMessage struct {
 }
 
 type BroadcastRepo interface {
-	RegisterConnChannel(ctx context.Context, topic string, connChan chan *TokenMessage) error
+	// RegisterConnChannel 返回的 channel 在连接因消费过慢被断开时关闭
+	RegisterConnChannel(ctx context.Context, topic string, connChan chan *TokenMessage) (<-chan struct{}, error)
 	UngisterConnChannel(ctx context.Context, topic string, connChan chan *TokenMessage) error
+	// ReadTopic 持续读取广播，订阅失败或断开时自动重新订阅，直到 ctx 结束
 	ReadTopic(ctx context.Context, topic string) error
 	GetMessageCache(topicUID string) []*TokenMessage
 	LockMutex()
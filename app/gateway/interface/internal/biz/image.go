@@ -0,0 +1,233 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"mime/multipart"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// StorageRefPrefix 保存在头像、标题图片字段中的对象存储引用前缀，返回给客户端前会被替换成签名 URL
+const StorageRefPrefix = "storage://"
+
+const (
+	ImageKindAvatar = "avatar"
+	ImageKindTitle  = "title"
+
+	defaultMaxImageSize = 5 << 20
+	defaultImageURLTTL  = time.Hour
+	// 解码前先检查尺寸，避免小文件解压出超大图片
+	maxImagePixels     = 4096 * 4096
+	thumbnailMaxLength = 256
+)
+
+var (
+	ErrImageTooLarge       = grpcstatus.Error(codes.InvalidArgument, "图片过大")
+	ErrImageType           = grpcstatus.Error(codes.InvalidArgument, "只支持 png、jpeg 和 gif 图片")
+	ErrImageKind           = grpcstatus.Error(codes.InvalidArgument, "图片用途只能是 avatar 或 title")
+	ErrImageURLInvalid     = grpcstatus.Error(codes.PermissionDenied, "图片链接无效或已过期")
+	ErrImageNotFound       = grpcstatus.Error(codes.NotFound, "图片不存在")
+	ErrImageServeByBackend = grpcstatus.Error(codes.NotFound, "当前存储不由网关提供文件")
+)
+
+// ObjectStorage 对象存储，key 为不带前缀的对象路径
+type ObjectStorage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, string, error)
+	SignURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// URLVerifier 由网关自己提供文件的存储实现，用来校验签名 URL
+type URLVerifier interface {
+	VerifyURL(key, expires, signature string) error
+}
+
+// UploadedImage Ref 和 ThumbnailRef 保存到头像或标题图片字段，URL 只在有效期内可用
+type UploadedImage struct {
+	Ref          string `json:"ref"`
+	URL          string `json:"url"`
+	ThumbnailRef string `json:"thumbnail_ref"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+type ImageUsecase struct {
+	storage ObjectStorage
+	maxSize int64
+	urlTTL  time.Duration
+	log     *log.Helper
+}
+
+var globalImageUsecase *ImageUsecase
+
+func NewImageUsecase(c *conf.Data, storage ObjectStorage, logger log.Logger) *ImageUsecase {
+	maxSize, urlTTL := c.GetStorage().GetMaxImageSize(), c.GetStorage().GetUrlTtl().AsDuration()
+	if maxSize <= 0 {
+		maxSize = defaultMaxImageSize
+	}
+	if urlTTL <= 0 {
+		urlTTL = defaultImageURLTTL
+	}
+	uc := &ImageUsecase{storage: storage, maxSize: maxSize, urlTTL: urlTTL, log: log.NewHelper(logger)}
+	globalImageUsecase = uc
+	return uc
+}
+
+// UploadImage 校验图片类型和大小，保存原图和缩略图
+func UploadImage(ctx context.Context, kind string, file multipart.File, handler *multipart.FileHeader) (*UploadedImage, error) {
+	return globalImageUsecase.Upload(ctx, kind, file, handler.Size)
+}
+
+func (uc *ImageUsecase) Upload(ctx context.Context, kind string, file io.Reader, size int64) (*UploadedImage, error) {
+	if kind != ImageKindAvatar && kind != ImageKindTitle {
+		return nil, ErrImageKind
+	}
+	if size > uc.maxSize {
+		return nil, ErrImageTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(file, uc.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > uc.maxSize {
+		return nil, ErrImageTooLarge
+	}
+	// 以文件内容判断类型，不信任客户端提供的 Content-Type
+	contentType := nethttp.DetectContentType(data)
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, ErrImageType
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageType
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageType
+	}
+	thumb, thumbType, err := encodeThumbnail(thumbnail(img, thumbnailMaxLength), contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := utils.GetSnowflakeID(0)
+	if err != nil {
+		return nil, err
+	}
+	key := "images/" + kind + "/" + uid + ext
+	thumbKey := strings.TrimSuffix(key, ext) + "_thumb" + imageExtensions[thumbType]
+	if err := uc.storage.Put(ctx, key, data, contentType); err != nil {
+		return nil, err
+	}
+	if err := uc.storage.Put(ctx, thumbKey, thumb, thumbType); err != nil {
+		return nil, err
+	}
+
+	url, err := uc.storage.SignURL(ctx, key, uc.urlTTL)
+	if err != nil {
+		return nil, err
+	}
+	thumbURL, err := uc.storage.SignURL(ctx, thumbKey, uc.urlTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &UploadedImage{Ref: StorageRefPrefix + key, URL: url, ThumbnailRef: StorageRefPrefix + thumbKey, ThumbnailURL: thumbURL}, nil
+}
+
+// ResolveURL 把对象存储引用替换成签名 URL，其他值（外部链接等）原样返回
+func (uc *ImageUsecase) ResolveURL(ctx context.Context, value string) string {
+	key, ok := strings.CutPrefix(value, StorageRefPrefix)
+	if !ok {
+		return value
+	}
+	url, err := uc.storage.SignURL(ctx, key, uc.urlTTL)
+	if err != nil {
+		uc.log.Errorf("sign image url %s failed: %v", key, err)
+		return ""
+	}
+	return url
+}
+
+// OpenImage 校验签名后读取文件，只用于本地存储
+func OpenImage(ctx context.Context, key, expires, signature string) (io.ReadCloser, string, error) {
+	verifier, ok := globalImageUsecase.storage.(URLVerifier)
+	if !ok {
+		return nil, "", ErrImageServeByBackend
+	}
+	if err := verifier.VerifyURL(key, expires, signature); err != nil {
+		return nil, "", ErrImageURLInvalid
+	}
+	return globalImageUsecase.storage.Open(ctx, key)
+}
+
+var imageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+}
+
+// thumbnail 按比例缩小到最长边不超过 maxLength，每个目标像素取源区域的平均值
+func thumbnail(src image.Image, maxLength int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxLength && h <= maxLength {
+		return src
+	}
+	tw, th := maxLength, maxLength
+	if w > h {
+		th = max(1, h*maxLength/w)
+	} else {
+		tw = max(1, w*maxLength/h)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+max((y+1)*h/th, y*h/th+1)
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+max((x+1)*w/tw, x*w/tw+1)
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(bl / n), A: uint16(a / n)})
+		}
+	}
+	return dst
+}
+
+// encodeThumbnail jpeg 原图生成 jpeg 缩略图，其他格式使用 png 以保留透明度
+func encodeThumbnail(img image.Image, contentType string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	switch contentType {
+	case "image/jpeg":
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, "", err
+		}
+	default:
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		contentType = "image/png"
+	}
+	return buf.Bytes(), contentType, nil
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/url"
+	"sort"
+	"time"
+
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+	"github.com/Fl0rencess720/Ayana/pkgs/limiter"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// OIDCStateTTL 用户需要在这段时间内完成第三方登录
+const OIDCStateTTL = 10 * time.Minute
+
+var (
+	ErrOIDCProviderNotFound = grpcstatus.Error(codes.NotFound, "不支持的登录方式")
+	ErrOIDCStateInvalid     = grpcstatus.Error(codes.InvalidArgument, "登录请求无效或已过期，请重新登录")
+	ErrOIDCLoginFailed      = grpcstatus.Error(codes.Unauthenticated, "第三方登录失败，请重新登录")
+)
+
+// OIDCClaims 已校验的 ID Token 中的用户信息
+type OIDCClaims struct {
+	Subject             string
+	Email               string
+	Name                string
+	PhoneNumber         string
+	PhoneNumberVerified bool
+	Nonce               string
+}
+
+// OIDCProvider 一个 OIDC 身份提供方。Exchange 用授权码换取 ID Token，
+// 并校验签名、签发者、受众和有效期，nonce 由调用方校验
+type OIDCProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier string) (OIDCClaims, error)
+	// TrustPhone 是否按提供方声明的手机号关联已有账号
+	TrustPhone() bool
+}
+
+// OIDCProviders 按配置中的名称索引
+type OIDCProviders map[string]OIDCProvider
+
+// OIDCAuthState 发起授权时保存，回调时凭 state 取出，只能使用一次
+type OIDCAuthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+}
+
+type OIDCStateRepo interface {
+	SaveAuthState(ctx context.Context, state string, s OIDCAuthState, ttl time.Duration) error
+	// TakeAuthState 取出后立即删除，不存在时返回 ErrOIDCStateInvalid
+	TakeAuthState(ctx context.Context, state string) (OIDCAuthState, error)
+}
+
+type OIDCUsecase struct {
+	providers       OIDCProviders
+	repo            OIDCStateRepo
+	userClient      userV1.UserClient
+	successRedirect string
+	log             *log.Helper
+}
+
+func NewOIDCUsecase(c *conf.Server, providers OIDCProviders, repo OIDCStateRepo, userClient userV1.UserClient, logger log.Logger) *OIDCUsecase {
+	return &OIDCUsecase{
+		providers:       providers,
+		repo:            repo,
+		userClient:      userClient,
+		successRedirect: c.GetOidc().GetSuccessRedirect(),
+		log:             log.NewHelper(logger),
+	}
+}
+
+// Providers 返回已配置的登录方式，供登录页展示
+func (uc *OIDCUsecase) Providers() []string {
+	names := make([]string, 0, len(uc.providers))
+	for name := range uc.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AuthURL 生成 state、nonce 和 PKCE 校验码，返回身份提供方的授权地址和 state。
+// 调用方需要把 state 绑定到发起登录的浏览器，回调时原样传回 Callback
+func (uc *OIDCUsecase) AuthURL(ctx context.Context, provider string) (string, string, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return "", "", ErrOIDCProviderNotFound
+	}
+	state, verifier, nonce := randomToken(), randomToken(), randomToken()
+	if err := uc.repo.SaveAuthState(ctx, state, OIDCAuthState{Provider: provider, CodeVerifier: verifier, Nonce: nonce}, OIDCStateTTL); err != nil {
+		return "", "", err
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		uc.log.Errorf("build %s auth url failed: %v", provider, err)
+		return "", "", grpcstatus.Error(codes.Unavailable, "身份提供方暂不可用，请稍后再试")
+	}
+	return authURL, state, nil
+}
+
+// Callback 用授权码换取并校验 ID Token，再由用户服务关联或创建用户并签发令牌。
+// boundState 为发起登录的浏览器保存的 state，与回调参数不一致说明回调不是本人发起的（登录 CSRF）
+func (uc *OIDCUsecase) Callback(ctx context.Context, state, boundState, code string) (*userV1.OIDCLoginReply, error) {
+	if state == "" || code == "" || subtle.ConstantTimeCompare([]byte(state), []byte(boundState)) != 1 {
+		return nil, ErrOIDCStateInvalid
+	}
+	authState, err := uc.repo.TakeAuthState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := uc.providers[authState.Provider]
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+	claims, err := p.Exchange(ctx, code, authState.CodeVerifier)
+	if err != nil {
+		uc.log.Errorf("%s code exchange failed: %v", authState.Provider, err)
+		return nil, ErrOIDCLoginFailed
+	}
+	if claims.Nonce != authState.Nonce {
+		uc.log.Warnf("%s id token nonce mismatch for subject %s", authState.Provider, claims.Subject)
+		return nil, ErrOIDCLoginFailed
+	}
+	req := &userV1.OIDCLoginRequest{
+		Provider: authState.Provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		Name:     claims.Name,
+		Device:   requestDevice(ctx, ""),
+		Ip:       limiter.ClientIP(ctx),
+	}
+	// 未验证的手机号不能用来关联已有账号
+	if claims.PhoneNumberVerified {
+		req.Phone = claims.PhoneNumber
+		req.TrustPhone = p.TrustPhone()
+	}
+	return uc.userClient.OIDCLogin(ctx, req)
+}
+
+// SuccessRedirect 配置了前端地址时返回带令牌的跳转地址，令牌放在 fragment 中不会发送给服务器
+func (uc *OIDCUsecase) SuccessRedirect(reply *userV1.OIDCLoginReply) (string, bool) {
+	if uc.successRedirect == "" {
+		return "", false
+	}
+	fragment := url.Values{
+		"access_token":  {reply.AccessToken},
+		"refresh_token": {reply.RefreshToken},
+	}
+	if reply.Created {
+		fragment.Set("created", "true")
+	}
+	return uc.successRedirect + "#" + fragment.Encode(), true
+}
+
+// randomToken 生成 256 位随机数，base64url 编码后满足 PKCE 对校验码长度和字符的要求
+func randomToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
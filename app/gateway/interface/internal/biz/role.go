@@ -9,17 +9,22 @@ import (
 )
 
 type RoleRepo interface {
-	GetAvailableModelsFromRedis(ctx context.Context) ([]Model, error)
+	GetAvailableModelsFromRedis(ctx context.Context) ([]AvailableModel, error)
 	GetRolesFromRedis(ctx context.Context, phone string) ([]Role, error)
 }
 
 type Role struct {
-	Uid         string
-	Description string
-	Avatar      string
-	ApiPath     string
-	ApiKey      string
-	RoleName    string
+	Uid           string
+	Description   string
+	Avatar        string
+	ApiPath       string
+	ApiKeyMask    string
+	RoleName      string
+	CredentialUid string
+	Visibility    string
+	ShareCode     string
+	ImportCount   int64
+	Version       int32
 	Model
 }
 
@@ -28,14 +33,27 @@ type Model struct {
 	Provider  string
 }
 
+// AvailableModel 角色服务缓存的模型注册表条目
+type AvailableModel struct {
+	Model
+	DisplayName             string
+	ContextLength           int32
+	MaxOutput               int32
+	SupportsTools           bool
+	SupportsReasoningStream bool
+	SupportsVision          bool
+	Enabled                 bool
+}
+
 type RoleUsecase struct {
 	repo       RoleRepo
 	log        *log.Helper
 	roleClient roleV1.RoleManagerClient
+	images     *ImageUsecase
 }
 
-func NewRoleUsecase(repo RoleRepo, logger log.Logger, roleClient roleV1.RoleManagerClient) *RoleUsecase {
-	return &RoleUsecase{repo: repo, log: log.NewHelper(logger), roleClient: roleClient}
+func NewRoleUsecase(repo RoleRepo, logger log.Logger, roleClient roleV1.RoleManagerClient, images *ImageUsecase) *RoleUsecase {
+	return &RoleUsecase{repo: repo, log: log.NewHelper(logger), roleClient: roleClient, images: images}
 }
 
 func (uc *RoleUsecase) CallRole() {
@@ -52,13 +70,18 @@ func (uc *RoleUsecase) GetRoles(ctx context.Context, req *roleV1.GetRolesRequest
 		rs := make([]*roleV1.Role, 0, len(roles))
 		for _, r := range roles {
 			rs = append(rs, &roleV1.Role{
-				Name:        r.RoleName,
-				Uid:         r.Uid,
-				Description: r.Description,
-				Avatar:      r.Avatar,
-				ApiPath:     r.ApiPath,
-				ApiKey:      r.ApiKey,
-				Model:       &roleV1.Model{Provider: r.Model.Provider, Name: r.Model.ModelName},
+				Name:         r.RoleName,
+				Uid:          r.Uid,
+				Description:  r.Description,
+				Avatar:       uc.images.ResolveURL(ctx, r.Avatar),
+				ApiPath:      r.ApiPath,
+				ApiKey:       r.ApiKeyMask,
+				Model:        &roleV1.Model{Provider: r.Model.Provider, Name: r.Model.ModelName},
+				CredentialId: r.CredentialUid,
+				Visibility:   r.Visibility,
+				ShareCode:    r.ShareCode,
+				ImportCount:  r.ImportCount,
+				Version:      r.Version,
 			})
 		}
 		return &roleV1.GetRolesReply{
@@ -66,7 +89,14 @@ func (uc *RoleUsecase) GetRoles(ctx context.Context, req *roleV1.GetRolesRequest
 		}, nil
 	}
 	req.Phone = phone
-	return uc.roleClient.GetRoles(ctx, req)
+	reply, err := uc.roleClient.GetRoles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reply.Roles {
+		r.Avatar = uc.images.ResolveURL(ctx, r.Avatar)
+	}
+	return reply, nil
 }
 
 func (uc *RoleUsecase) DeleteRole(ctx context.Context, req *roleV1.DeleteRoleRequest) (*roleV1.DeleteRoleReply, error) {
@@ -82,8 +112,15 @@ func (uc *RoleUsecase) GetAvailableModels(ctx context.Context, req *roleV1.GetAv
 		ms := make([]*roleV1.Model, 0, len(models))
 		for _, m := range models {
 			ms = append(ms, &roleV1.Model{
-				Name:     m.ModelName,
-				Provider: m.Provider,
+				Name:                    m.ModelName,
+				Provider:                m.Provider,
+				DisplayName:             m.DisplayName,
+				ContextLength:           m.ContextLength,
+				MaxOutput:               m.MaxOutput,
+				SupportsTools:           m.SupportsTools,
+				SupportsReasoningStream: m.SupportsReasoningStream,
+				SupportsVision:          m.SupportsVision,
+				Enabled:                 m.Enabled,
 			})
 		}
 		return &roleV1.GetAvailableModelsReply{
@@ -99,3 +136,160 @@ func (uc *RoleUsecase) SetRole(ctx context.Context, req *roleV1.SetRoleRequest)
 	}
 	return reply, nil
 }
+
+func (uc *RoleUsecase) CreateCredential(ctx context.Context, req *roleV1.CreateCredentialRequest) (*roleV1.CreateCredentialReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.CreateCredential(ctx, req)
+}
+
+func (uc *RoleUsecase) GetCredentials(ctx context.Context, req *roleV1.GetCredentialsRequest) (*roleV1.GetCredentialsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.GetCredentials(ctx, req)
+}
+
+func (uc *RoleUsecase) SetCredential(ctx context.Context, req *roleV1.SetCredentialRequest) (*roleV1.SetCredentialReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.SetCredential(ctx, req)
+}
+
+func (uc *RoleUsecase) DeleteCredential(ctx context.Context, req *roleV1.DeleteCredentialRequest) (*roleV1.DeleteCredentialReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.DeleteCredential(ctx, req)
+}
+
+func (uc *RoleUsecase) GetSystemRoles(ctx context.Context, req *roleV1.GetSystemRolesRequest) (*roleV1.GetSystemRolesReply, error) {
+	reply, err := uc.roleClient.GetSystemRoles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reply.Roles {
+		if r.Role != nil {
+			r.Role.Avatar = uc.images.ResolveURL(ctx, r.Role.Avatar)
+		}
+	}
+	return reply, nil
+}
+
+func (uc *RoleUsecase) GetSystemRole(ctx context.Context, req *roleV1.GetSystemRoleRequest) (*roleV1.GetSystemRoleReply, error) {
+	reply, err := uc.roleClient.GetSystemRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Role.GetRole() != nil {
+		reply.Role.Role.Avatar = uc.images.ResolveURL(ctx, reply.Role.Role.Avatar)
+	}
+	return reply, nil
+}
+
+func (uc *RoleUsecase) CloneSystemRole(ctx context.Context, req *roleV1.CloneSystemRoleRequest) (*roleV1.CloneSystemRoleReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.CloneSystemRole(ctx, req)
+}
+
+func (uc *RoleUsecase) PublishRole(ctx context.Context, req *roleV1.PublishRoleRequest) (*roleV1.PublishRoleReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.PublishRole(ctx, req)
+}
+
+func (uc *RoleUsecase) UnpublishRole(ctx context.Context, req *roleV1.UnpublishRoleRequest) (*roleV1.UnpublishRoleReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.UnpublishRole(ctx, req)
+}
+
+func (uc *RoleUsecase) GetSharedRoles(ctx context.Context, req *roleV1.GetSharedRolesRequest) (*roleV1.GetSharedRolesReply, error) {
+	reply, err := uc.roleClient.GetSharedRoles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reply.Roles {
+		r.Avatar = uc.images.ResolveURL(ctx, r.Avatar)
+	}
+	return reply, nil
+}
+
+func (uc *RoleUsecase) GetSharedRole(ctx context.Context, req *roleV1.GetSharedRoleRequest) (*roleV1.GetSharedRoleReply, error) {
+	reply, err := uc.roleClient.GetSharedRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Role != nil {
+		reply.Role.Avatar = uc.images.ResolveURL(ctx, reply.Role.Avatar)
+	}
+	return reply, nil
+}
+
+func (uc *RoleUsecase) ImportSharedRole(ctx context.Context, req *roleV1.ImportSharedRoleRequest) (*roleV1.ImportSharedRoleReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.ImportSharedRole(ctx, req)
+}
+
+func (uc *RoleUsecase) GetRoleVersions(ctx context.Context, req *roleV1.GetRoleVersionsRequest) (*roleV1.GetRoleVersionsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.roleClient.GetRoleVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range reply.Versions {
+		v.Avatar = uc.images.ResolveURL(ctx, v.Avatar)
+	}
+	return reply, nil
+}
+
+func (uc *RoleUsecase) DiffRoleVersions(ctx context.Context, req *roleV1.DiffRoleVersionsRequest) (*roleV1.DiffRoleVersionsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.roleClient.DiffRoleVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range reply.Changes {
+		if c.Field == "avatar" {
+			c.From, c.To = uc.images.ResolveURL(ctx, c.From), uc.images.ResolveURL(ctx, c.To)
+		}
+	}
+	return reply, nil
+}
+
+func (uc *RoleUsecase) RollbackRole(ctx context.Context, req *roleV1.RollbackRoleRequest) (*roleV1.RollbackRoleReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.RollbackRole(ctx, req)
+}
+
+func (uc *RoleUsecase) GetModels(ctx context.Context, req *roleV1.GetModelsRequest) (*roleV1.GetModelsReply, error) {
+	return uc.roleClient.GetModels(ctx, req)
+}
+
+func (uc *RoleUsecase) CreateModel(ctx context.Context, req *roleV1.CreateModelRequest) (*roleV1.CreateModelReply, error) {
+	return uc.roleClient.CreateModel(ctx, req)
+}
+
+func (uc *RoleUsecase) SetModel(ctx context.Context, req *roleV1.SetModelRequest) (*roleV1.SetModelReply, error) {
+	return uc.roleClient.SetModel(ctx, req)
+}
+
+func (uc *RoleUsecase) DeleteModel(ctx context.Context, req *roleV1.DeleteModelRequest) (*roleV1.DeleteModelReply, error) {
+	return uc.roleClient.DeleteModel(ctx, req)
+}
+
+func (uc *RoleUsecase) GenerateRole(ctx context.Context, req *roleV1.GenerateRoleRequest) (*roleV1.GenerateRoleReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.GenerateRole(ctx, req)
+}
+
+func (uc *RoleUsecase) GenerateRolePanel(ctx context.Context, req *roleV1.GenerateRolePanelRequest) (*roleV1.GenerateRolePanelReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	return uc.roleClient.GenerateRolePanel(ctx, req)
+}
+
+// 管理接口的调用者由网关的 AdminOnly 校验，请求原样转发
+
+func (uc *RoleUsecase) AdminCreateSystemRole(ctx context.Context, req *roleV1.AdminCreateSystemRoleRequest) (*roleV1.AdminCreateSystemRoleReply, error) {
+	return uc.roleClient.AdminCreateSystemRole(ctx, req)
+}
+
+func (uc *RoleUsecase) AdminSetSystemRole(ctx context.Context, req *roleV1.AdminSetSystemRoleRequest) (*roleV1.AdminSetSystemRoleReply, error) {
+	return uc.roleClient.AdminSetSystemRole(ctx, req)
+}
+
+func (uc *RoleUsecase) AdminDeleteSystemRole(ctx context.Context, req *roleV1.AdminDeleteSystemRoleRequest) (*roleV1.AdminDeleteSystemRoleReply, error) {
+	return uc.roleClient.AdminDeleteSystemRole(ctx, req)
+}
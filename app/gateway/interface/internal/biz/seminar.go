@@ -9,11 +9,14 @@ import (
 	nethttp "net/http"
 
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
 	"github.com/Fl0rencess720/Ayana/pkgs/kafkatopic"
 	"github.com/Fl0rencess720/Ayana/pkgs/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 type SeminarRepo interface {
@@ -27,6 +30,7 @@ type SeminarUsecase struct {
 	log   *log.Helper
 
 	seminarClient v1.SeminarClient
+	images        *ImageUsecase
 }
 type sseResp struct {
 	RoleUID string `json:"role_uid"`
@@ -56,8 +60,8 @@ type Topic struct {
 	Content      string
 }
 
-func NewSeminarUsecase(repo UserRepo, srepo SeminarRepo, brepo BroadcastRepo, logger log.Logger, seminarClient v1.SeminarClient) *SeminarUsecase {
-	seminarUsecase := &SeminarUsecase{repo: repo, srepo: srepo, brepo: brepo, log: log.NewHelper(logger), seminarClient: seminarClient}
+func NewSeminarUsecase(repo UserRepo, srepo SeminarRepo, brepo BroadcastRepo, logger log.Logger, seminarClient v1.SeminarClient, images *ImageUsecase) *SeminarUsecase {
+	seminarUsecase := &SeminarUsecase{repo: repo, srepo: srepo, brepo: brepo, log: log.NewHelper(logger), seminarClient: seminarClient, images: images}
 	globalSeminarUsecase = seminarUsecase
 	go func() {
 		if err := seminarUsecase.brepo.ReadTopic(context.Background(), kafkatopic.TOPIC); err != nil {
@@ -89,6 +93,9 @@ func (uc *SeminarUsecase) GetTopic(ctx context.Context, req *v1.GetTopicRequest)
 	if err != nil {
 		return nil, err
 	}
+	if reply.Topic != nil {
+		reply.Topic.TitleImage = uc.images.ResolveURL(ctx, reply.Topic.TitleImage)
+	}
 	return reply, nil
 }
 
@@ -98,13 +105,25 @@ func (uc *SeminarUsecase) GetTopicsMetadata(ctx context.Context, req *v1.GetTopi
 	if err != nil {
 		return nil, err
 	}
+	for _, topic := range reply.Topics {
+		topic.TitleImage = uc.images.ResolveURL(ctx, topic.TitleImage)
+	}
 	return reply, nil
 }
 
+// checkTopicOwner 在建立 SSE 连接前确认讨论属于当前用户，不属于时由后端返回 NotFound
+func checkTopicOwner(c context.Context, topicUID string) error {
+	_, err := globalSeminarUsecase.seminarClient.GetTopic(c, &v1.GetTopicRequest{Uid: topicUID})
+	return err
+}
+
 func StartTopic(ctx http.Context, c context.Context) (interface{}, error) {
 	req := v1.StartTopicRequest{}
 	req.TopicId = ctx.Query().Get("topic_id")
 	req.Phone = utils.GetPhoneFromContext(c)
+	if err := checkTopicOwner(c, req.TopicId); err != nil {
+		return nil, err
+	}
 	status, err := globalSeminarUsecase.srepo.GetTopicLockStatus(ctx, req.TopicId)
 	if err != nil {
 		return nil, err
@@ -128,13 +147,21 @@ func StartTopic(ctx http.Context, c context.Context) (interface{}, error) {
 		close(tokenChan)
 	}()
 
-	if err := globalSeminarUsecase.brepo.RegisterConnChannel(ctx, req.TopicId, tokenChan); err != nil {
+	connDone, err := globalSeminarUsecase.brepo.RegisterConnChannel(ctx, req.TopicId, tokenChan)
+	if err != nil {
 		return nil, err
 	}
 
-	_, err = globalSeminarUsecase.seminarClient.StartTopic(ctx, &req)
+	_, err = globalSeminarUsecase.seminarClient.StartTopic(c, &req)
 	if err != nil {
 		zap.L().Error("StartTopic error", zap.Error(err))
+		// 用量超出配额时讨论不会开始，直接告知前端
+		if grpcstatus.Code(err) == codes.ResourceExhausted {
+			jsonData, _ := json.Marshal(sseResp{Content: grpcstatus.Convert(err).Message()})
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", jsonData)
+			flusher.Flush()
+			return nil, nil
+		}
 	}
 
 	for {
@@ -154,20 +181,27 @@ func StartTopic(ctx http.Context, c context.Context) (interface{}, error) {
 				fmt.Fprintf(w, "event: reasoning\ndata: %s\n\n", jsonData)
 			} else if token.ContentType == "text" {
 				fmt.Fprintf(w, "event: text\ndata: %s\n\n", jsonData)
+			} else if token.ContentType == "error" {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", jsonData)
 			} else if token.ContentType == "end" {
 				fmt.Fprintf(w, "event: end\ndata: %s\n\n", "{}")
 				flusher.Flush()
 				return nil, nil
 			}
 			flusher.Flush()
+		case <-connDone:
+			return nil, fmt.Errorf("client is too slow to consume tokens")
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		}
 	}
 }
 
-func GetTopicStream(ctx http.Context) (interface{}, error) {
+func GetTopicStream(ctx http.Context, c context.Context) (interface{}, error) {
 	topicUID := ctx.Query().Get("topic_id")
+	if err := checkTopicOwner(c, topicUID); err != nil {
+		return nil, err
+	}
 	status, err := globalSeminarUsecase.srepo.GetTopicLockStatus(ctx, topicUID)
 	if err != nil {
 		return nil, err
@@ -193,10 +227,11 @@ func GetTopicStream(ctx http.Context) (interface{}, error) {
 
 	globalSeminarUsecase.brepo.LockMutex()
 	cachedMsgs := globalSeminarUsecase.brepo.GetMessageCache(topicUID)
-	if err := globalSeminarUsecase.brepo.RegisterConnChannel(ctx, topicUID, tokenChan); err != nil {
+	connDone, err := globalSeminarUsecase.brepo.RegisterConnChannel(ctx, topicUID, tokenChan)
+	globalSeminarUsecase.brepo.UnlockMutex()
+	if err != nil {
 		return nil, err
 	}
-	globalSeminarUsecase.brepo.UnlockMutex()
 	for _, token := range cachedMsgs {
 		sseResp := sseResp{RoleUID: token.RoleUID, Content: token.Content}
 		if token.ContentType == "reasoning" {
@@ -227,18 +262,70 @@ func GetTopicStream(ctx http.Context) (interface{}, error) {
 				fmt.Fprintf(w, "event: reasoning\ndata: %s\n\n", jsonData)
 			} else if token.ContentType == "text" {
 				fmt.Fprintf(w, "event: text\ndata: %s\n\n", jsonData)
+			} else if token.ContentType == "error" {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", jsonData)
 			} else if token.ContentType == "end" {
 				fmt.Fprintf(w, "event: end\ndata: %s\n\n", "{}")
 				flusher.Flush()
 				return nil, nil
 			}
 			flusher.Flush()
+		case <-connDone:
+			return nil, fmt.Errorf("client is too slow to consume tokens")
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		}
 	}
 }
 
+// PreviewRole 试聊角色，把后端的流式回答转成 SSE
+func PreviewRole(ctx http.Context, c context.Context) (interface{}, error) {
+	req := v1.PreviewRoleRequest{}
+	if err := ctx.Bind(&req); err != nil {
+		return nil, err
+	}
+	req.Phone = utils.GetPhoneFromContext(c)
+	stream, err := globalSeminarUsecase.seminarClient.PreviewRole(identity.OutgoingContext(c), &req)
+	if err != nil {
+		return nil, err
+	}
+	// 第一条消息到达前的错误（参数、配额、角色不存在）直接以普通响应返回
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	w := ctx.Response()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Transfer-Encoding", "chunked")
+	ctx.Response().WriteHeader(nethttp.StatusOK)
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("response writer does not implement http.Flusher")
+	}
+	for reply := first; ; {
+		if reply.ContentType == "end" {
+			fmt.Fprintf(w, "event: end\ndata: %s\n\n", "{}")
+			flusher.Flush()
+			return nil, nil
+		}
+		jsonData, err := json.Marshal(sseResp{RoleUID: req.RoleUid, Content: reply.Content})
+		if err != nil {
+			zap.L().Error("JSON marshal error", zap.Error(err))
+		} else {
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", reply.ContentType, jsonData)
+			flusher.Flush()
+		}
+		if reply, err = stream.Recv(); err != nil {
+			jsonData, _ := json.Marshal(sseResp{RoleUID: req.RoleUid, Content: grpcstatus.Convert(err).Message()})
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", jsonData)
+			flusher.Flush()
+			return nil, nil
+		}
+	}
+}
+
 func (uc *SeminarUsecase) StopTopic(ctx context.Context, req *v1.StopTopicRequest) (*v1.StopTopicReply, error) {
 	reply, err := uc.seminarClient.StopTopic(ctx, req)
 	if err != nil {
@@ -247,9 +334,18 @@ func (uc *SeminarUsecase) StopTopic(ctx context.Context, req *v1.StopTopicReques
 	return reply, nil
 }
 
+func (uc *SeminarUsecase) InterjectTopic(ctx context.Context, req *v1.InterjectTopicRequest) (*v1.InterjectTopicReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.InterjectTopic(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
 func UploadDocument(ctx context.Context, file multipart.File, handler *multipart.FileHeader) (interface{}, error) {
 	phone := utils.GetPhoneFromContext(ctx)
-	stream, err := globalSeminarUsecase.seminarClient.UploadDocument(ctx)
+	stream, err := globalSeminarUsecase.seminarClient.UploadDocument(identity.OutgoingContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -337,3 +433,84 @@ func (uc *SeminarUsecase) DisableMCPServer(ctx context.Context, req *v1.DisableM
 	}
 	return reply, nil
 }
+
+func (uc *SeminarUsecase) GetMCPServerTools(ctx context.Context, req *v1.GetMCPServerToolsRequest) (*v1.GetMCPServerToolsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.GetMCPServerTools(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *SeminarUsecase) SetTopicTools(ctx context.Context, req *v1.SetTopicToolsRequest) (*v1.SetTopicToolsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.SetTopicTools(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *SeminarUsecase) SetTopicDocuments(ctx context.Context, req *v1.SetTopicDocumentsRequest) (*v1.SetTopicDocumentsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.SetTopicDocuments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *SeminarUsecase) GetUsage(ctx context.Context, req *v1.GetUsageRequest) (*v1.GetUsageReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.GetUsage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *SeminarUsecase) EstimateTopicCost(ctx context.Context, req *v1.EstimateTopicCostRequest) (*v1.EstimateTopicCostReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.EstimateTopicCost(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *SeminarUsecase) TestCredential(ctx context.Context, req *v1.TestCredentialRequest) (*v1.TestCredentialReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.TestCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *SeminarUsecase) GetTopicRuns(ctx context.Context, req *v1.GetTopicRunsRequest) (*v1.GetTopicRunsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.seminarClient.GetTopicRuns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range reply.Runs {
+		for _, role := range run.Roles {
+			role.Avatar = uc.images.ResolveURL(ctx, role.Avatar)
+		}
+	}
+	return reply, nil
+}
+
+// AdminGetUsage 请求中的手机号是被查看的用户，不能替换为调用者
+func (uc *SeminarUsecase) AdminGetUsage(ctx context.Context, req *v1.AdminGetUsageRequest) (*v1.GetUsageReply, error) {
+	return uc.seminarClient.AdminGetUsage(ctx, req)
+}
+
+func (uc *SeminarUsecase) AdminGetRunningTopics(ctx context.Context, req *v1.AdminGetRunningTopicsRequest) (*v1.AdminGetRunningTopicsReply, error) {
+	return uc.seminarClient.AdminGetRunningTopics(ctx, req)
+}
+
+func (uc *SeminarUsecase) AdminStopTopic(ctx context.Context, req *v1.AdminStopTopicRequest) (*v1.AdminStopTopicReply, error) {
+	return uc.seminarClient.AdminStopTopic(ctx, req)
+}
@@ -2,16 +2,16 @@ package biz
 
 import (
 	"context"
-	"errors"
-	"strings"
 
 	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
-	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/Fl0rencess720/Ayana/pkgs/limiter"
 	"github.com/Fl0rencess720/Ayana/pkgs/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport"
 )
 
+const maxDeviceLength = 200
+
 type Profile struct {
 	Name   string
 	Avatar string
@@ -30,11 +30,12 @@ type UserUsecase struct {
 	repo       UserRepo
 	log        *log.Helper
 	userClient userV1.UserClient
+	images     *ImageUsecase
 }
 
 // NewUserUsecase new a User usecase.
-func NewUserUsecase(repo UserRepo, logger log.Logger, userClient userV1.UserClient) *UserUsecase {
-	return &UserUsecase{repo: repo, log: log.NewHelper(logger), userClient: userClient}
+func NewUserUsecase(repo UserRepo, logger log.Logger, userClient userV1.UserClient, images *ImageUsecase) *UserUsecase {
+	return &UserUsecase{repo: repo, log: log.NewHelper(logger), userClient: userClient, images: images}
 }
 
 func (uc *UserUsecase) Register(ctx context.Context, req *userV1.RegisterRequest) (*userV1.RegisterReply, error) {
@@ -46,6 +47,9 @@ func (uc *UserUsecase) Register(ctx context.Context, req *userV1.RegisterRequest
 }
 
 func (uc *UserUsecase) Login(ctx context.Context, req *userV1.LoginRequest) (*userV1.LoginReply, error) {
+	// 会话列表中展示登录的设备和 IP
+	req.Ip = limiter.ClientIP(ctx)
+	req.Device = requestDevice(ctx, req.Device)
 	reply, err := uc.userClient.Login(ctx, req)
 	if err != nil {
 		return nil, err
@@ -68,24 +72,144 @@ func (uc *UserUsecase) GetProfile(ctx context.Context, req *userV1.GetProfileReq
 	if err != nil {
 		return reply, err
 	}
+	if reply.Profile != nil {
+		reply.Profile.Avatar = uc.images.ResolveURL(ctx, reply.Profile.Avatar)
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) ChangePassword(ctx context.Context, req *userV1.ChangePasswordRequest) (*userV1.ChangePasswordReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	req.SessionId = utils.GetSessionIDFromContext(ctx)
+	reply, err := uc.userClient.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) SendResetCode(ctx context.Context, req *userV1.SendResetCodeRequest) (*userV1.SendResetCodeReply, error) {
+	reply, err := uc.userClient.SendResetCode(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) ResetPassword(ctx context.Context, req *userV1.ResetPasswordRequest) (*userV1.ResetPasswordReply, error) {
+	reply, err := uc.userClient.ResetPassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) RefreshToken(ctx context.Context, req *userV1.RefreshTokenRequest) (*userV1.RefreshTokenReply, error) {
+	reply, err := uc.userClient.RefreshToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) Logout(ctx context.Context, req *userV1.LogoutRequest) (*userV1.LogoutReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	req.CurrentSessionId = utils.GetSessionIDFromContext(ctx)
+	reply, err := uc.userClient.Logout(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) LogoutAllDevices(ctx context.Context, req *userV1.LogoutAllDevicesRequest) (*userV1.LogoutAllDevicesReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.LogoutAllDevices(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) ListSessions(ctx context.Context, req *userV1.ListSessionsRequest) (*userV1.ListSessionsReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	req.CurrentSessionId = utils.GetSessionIDFromContext(ctx)
+	reply, err := uc.userClient.ListSessions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) CreatePersonalAccessToken(ctx context.Context, req *userV1.CreatePersonalAccessTokenRequest) (*userV1.CreatePersonalAccessTokenReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.CreatePersonalAccessToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) ListPersonalAccessTokens(ctx context.Context, req *userV1.ListPersonalAccessTokensRequest) (*userV1.ListPersonalAccessTokensReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.ListPersonalAccessTokens(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *UserUsecase) RevokePersonalAccessToken(ctx context.Context, req *userV1.RevokePersonalAccessTokenRequest) (*userV1.RevokePersonalAccessTokenReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.RevokePersonalAccessToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// requestDevice 客户端没有提供设备名时使用 User-Agent
+func requestDevice(ctx context.Context, device string) string {
+	if tr, ok := transport.FromServerContext(ctx); ok && device == "" {
+		device = tr.RequestHeader().Get("User-Agent")
+	}
+	if len(device) > maxDeviceLength {
+		device = device[:maxDeviceLength]
+	}
+	return device
+}
+
+// AdminGetUsers 管理接口的请求原样转发，操作者由用户服务从调用身份中取出
+func (uc *UserUsecase) AdminGetUsers(ctx context.Context, req *userV1.AdminGetUsersRequest) (*userV1.AdminGetUsersReply, error) {
+	reply, err := uc.userClient.AdminGetUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range reply.Users {
+		u.Avatar = uc.images.ResolveURL(ctx, u.Avatar)
+	}
 	return reply, nil
 }
 
-func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
-	if tr, ok := transport.FromServerContext(ctx); ok {
-		tokenString := tr.RequestHeader().Get("Authorization")
-		if tokenString == "" {
-			return "", errors.New("miss token string")
-		}
-		parts := strings.Split(tokenString, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			return "", errors.New("wrong token format")
-		}
-		accessToken, err := jwtc.RefreshToken(parts[1], refreshToken)
-		if err != nil {
-			return "", err
-		}
-		return accessToken, nil
-	}
-	return "", errors.New("wrong context")
+func (uc *UserUsecase) AdminSetUserStatus(ctx context.Context, req *userV1.AdminSetUserStatusRequest) (*userV1.AdminSetUserStatusReply, error) {
+	return uc.userClient.AdminSetUserStatus(ctx, req)
+}
+
+func (uc *UserUsecase) AdminGetAuditLogs(ctx context.Context, req *userV1.AdminGetAuditLogsRequest) (*userV1.AdminGetAuditLogsReply, error) {
+	return uc.userClient.AdminGetAuditLogs(ctx, req)
+}
+
+// CheckAdmin 向用户服务确认调用者当前是否为管理员
+func (uc *UserUsecase) CheckAdmin(ctx context.Context, phone string) (bool, error) {
+	reply, err := uc.userClient.CheckAdmin(ctx, &userV1.CheckAdminRequest{Phone: phone})
+	if err != nil {
+		return false, err
+	}
+	return reply.Admin, nil
+}
+
+// RecordAuditLog 审计记录由用户服务统一保存
+func (uc *UserUsecase) RecordAuditLog(ctx context.Context, entry *userV1.AuditLog) error {
+	_, err := uc.userClient.RecordAuditLog(ctx, &userV1.RecordAuditLogRequest{Log: entry})
+	return err
 }
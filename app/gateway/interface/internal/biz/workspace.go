@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"context"
+	"net/url"
+
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WorkspaceUsecase 转发工作区管理请求，并为邀请拼出可以直接分享的链接
+type WorkspaceUsecase struct {
+	userClient userV1.UserClient
+	inviteURL  string
+	log        *log.Helper
+}
+
+func NewWorkspaceUsecase(c *conf.Server, userClient userV1.UserClient, logger log.Logger) *WorkspaceUsecase {
+	return &WorkspaceUsecase{userClient: userClient, inviteURL: c.GetInviteUrl(), log: log.NewHelper(logger)}
+}
+
+func (uc *WorkspaceUsecase) CreateWorkspace(ctx context.Context, req *userV1.CreateWorkspaceRequest) (*userV1.CreateWorkspaceReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.CreateWorkspace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) GetWorkspaces(ctx context.Context, req *userV1.GetWorkspacesRequest) (*userV1.GetWorkspacesReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.GetWorkspaces(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) GetWorkspaceMembers(ctx context.Context, req *userV1.GetWorkspaceMembersRequest) (*userV1.GetWorkspaceMembersReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.GetWorkspaceMembers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) SetWorkspaceMember(ctx context.Context, req *userV1.SetWorkspaceMemberRequest) (*userV1.SetWorkspaceMemberReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.SetWorkspaceMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) RemoveWorkspaceMember(ctx context.Context, req *userV1.RemoveWorkspaceMemberRequest) (*userV1.RemoveWorkspaceMemberReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.RemoveWorkspaceMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) CreateWorkspaceInvite(ctx context.Context, req *userV1.CreateWorkspaceInviteRequest) (*userV1.CreateWorkspaceInviteReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.CreateWorkspaceInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reply.Link = uc.inviteLink(reply.Token)
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) GetWorkspaceInvites(ctx context.Context, req *userV1.GetWorkspaceInvitesRequest) (*userV1.GetWorkspaceInvitesReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.GetWorkspaceInvites(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) RevokeWorkspaceInvite(ctx context.Context, req *userV1.RevokeWorkspaceInviteRequest) (*userV1.RevokeWorkspaceInviteReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.RevokeWorkspaceInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) AcceptWorkspaceInvite(ctx context.Context, req *userV1.AcceptWorkspaceInviteRequest) (*userV1.AcceptWorkspaceInviteReply, error) {
+	req.Phone = utils.GetPhoneFromContext(ctx)
+	reply, err := uc.userClient.AcceptWorkspaceInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (uc *WorkspaceUsecase) inviteLink(token string) string {
+	if uc.inviteURL == "" {
+		return ""
+	}
+	u, err := url.Parse(uc.inviteURL)
+	if err != nil {
+		uc.log.Errorf("parse invite url %q failed: %v", uc.inviteURL, err)
+		return ""
+	}
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -6,13 +6,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/broadcast"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/segmentio/kafka-go"
+	"github.com/go-redis/redis/v8"
 	"go.uber.org/zap"
 )
 
+const (
+	subscriptionTTL       = 90 * time.Second
+	subscriptionHeartbeat = 30 * time.Second
+)
+
 type broadcastRepo struct {
 	data *Data
 	log  *log.Helper
@@ -25,119 +32,195 @@ func NewBroadcastRepo(data *Data, logger log.Logger) biz.BroadcastRepo {
 	}
 }
 
-func (r *broadcastRepo) RegisterConnChannel(ctx context.Context, topic string, connChan chan *biz.TokenMessage) error {
+func (r *broadcastRepo) RegisterConnChannel(ctx context.Context, topic string, connChan chan *biz.TokenMessage) (<-chan struct{}, error) {
+	conn := newClientConn(connChan, r.data.clientQueueSize, r.data.slowConsumerPolicy)
 	r.data.rmu.Lock()
-	r.data.clientConnMap[topic] = append(r.data.clientConnMap[topic], &clientConn{tokenMessageChan: connChan, isclosed: false})
+	r.data.clientConnMap[topic] = append(r.data.clientConnMap[topic], conn)
+	sseConnections.WithLabelValues(topic).Set(float64(len(r.data.clientConnMap[topic])))
 	r.data.rmu.Unlock()
 	r.log.Info("RegisterConnChannel", zap.String("topic", topic))
-	return nil
+	if err := r.trackSubscription(ctx, topic); err != nil {
+		zap.L().Error("failed to track subscription", zap.String("topic", topic), zap.Error(err))
+	}
+	return conn.done, nil
 }
 
 func (r *broadcastRepo) UngisterConnChannel(ctx context.Context, topic string, connChan chan *biz.TokenMessage) error {
 	r.log.Info("UngisterConnChannel", zap.String("topic", topic))
-	for i, v := range r.data.clientConnMap[topic] {
-		if v.tokenMessageChan == connChan {
-			r.data.clientConnMap[topic][i].isclosed = true
-			return nil
+	if conn, last := r.removeConn(topic, func(c *clientConn) bool { return c.tokenMessageChan == connChan }); conn != nil {
+		conn.close()
+		if last {
+			if err := r.untrackSubscription(context.Background(), topic); err != nil {
+				zap.L().Error("failed to untrack subscription", zap.String("topic", topic), zap.Error(err))
+			}
 		}
 	}
 	return nil
 }
 
-// ReadTopic 从 Kafka 主题读取消息并处理
-func (r *broadcastRepo) ReadTopic(ctx context.Context, topic string) error {
-	readers := r.data.kafkaClient.readers
-	if len(readers) == 0 {
-		zap.L().Error("Kafka readers not initialized")
-		return fmt.Errorf("kafka readers not initialized")
+// removeConn 从连接表中移除第一个满足条件的连接，last 表示该讨论在本实例上已没有连接
+func (r *broadcastRepo) removeConn(topic string, match func(*clientConn) bool) (conn *clientConn, last bool) {
+	r.data.rmu.Lock()
+	defer r.data.rmu.Unlock()
+	conns := r.data.clientConnMap[topic]
+	for i, c := range conns {
+		if match(c) {
+			conn = c
+			conns = append(conns[:i:i], conns[i+1:]...)
+			break
+		}
+	}
+	if conn == nil {
+		return nil, false
+	}
+	if len(conns) == 0 {
+		delete(r.data.clientConnMap, topic)
+		sseConnections.DeleteLabelValues(topic)
+		return conn, true
 	}
-	errChan := make(chan error, len(readers))
+	r.data.clientConnMap[topic] = conns
+	sseConnections.WithLabelValues(topic).Set(float64(len(conns)))
+	return conn, false
+}
 
-	for _, reader := range readers {
-		go func(reader *kafka.Reader) {
-			defer func() {
-				if closeErr := reader.Close(); closeErr != nil {
-					zap.L().Error("failed to close kafka reader", zap.Error(closeErr))
-				}
-			}()
-
-			for {
-				select {
-				case <-ctx.Done():
-					zap.L().Info("kafka reader context cancelled", zap.Error(ctx.Err()))
-					return
-				default:
-				}
+// 在 Redis 中记录每个网关实例当前持有连接的讨论，以及每个讨论被哪些实例订阅
+func (r *broadcastRepo) trackSubscription(ctx context.Context, topic string) error {
+	pipe := r.data.redisClient.TxPipeline()
+	pipe.SAdd(ctx, instanceTopicsKey(r.data.instanceID), topic)
+	pipe.Expire(ctx, instanceTopicsKey(r.data.instanceID), subscriptionTTL)
+	pipe.ZAdd(ctx, topicGatewaysKey(topic), &redis.Z{Score: float64(time.Now().Unix()), Member: r.data.instanceID})
+	pipe.Expire(ctx, topicGatewaysKey(topic), subscriptionTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
 
-				msg, err := reader.ReadMessage(ctx)
-				if err != nil {
-					switch {
-					case errors.Is(err, context.Canceled):
-						zap.L().Info("kafka reader cancelled during ReadMessage", zap.Error(err))
-						return
-					case errors.Is(err, io.EOF):
-						zap.L().Info("kafka reader connection closed (EOF)", zap.Error(err))
-						select {
-						case errChan <- fmt.Errorf("kafka connection closed: %w", err):
-						default:
-							zap.L().Error("errChan buffer full, couldn't send EOF error")
-						}
-						return
-					default:
-						zap.L().Error("kafka read error", zap.Error(err))
-						continue
-					}
-				}
+func (r *broadcastRepo) untrackSubscription(ctx context.Context, topic string) error {
+	pipe := r.data.redisClient.TxPipeline()
+	pipe.SRem(ctx, instanceTopicsKey(r.data.instanceID), topic)
+	pipe.ZRem(ctx, topicGatewaysKey(topic), r.data.instanceID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
 
-				var tokenMsg biz.TokenMessage
-				if err := json.Unmarshal(msg.Value, &tokenMsg); err != nil {
-					zap.L().Error("failed to unmarshal message", zap.Error(err), zap.String("message_value", string(msg.Value)))
-					continue
-				}
+// heartbeat 定期续期本实例的订阅记录，实例宕机后记录会随 TTL 过期
+func (r *broadcastRepo) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(subscriptionHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		r.data.rmu.Lock()
+		topics := make([]string, 0, len(r.data.clientConnMap))
+		for topic := range r.data.clientConnMap {
+			topics = append(topics, topic)
+		}
+		r.data.rmu.Unlock()
+		for _, topic := range topics {
+			if err := r.trackSubscription(ctx, topic); err != nil {
+				zap.L().Error("failed to refresh subscription", zap.String("topic", topic), zap.Error(err))
+			}
+		}
+	}
+}
 
-				r.data.mu.Lock()
-				if len(r.data.messageCache[tokenMsg.TopicUID]) != 0 {
-					if tokenMsg.RoleUID != r.data.messageCache[tokenMsg.TopicUID][len(r.data.messageCache[tokenMsg.TopicUID])-1].RoleUID {
+func instanceTopicsKey(instanceID string) string {
+	return fmt.Sprintf("AyanaGateway:%s:topics", instanceID)
+}
 
-						r.data.messageCache[tokenMsg.TopicUID] = r.data.messageCache[tokenMsg.TopicUID][0:0]
-					}
-				}
-				r.data.messageCache[tokenMsg.TopicUID] = append(r.data.messageCache[tokenMsg.TopicUID], &tokenMsg)
+func topicGatewaysKey(topic string) string {
+	return fmt.Sprintf("AyanaTopic:%s:gateways", topic)
+}
 
-				var connsCopy []*clientConn
-				conns, ok := r.data.clientConnMap[tokenMsg.TopicUID]
-				if ok && len(conns) > 0 {
-					connsCopy = make([]*clientConn, len(conns))
-					copy(connsCopy, conns)
-				}
-				r.data.mu.Unlock()
-
-				if len(connsCopy) > 0 {
-					for _, clientConn := range connsCopy {
-						if clientConn.isclosed {
-							continue
-						}
-						select {
-						case clientConn.tokenMessageChan <- &tokenMsg:
-
-						case <-ctx.Done():
-
-							zap.L().Info("context cancelled while sending message to client conn", zap.Error(ctx.Err()))
-							return
-						}
-					}
-				}
+// ReadTopic 从广播总线读取 token 消息并分发给订阅该讨论的连接。
+// 订阅失败或被关闭时按退避间隔重新订阅，Kafka 重新订阅时也会重新读取分区列表
+func (r *broadcastRepo) ReadTopic(ctx context.Context, topic string) error {
+	go r.heartbeat(ctx)
+	backoff := broadcast.NewBackoff()
+	for {
+		err := r.consume(ctx, topic, backoff)
+		if ctx.Err() != nil {
+			zap.L().Info("ReadTopic context cancelled", zap.Error(err))
+			return ctx.Err()
+		}
+		zap.L().Error("broadcast subscription lost, resubscribing", zap.String("topic", topic), zap.Error(err))
+		if err := backoff.Wait(ctx); err != nil {
+			return err
+		}
+	}
+}
 
+// consume 订阅一次并读取到订阅失效为止
+func (r *broadcastRepo) consume(ctx context.Context, topic string, backoff *broadcast.Backoff) error {
+	// 每个网关实例都需要收到全部讨论的 token，不能与其他实例共享消费组
+	sub, err := r.data.broker.Subscribe(ctx, topic, "")
+	if err != nil {
+		return fmt.Errorf("subscribe broadcast topic: %w", err)
+	}
+	defer func() {
+		if closeErr := sub.Close(); closeErr != nil {
+			zap.L().Error("failed to close broadcast subscription", zap.Error(closeErr))
+		}
+	}()
+	zap.L().Info("gateway instance subscribed", zap.String("instance", r.data.instanceID), zap.String("topic", topic))
+
+	for {
+		msg, err := sub.Next(ctx)
+		if err != nil {
+			switch {
+			case errors.Is(err, context.Canceled), errors.Is(err, io.EOF), errors.Is(err, broadcast.ErrClosed):
+				return err
+			default:
+				zap.L().Error("broadcast read error", zap.Error(err))
+				if err := backoff.Wait(ctx); err != nil {
+					return err
+				}
+				continue
 			}
-		}(reader)
+		}
+		backoff.Reset()
+		r.dispatch(ctx, msg)
+	}
+}
+
+// dispatch 把一条 token 消息写入缓存并推送给本实例上订阅该讨论的连接
+func (r *broadcastRepo) dispatch(ctx context.Context, msg broadcast.Message) {
+	var tokenMsg biz.TokenMessage
+	if err := json.Unmarshal(msg.Value, &tokenMsg); err != nil {
+		zap.L().Error("failed to unmarshal message", zap.Error(err), zap.String("message_value", string(msg.Value)))
+		return
 	}
 
-	select {
-	case err := <-errChan:
-		return err
-	case <-ctx.Done():
-		zap.L().Info("ReadTopic context cancelled", zap.Error(ctx.Err()))
-		return ctx.Err()
+	r.data.mu.Lock()
+	if len(r.data.messageCache[tokenMsg.TopicUID]) != 0 {
+		if tokenMsg.RoleUID != r.data.messageCache[tokenMsg.TopicUID][len(r.data.messageCache[tokenMsg.TopicUID])-1].RoleUID {
+
+			r.data.messageCache[tokenMsg.TopicUID] = r.data.messageCache[tokenMsg.TopicUID][0:0]
+		}
+	}
+	r.data.messageCache[tokenMsg.TopicUID] = append(r.data.messageCache[tokenMsg.TopicUID], &tokenMsg)
+
+	r.data.rmu.Lock()
+	conns := append([]*clientConn(nil), r.data.clientConnMap[tokenMsg.TopicUID]...)
+	r.data.rmu.Unlock()
+	r.data.mu.Unlock()
+
+	// 入队不会阻塞，队列满时按配置的策略处理
+	for _, conn := range conns {
+		if conn.push(&tokenMsg) {
+			continue
+		}
+		zap.L().Warn("disconnect slow sse client", zap.String("topic", tokenMsg.TopicUID))
+		sseSlowDisconnects.Inc()
+		target := conn
+		if _, last := r.removeConn(tokenMsg.TopicUID, func(c *clientConn) bool { return c == target }); last {
+			if err := r.untrackSubscription(ctx, tokenMsg.TopicUID); err != nil {
+				zap.L().Error("failed to untrack subscription", zap.String("topic", tokenMsg.TopicUID), zap.Error(err))
+			}
+		}
+		target.close()
 	}
 }
 
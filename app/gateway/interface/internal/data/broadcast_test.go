@@ -0,0 +1,174 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/broadcast"
+	"github.com/Fl0rencess720/Ayana/pkgs/kafkatopic"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// newTestGateway 模拟一个网关实例，订阅记录写入不可用的 Redis，失败只会打日志
+func newTestGateway(t *testing.T, broker broadcast.Broker) *broadcastRepo {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 10 * time.Millisecond})
+	t.Cleanup(func() { rdb.Close() })
+	d := &Data{
+		redisClient:   rdb,
+		broker:        broker,
+		instanceID:    utils.NewInstanceID(),
+		messageCache:  make(map[string][]*biz.TokenMessage),
+		clientConnMap: make(map[string][]*clientConn),
+	}
+	r := NewBroadcastRepo(d, log.DefaultLogger).(*broadcastRepo)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.ReadTopic(ctx, kafkatopic.TOPIC)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return r
+}
+
+func connect(t *testing.T, r *broadcastRepo, topicUID string) chan *biz.TokenMessage {
+	t.Helper()
+	ch := make(chan *biz.TokenMessage, 64)
+	if _, err := r.RegisterConnChannel(context.Background(), topicUID, ch); err != nil {
+		t.Fatalf("register conn: %v", err)
+	}
+	t.Cleanup(func() { r.UngisterConnChannel(context.Background(), topicUID, ch) })
+	return ch
+}
+
+func publishToken(t *testing.T, broker broadcast.Broker, topicUID, content string) {
+	t.Helper()
+	data, err := json.Marshal(biz.TokenMessage{TopicUID: topicUID, RoleUID: "role", ContentType: "text", Content: content})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := broker.Publish(context.Background(), kafkatopic.TOPIC, broadcast.Message{Key: topicUID, Value: data}); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+}
+
+// waitSubscribed 网关异步订阅广播，反复发布探测消息直到每个实例都收到
+func waitSubscribed(t *testing.T, broker broadcast.Broker, gateways ...*broadcastRepo) {
+	t.Helper()
+	var probes []chan *biz.TokenMessage
+	for _, g := range gateways {
+		probes = append(probes, connect(t, g, "probe"))
+	}
+	deadline := time.After(5 * time.Second)
+	for _, probe := range probes {
+		for received := false; !received; {
+			publishToken(t, broker, "probe", "ping")
+			select {
+			case <-probe:
+				received = true
+			case <-time.After(20 * time.Millisecond):
+			case <-deadline:
+				t.Fatal("gateway did not subscribe to the broadcast in time")
+			}
+		}
+	}
+}
+
+func receive(t *testing.T, ch chan *biz.TokenMessage, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		select {
+		case msg := <-ch:
+			if msg.Content != w {
+				t.Fatalf("got %q, want %q", msg.Content, w)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", w)
+		}
+	}
+}
+
+// TestGatewaysReceiveAllTopics 连接在任意一个网关实例上都能收到所在讨论的全部 token
+func TestGatewaysReceiveAllTopics(t *testing.T) {
+	broker := broadcast.NewMemoryBroker()
+	t.Cleanup(func() { broker.Close() })
+	a, b := newTestGateway(t, broker), newTestGateway(t, broker)
+	waitSubscribed(t, broker, a, b)
+
+	onA := connect(t, a, "topic-1")
+	onB := connect(t, b, "topic-1")
+	otherOnB := connect(t, b, "topic-2")
+
+	publishToken(t, broker, "topic-1", "hello")
+	publishToken(t, broker, "topic-2", "other")
+	publishToken(t, broker, "topic-1", "world")
+
+	receive(t, onA, "hello", "world")
+	receive(t, onB, "hello", "world")
+	receive(t, otherOnB, "other")
+	select {
+	case msg := <-otherOnB:
+		t.Fatalf("topic-2 connection received %q from another topic", msg.Content)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// flakyBroker 第一次订阅失败，并记录订阅以便测试关闭正在使用的订阅
+type flakyBroker struct {
+	broadcast.Broker
+
+	mu     sync.Mutex
+	failed bool
+	subs   []broadcast.Subscription
+}
+
+func (b *flakyBroker) Subscribe(ctx context.Context, topic, group string) (broadcast.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.failed {
+		b.failed = true
+		return nil, errors.New("broker unavailable")
+	}
+	sub, err := b.Broker.Subscribe(ctx, topic, group)
+	if err == nil {
+		b.subs = append(b.subs, sub)
+	}
+	return sub, err
+}
+
+func (b *flakyBroker) closeSubscriptions() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, sub := range b.subs {
+		sub.Close()
+	}
+	b.subs = nil
+}
+
+// TestGatewayResubscribes 订阅失败或订阅被关闭后网关重新订阅，连接继续收到消息
+func TestGatewayResubscribes(t *testing.T) {
+	memory := broadcast.NewMemoryBroker()
+	t.Cleanup(func() { memory.Close() })
+	broker := &flakyBroker{Broker: memory}
+	g := newTestGateway(t, broker)
+	waitSubscribed(t, broker, g)
+
+	conn := connect(t, g, "topic-1")
+	publishToken(t, broker, "topic-1", "before")
+	receive(t, conn, "before")
+
+	broker.closeSubscriptions()
+	waitSubscribed(t, broker, g)
+	publishToken(t, broker, "topic-1", "after")
+	receive(t, conn, "after")
+}
@@ -0,0 +1,135 @@
+package data
+
+import (
+	"sync"
+
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+)
+
+const (
+	PolicyDropOldest = "drop_oldest"
+	PolicyCoalesce   = "coalesce"
+	PolicyDisconnect = "disconnect"
+
+	defaultClientQueueSize = 256
+)
+
+// clientConn 为每个 SSE 连接维护一个有界队列，由独立的 goroutine 把消息转发到连接的 channel，
+// 读取广播的 goroutine 只负责入队，单个慢连接不会阻塞其他连接
+type clientConn struct {
+	tokenMessageChan chan *biz.TokenMessage
+
+	mu       sync.Mutex
+	queue    []*biz.TokenMessage
+	capacity int
+	policy   string
+	notify   chan struct{}
+
+	done     chan struct{}
+	doneOnce sync.Once
+	exited   chan struct{}
+}
+
+func newClientConn(connChan chan *biz.TokenMessage, capacity int, policy string) *clientConn {
+	if capacity <= 0 {
+		capacity = defaultClientQueueSize
+	}
+	c := &clientConn{
+		tokenMessageChan: connChan,
+		capacity:         capacity,
+		policy:           policy,
+		notify:           make(chan struct{}, 1),
+		done:             make(chan struct{}),
+		exited:           make(chan struct{}),
+	}
+	go c.pump()
+	return c
+}
+
+// push 把消息放入队列，返回 false 表示按 disconnect 策略需要断开该连接
+func (c *clientConn) push(msg *biz.TokenMessage) bool {
+	c.mu.Lock()
+	if len(c.queue) >= c.capacity {
+		switch c.policy {
+		case PolicyDisconnect:
+			c.mu.Unlock()
+			return false
+		case PolicyCoalesce:
+			if tail := c.queue[len(c.queue)-1]; canCoalesce(tail, msg) {
+				merged := *tail
+				merged.Content += msg.Content
+				c.queue[len(c.queue)-1] = &merged
+				c.mu.Unlock()
+				sseCoalescedMessages.Inc()
+				return true
+			}
+			fallthrough
+		default:
+			c.queue[0] = nil
+			c.queue = c.queue[1:]
+			sseQueuedMessages.Dec()
+			sseDroppedMessages.WithLabelValues(c.policy).Inc()
+		}
+	}
+	c.queue = append(c.queue, msg)
+	depth := len(c.queue)
+	c.mu.Unlock()
+
+	sseQueuedMessages.Inc()
+	sseQueueDepth.Observe(float64(depth))
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (c *clientConn) pop() (*biz.TokenMessage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.queue) == 0 {
+		return nil, false
+	}
+	msg := c.queue[0]
+	c.queue[0] = nil
+	c.queue = c.queue[1:]
+	sseQueuedMessages.Dec()
+	return msg, true
+}
+
+func (c *clientConn) pump() {
+	defer close(c.exited)
+	for {
+		msg, ok := c.pop()
+		if !ok {
+			select {
+			case <-c.notify:
+				continue
+			case <-c.done:
+				return
+			}
+		}
+		select {
+		case c.tokenMessageChan <- msg:
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// close 停止转发并等待转发 goroutine 退出，之后调用方可以安全地关闭 tokenMessageChan
+func (c *clientConn) close() {
+	c.doneOnce.Do(func() {
+		close(c.done)
+	})
+	<-c.exited
+	c.mu.Lock()
+	sseQueuedMessages.Sub(float64(len(c.queue)))
+	c.queue = nil
+	c.mu.Unlock()
+}
+
+func canCoalesce(a, b *biz.TokenMessage) bool {
+	return a.TopicUID == b.TopicUID && a.RoleUID == b.RoleUID && a.ContentType == b.ContentType &&
+		(a.ContentType == "text" || a.ContentType == "reasoning")
+}
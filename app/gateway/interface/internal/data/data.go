@@ -10,7 +10,12 @@ import (
 	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
 	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
 	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+	"github.com/Fl0rencess720/Ayana/pkgs/broadcast"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
 	"github.com/Fl0rencess720/Ayana/pkgs/kafkatopic"
+	"github.com/Fl0rencess720/Ayana/pkgs/limiter"
+	"github.com/Fl0rencess720/Ayana/pkgs/timeout"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -19,29 +24,27 @@ import (
 	"github.com/go-redis/redis/extra/redisotel"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
-	"github.com/segmentio/kafka-go"
+	redisv9 "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
 	grpcx "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewRoleRepo, NewBroadcastRepo, NewSeminarRepo, NewUserRepo, NewRedis, NewRoleServiceClient, NewUserServiceClient, NewSeminarServiceClient, NewKafkaClient)
-
-type kafkaClient struct {
-	readers []*kafka.Reader
-}
-
-type clientConn struct {
-	tokenMessageChan chan *biz.TokenMessage
-	isclosed         bool
-}
+var ProviderSet = wire.NewSet(NewData, NewRoleRepo, NewBroadcastRepo, NewSeminarRepo, NewUserRepo, NewObjectStorage, NewRedis, NewRoleServiceClient, NewUserServiceClient, NewSeminarServiceClient, NewBroker, NewRateLimiter, NewSessionValidator, NewAccountValidator, NewPersonalAccessTokenValidator, NewWorkspaceRoleResolver, NewOIDCProviders, NewOIDCStateRepo)
 
 // Data .
 type Data struct {
 	redisClient *redis.Client
-	kafkaClient *kafkaClient
+	broker      broadcast.Broker
+	// instanceID 标识当前网关实例，每个实例独立订阅全部 token 消息
+	instanceID string
 
 	messageCache  map[string][]*biz.TokenMessage
 	clientConnMap map[string][]*clientConn
+	// SSE 连接队列的长度和队列满时的处理策略
+	clientQueueSize    int
+	slowConsumerPolicy string
 
 	rc roleV1.RoleManagerClient
 	uc userV1.UserClient
@@ -53,28 +56,33 @@ type Data struct {
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger, redisClient *redis.Client, uc userV1.UserClient, rc roleV1.RoleManagerClient,
-	kafkaClient *kafkaClient, sc seminarV1.SeminarClient) (*Data, func(), error) {
+	broker broadcast.Broker, sc seminarV1.SeminarClient) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := broker.Close(); err != nil {
+			zap.L().Error("failed to close broadcast broker", zap.Error(err))
+		}
 	}
 	messageCache := make(map[string][]*biz.TokenMessage)
 	clientConnMap := make(map[string][]*clientConn)
-	return &Data{uc: uc, rc: rc, sc: sc, kafkaClient: kafkaClient, clientConnMap: clientConnMap, messageCache: messageCache, redisClient: redisClient}, cleanup, nil
+	return &Data{uc: uc, rc: rc, sc: sc, broker: broker, instanceID: utils.NewInstanceID(),
+		clientQueueSize: int(c.Broadcast.GetClientQueueSize()), slowConsumerPolicy: c.Broadcast.GetSlowConsumerPolicy(), clientConnMap: clientConnMap, messageCache: messageCache, redisClient: redisClient}, cleanup, nil
 }
 
-func NewKafkaClient(c *conf.Data) *kafkaClient {
-	var readers []*kafka.Reader
-	for i := 0; i < kafkatopic.PARTITIONSIZE; i++ {
-		reader := kafka.NewReader(kafka.ReaderConfig{
-			Brokers:     []string{c.Kafka.Addr},
-			Topic:       kafkatopic.TOPIC,
-			GroupID:     kafkatopic.GROUP,
-			StartOffset: kafka.LastOffset,
-		})
-		readers = append(readers, reader)
-	}
-	return &kafkaClient{
-		readers: readers,
+func NewBroker(c *conf.Data) broadcast.Broker {
+	switch c.Broadcast.GetDriver() {
+	case broadcast.DriverMemory:
+		return broadcast.NewMemoryBroker()
+	case broadcast.DriverRedis:
+		return broadcast.NewRedisBroker(redisv9.NewClient(&redisv9.Options{
+			Addr:         c.Redis.Addr,
+			Password:     c.Redis.Password,
+			DB:           int(c.Redis.Db),
+			DialTimeout:  c.Redis.DialTimeout.AsDuration(),
+			WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+		}))
+	default:
+		return broadcast.NewKafkaBroker([]string{c.Kafka.Addr}, broadcast.WithKafkaReaders(kafkatopic.PARTITIONSIZE))
 	}
 }
 
@@ -91,6 +99,10 @@ func NewRedis(c *conf.Data) *redis.Client {
 	return rdb
 }
 
+func NewRateLimiter(rdb *redis.Client) *limiter.Limiter {
+	return limiter.NewRedisLimiter(rdb)
+}
+
 func NewUserServiceClient(sr *conf.Service, rr registry.Discovery) userV1.UserClient {
 	conn, err := grpc.DialInsecure(
 		context.Background(),
@@ -98,10 +110,11 @@ func NewUserServiceClient(sr *conf.Service, rr registry.Discovery) userV1.UserCl
 		grpc.WithDiscovery(rr),
 		grpc.WithMiddleware(
 			tracing.Client(),
+			identity.Client(),
 			recovery.Recovery(),
 		),
 
-		grpc.WithTimeout(2*time.Second),
+		grpc.WithTimeout(clientTimeout(sr.User.GetTimeout())),
 		grpc.WithOptions(grpcx.WithStatsHandler(&tracing.ClientHandler{})),
 	)
 	if err != nil {
@@ -118,9 +131,12 @@ func NewRoleServiceClient(sr *conf.Service, rr registry.Discovery) roleV1.RoleMa
 		grpc.WithDiscovery(rr),
 		grpc.WithMiddleware(
 			tracing.Client(),
+			identity.Client(),
 			recovery.Recovery(),
+			timeout.Middleware(clientTimeout(sr.Role.GetTimeout()), roleGenerateTimeouts),
 		),
-		grpc.WithTimeout(2*time.Second),
+		// 超时由 timeout 中间件按接口设置，传输层的统一超时会截断生成角色的调用
+		grpc.WithTimeout(0),
 		grpc.WithOptions(grpcx.WithStatsHandler(&tracing.ClientHandler{})),
 	)
 	if err != nil {
@@ -137,9 +153,10 @@ func NewSeminarServiceClient(sr *conf.Service, rr registry.Discovery) seminarV1.
 		grpc.WithDiscovery(rr),
 		grpc.WithMiddleware(
 			tracing.Client(),
+			identity.Client(),
 			recovery.Recovery(),
 		),
-		grpc.WithTimeout(2*time.Second),
+		grpc.WithTimeout(clientTimeout(sr.Seminar.GetTimeout())),
 		grpc.WithOptions(grpcx.WithStatsHandler(&tracing.ClientHandler{})),
 	)
 	if err != nil {
@@ -148,3 +165,17 @@ func NewSeminarServiceClient(sr *conf.Service, rr registry.Discovery) seminarV1.
 	c := seminarV1.NewSeminarClient(conn)
 	return c
 }
+
+// roleGenerateTimeouts 生成角色要等模型输出完整的人设，只有这两个接口放宽超时
+var roleGenerateTimeouts = timeout.Operations{
+	roleV1.OperationRoleManagerGenerateRole:      60 * time.Second,
+	roleV1.OperationRoleManagerGenerateRolePanel: 60 * time.Second,
+}
+
+// clientTimeout 未配置时使用 2s
+func clientTimeout(d *durationpb.Duration) time.Duration {
+	if d == nil {
+		return 2 * time.Second
+	}
+	return d.AsDuration()
+}
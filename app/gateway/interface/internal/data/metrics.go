@@ -0,0 +1,46 @@
+package data
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	sseConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ayana",
+		Subsystem: "gateway",
+		Name:      "sse_connections",
+		Help:      "Open SSE connections on this gateway instance per topic.",
+	}, []string{"topic"})
+	sseQueuedMessages = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "ayana",
+		Subsystem: "gateway",
+		Name:      "sse_queued_messages",
+		Help:      "Number of token messages waiting in SSE client queues.",
+	})
+	sseQueueDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "ayana",
+		Subsystem: "gateway",
+		Name:      "sse_queue_depth",
+		Help:      "Depth of an SSE client queue observed on enqueue.",
+		Buckets:   []float64{1, 4, 16, 32, 64, 128, 256, 512, 1024},
+	})
+	sseDroppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ayana",
+		Subsystem: "gateway",
+		Name:      "sse_dropped_messages_total",
+		Help:      "Token messages dropped because an SSE client queue was full.",
+	}, []string{"policy"})
+	sseCoalescedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "ayana",
+		Subsystem: "gateway",
+		Name:      "sse_coalesced_messages_total",
+		Help:      "Token messages merged into the tail of a full SSE client queue.",
+	})
+	sseSlowDisconnects = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "ayana",
+		Subsystem: "gateway",
+		Name:      "sse_slow_client_disconnects_total",
+		Help:      "SSE connections closed because their queue was full.",
+	})
+)
@@ -0,0 +1,354 @@
+package data
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	oidcHTTPTimeout = 10 * time.Second
+	// 遇到未知 kid 时重新拉取 JWKS，两次拉取之间至少间隔这么久，防止被伪造的 kid 放大请求
+	jwksRefreshInterval = time.Minute
+	// 允许的 ID Token 时钟偏差
+	idTokenLeeway = time.Minute
+)
+
+var defaultOIDCScopes = []string{"openid", "profile", "email", "phone"}
+
+// 只接受非对称签名，避免把公钥当作 HMAC 密钥的算法混淆攻击
+var idTokenSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce               string `json:"nonce"`
+	AuthorizedParty     string `json:"azp"`
+	Email               string `json:"email"`
+	Name                string `json:"name"`
+	PhoneNumber         string `json:"phone_number"`
+	PhoneNumberVerified bool   `json:"phone_number_verified"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// oidcProvider 第一次使用时读取发现文档，身份提供方晚于网关启动也不影响
+type oidcProvider struct {
+	conf   *conf.OIDC_Provider
+	client *http.Client
+
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	keys          map[string]any
+	keysFetchedAt time.Time
+}
+
+func NewOIDCProviders(c *conf.Server) biz.OIDCProviders {
+	providers := make(biz.OIDCProviders)
+	for _, p := range c.GetOidc().GetProviders() {
+		providers[p.Name] = &oidcProvider{conf: p, client: &http.Client{Timeout: oidcHTTPTimeout}}
+	}
+	return providers
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	scopes := p.conf.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.conf.ClientId},
+		"redirect_uri":          {p.conf.RedirectUrl},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + query.Encode(), nil
+}
+
+func (p *oidcProvider) TrustPhone() bool {
+	return p.conf.TrustPhone
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, codeVerifier string) (biz.OIDCClaims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return biz.OIDCClaims{}, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.conf.RedirectUrl},
+		"code_verifier": {codeVerifier},
+		"client_id":     {p.conf.ClientId},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return biz.OIDCClaims{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// 公共客户端只依赖 PKCE，机密客户端使用 client_secret_basic
+	if p.conf.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.conf.ClientId), url.QueryEscape(p.conf.ClientSecret))
+	}
+	var token oidcTokenResponse
+	if err := p.doJSON(req, &token); err != nil && token.Error == "" {
+		return biz.OIDCClaims{}, err
+	}
+	if token.Error != "" {
+		return biz.OIDCClaims{}, fmt.Errorf("token endpoint: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return biz.OIDCClaims{}, errors.New("token response has no id_token")
+	}
+	return p.verifyIDToken(ctx, d, token.IDToken)
+}
+
+func (p *oidcProvider) verifyIDToken(ctx context.Context, d *oidcDiscovery, raw string) (biz.OIDCClaims, error) {
+	var claims idTokenClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, d, kid)
+	}, jwt.WithValidMethods(idTokenSigningMethods), jwt.WithoutClaimsValidation())
+	if err != nil {
+		return biz.OIDCClaims{}, err
+	}
+	now := time.Now()
+	switch {
+	case claims.Issuer != d.Issuer:
+		return biz.OIDCClaims{}, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	case !claims.VerifyAudience(p.conf.ClientId, true):
+		return biz.OIDCClaims{}, fmt.Errorf("unexpected audience %v", claims.Audience)
+	case len(claims.Audience) > 1 && claims.AuthorizedParty != p.conf.ClientId:
+		return biz.OIDCClaims{}, fmt.Errorf("unexpected authorized party %q", claims.AuthorizedParty)
+	case claims.ExpiresAt == nil || now.After(claims.ExpiresAt.Add(idTokenLeeway)):
+		return biz.OIDCClaims{}, errors.New("id token expired")
+	case claims.IssuedAt != nil && claims.IssuedAt.After(now.Add(idTokenLeeway)):
+		return biz.OIDCClaims{}, errors.New("id token issued in the future")
+	case claims.Subject == "":
+		return biz.OIDCClaims{}, errors.New("id token has no subject")
+	}
+	return biz.OIDCClaims{
+		Subject:             claims.Subject,
+		Email:               claims.Email,
+		Name:                claims.Name,
+		PhoneNumber:         claims.PhoneNumber,
+		PhoneNumberVerified: claims.PhoneNumberVerified,
+		Nonce:               claims.Nonce,
+	}, nil
+}
+
+func (p *oidcProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.conf.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var d oidcDiscovery
+	if err := p.doJSON(req, &d); err != nil {
+		return nil, err
+	}
+	// 发现文档中的签发者必须与配置一致，ID Token 的 iss 也以此为准
+	if d.Issuer != p.conf.Issuer {
+		return nil, fmt.Errorf("issuer %q does not match configured %q", d.Issuer, p.conf.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("incomplete discovery document")
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKey 按 kid 查找签名公钥，找不到时刷新 JWKS 以支持身份提供方轮换密钥
+func (p *oidcProvider) getKey(ctx context.Context, d *oidcDiscovery, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.doJSON(req, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]any, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	p.keys, p.keysFetchedAt = keys, time.Now()
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// lookupKey ID Token 没有 kid 时只有 JWKS 中仅有一个密钥才能确定使用哪个
+func (p *oidcProvider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *oidcProvider) doJSON(req *http.Request, v any) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	// 令牌端点的错误响应也是 JSON，先解析再返回状态码错误
+	jsonErr := json.Unmarshal(body, v)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s", req.Method, req.URL.Redacted(), resp.Status)
+	}
+	return jsonErr
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+type oidcStateRepo struct {
+	data *Data
+}
+
+func NewOIDCStateRepo(data *Data) biz.OIDCStateRepo {
+	return &oidcStateRepo{data: data}
+}
+
+func oidcStateKey(state string) string {
+	return "oidc_state:" + state
+}
+
+func (r *oidcStateRepo) SaveAuthState(ctx context.Context, state string, s biz.OIDCAuthState, ttl time.Duration) error {
+	serialized, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return r.data.redisClient.Set(ctx, oidcStateKey(state), serialized, ttl).Err()
+}
+
+func (r *oidcStateRepo) TakeAuthState(ctx context.Context, state string) (biz.OIDCAuthState, error) {
+	serialized, err := r.data.redisClient.GetDel(ctx, oidcStateKey(state)).Bytes()
+	if err == redis.Nil {
+		return biz.OIDCAuthState{}, biz.ErrOIDCStateInvalid
+	}
+	if err != nil {
+		return biz.OIDCAuthState{}, err
+	}
+	var s biz.OIDCAuthState
+	if err := json.Unmarshal(serialized, &s); err != nil {
+		return biz.OIDCAuthState{}, biz.ErrOIDCStateInvalid
+	}
+	return s, nil
+}
@@ -0,0 +1,324 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+)
+
+const testClientID = "ayana"
+
+// testIssuer 模拟身份提供方：发现文档、JWKS 和令牌端点，令牌端点返回 idToken 生成的 ID Token
+type testIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu      sync.Mutex
+	idToken func() string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oidcDiscovery{
+			Issuer:                iss.URL,
+			AuthorizationEndpoint: iss.URL + "/authorize",
+			TokenEndpoint:         iss.URL + "/token",
+			JWKSURI:               iss.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		pub := key.PublicKey
+		json.NewEncoder(w).Encode(map[string][]jsonWebKey{"keys": {{
+			Kty: "RSA",
+			Kid: "k1",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		iss.mu.Lock()
+		defer iss.mu.Unlock()
+		json.NewEncoder(w).Encode(oidcTokenResponse{IDToken: iss.idToken()})
+	})
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+func (iss *testIssuer) provider() *oidcProvider {
+	return &oidcProvider{
+		conf:   &conf.OIDC_Provider{Name: "test", Issuer: iss.URL, ClientId: testClientID, RedirectUrl: "http://gateway/user/oidc/callback"},
+		client: iss.Client(),
+	}
+}
+
+func (iss *testIssuer) claims(nonce string) *idTokenClaims {
+	now := time.Now()
+	return &idTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    iss.URL,
+			Subject:   "user-1",
+			Audience:  jwt.ClaimStrings{testClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Nonce: nonce,
+	}
+}
+
+func (iss *testIssuer) sign(t *testing.T, claims *idTokenClaims, kid string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	raw, err := token.SignedString(iss.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	iss := newTestIssuer(t)
+	ctx := context.Background()
+
+	// hmacWithPublicKey 把公钥当作 HMAC 密钥签名，验证方如果接受 HS256 就会被伪造
+	hmacWithPublicKey := func(claims *idTokenClaims) string {
+		der, err := x509.MarshalPKIXPublicKey(&iss.key.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = "k1"
+		raw, err := token.SignedString(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+	unsigned := func(claims *idTokenClaims) string {
+		raw, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+
+	cases := []struct {
+		name    string
+		token   func(c *idTokenClaims) string
+		wantErr bool
+	}{
+		{name: "valid", token: func(c *idTokenClaims) string { return iss.sign(t, c, "k1") }},
+		{name: "bad issuer", wantErr: true, token: func(c *idTokenClaims) string {
+			c.Issuer = "https://evil.example.com"
+			return iss.sign(t, c, "k1")
+		}},
+		{name: "bad audience", wantErr: true, token: func(c *idTokenClaims) string {
+			c.Audience = jwt.ClaimStrings{"someone-else"}
+			return iss.sign(t, c, "k1")
+		}},
+		{name: "multiple audiences without azp", wantErr: true, token: func(c *idTokenClaims) string {
+			c.Audience = jwt.ClaimStrings{testClientID, "someone-else"}
+			return iss.sign(t, c, "k1")
+		}},
+		{name: "expired", wantErr: true, token: func(c *idTokenClaims) string {
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-2 * idTokenLeeway))
+			return iss.sign(t, c, "k1")
+		}},
+		{name: "within leeway", token: func(c *idTokenClaims) string {
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-idTokenLeeway / 2))
+			return iss.sign(t, c, "k1")
+		}},
+		{name: "no expiry", wantErr: true, token: func(c *idTokenClaims) string {
+			c.ExpiresAt = nil
+			return iss.sign(t, c, "k1")
+		}},
+		{name: "unknown kid", wantErr: true, token: func(c *idTokenClaims) string { return iss.sign(t, c, "k2") }},
+		{name: "hs256 with public key", wantErr: true, token: hmacWithPublicKey},
+		{name: "alg none", wantErr: true, token: unsigned},
+		{name: "no subject", wantErr: true, token: func(c *idTokenClaims) string {
+			c.Subject = ""
+			return iss.sign(t, c, "k1")
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := iss.provider()
+			d, err := p.getDiscovery(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims, err := p.verifyIDToken(ctx, d, tc.token(iss.claims("n")))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("token accepted")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if claims.Subject != "user-1" || claims.Nonce != "n" {
+				t.Fatalf("unexpected claims %+v", claims)
+			}
+		})
+	}
+}
+
+type memoryStateRepo struct {
+	mu     sync.Mutex
+	states map[string]biz.OIDCAuthState
+}
+
+func (r *memoryStateRepo) SaveAuthState(ctx context.Context, state string, s biz.OIDCAuthState, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[state] = s
+	return nil
+}
+
+func (r *memoryStateRepo) TakeAuthState(ctx context.Context, state string) (biz.OIDCAuthState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.states[state]
+	if !ok {
+		return biz.OIDCAuthState{}, biz.ErrOIDCStateInvalid
+	}
+	delete(r.states, state)
+	return s, nil
+}
+
+// loginRecorder 记录网关转发给用户服务的登录请求
+type loginRecorder struct {
+	userV1.UserClient
+	requests []*userV1.OIDCLoginRequest
+}
+
+func (c *loginRecorder) OIDCLogin(ctx context.Context, in *userV1.OIDCLoginRequest, opts ...grpc.CallOption) (*userV1.OIDCLoginReply, error) {
+	c.requests = append(c.requests, in)
+	return &userV1.OIDCLoginReply{AccessToken: "access", RefreshToken: "refresh"}, nil
+}
+
+func TestCallback(t *testing.T) {
+	iss := newTestIssuer(t)
+	ctx := context.Background()
+
+	// start 发起一次登录，令牌端点返回的 ID Token 由 mutate 修改
+	start := func(t *testing.T, trustPhone bool, mutate func(c *idTokenClaims)) (*biz.OIDCUsecase, *loginRecorder, string) {
+		p := iss.provider()
+		p.conf.TrustPhone = trustPhone
+		users := &loginRecorder{}
+		uc := biz.NewOIDCUsecase(&conf.Server{}, biz.OIDCProviders{"test": p},
+			&memoryStateRepo{states: map[string]biz.OIDCAuthState{}}, users, log.DefaultLogger)
+		authURL, state, err := uc.AuthURL(ctx, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(authURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := u.Query().Get("state"); got != state {
+			t.Fatalf("auth url state %q, want %q", got, state)
+		}
+		if !strings.HasPrefix(authURL, iss.URL+"/authorize?") || u.Query().Get("code_challenge_method") != "S256" {
+			t.Fatalf("unexpected auth url %s", authURL)
+		}
+		claims := iss.claims(u.Query().Get("nonce"))
+		claims.PhoneNumber, claims.PhoneNumberVerified = "13800000000", true
+		if mutate != nil {
+			mutate(claims)
+		}
+		iss.mu.Lock()
+		iss.idToken = func() string { return iss.sign(t, claims, "k1") }
+		iss.mu.Unlock()
+		return uc, users, state
+	}
+
+	t.Run("success", func(t *testing.T) {
+		uc, users, state := start(t, false, nil)
+		if _, err := uc.Callback(ctx, state, state, "code"); err != nil {
+			t.Fatalf("callback: %v", err)
+		}
+		if len(users.requests) != 1 || users.requests[0].Subject != "user-1" || users.requests[0].Provider != "test" {
+			t.Fatalf("unexpected login requests %+v", users.requests)
+		}
+		// 未配置信任的提供方不能按手机号关联账号
+		if users.requests[0].TrustPhone {
+			t.Fatal("phone trusted for a provider without trust_phone")
+		}
+		// state 只能使用一次
+		if _, err := uc.Callback(ctx, state, state, "code"); !errors.Is(err, biz.ErrOIDCStateInvalid) {
+			t.Fatalf("replayed state: got %v, want ErrOIDCStateInvalid", err)
+		}
+	})
+
+	t.Run("trusted phone", func(t *testing.T) {
+		uc, users, state := start(t, true, nil)
+		if _, err := uc.Callback(ctx, state, state, "code"); err != nil {
+			t.Fatalf("callback: %v", err)
+		}
+		if !users.requests[0].TrustPhone || users.requests[0].Phone != "13800000000" {
+			t.Fatalf("unexpected login request %+v", users.requests[0])
+		}
+	})
+
+	t.Run("state cookie mismatch", func(t *testing.T) {
+		uc, users, state := start(t, false, nil)
+		for _, bound := range []string{"", "attacker-state"} {
+			if _, err := uc.Callback(ctx, state, bound, "code"); !errors.Is(err, biz.ErrOIDCStateInvalid) {
+				t.Fatalf("bound state %q: got %v, want ErrOIDCStateInvalid", bound, err)
+			}
+		}
+		if len(users.requests) != 0 {
+			t.Fatal("user logged in without a matching state cookie")
+		}
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		uc, users, state := start(t, false, func(c *idTokenClaims) { c.Nonce = "replayed" })
+		if _, err := uc.Callback(ctx, state, state, "code"); !errors.Is(err, biz.ErrOIDCLoginFailed) {
+			t.Fatalf("got %v, want ErrOIDCLoginFailed", err)
+		}
+		if len(users.requests) != 0 {
+			t.Fatal("user logged in with a mismatched nonce")
+		}
+	})
+
+	t.Run("invalid id token", func(t *testing.T) {
+		uc, users, state := start(t, false, func(c *idTokenClaims) { c.Audience = jwt.ClaimStrings{"someone-else"} })
+		if _, err := uc.Callback(ctx, state, state, "code"); !errors.Is(err, biz.ErrOIDCLoginFailed) {
+			t.Fatalf("got %v, want ErrOIDCLoginFailed", err)
+		}
+		if len(users.requests) != 0 {
+			t.Fatal("user logged in with an invalid id token")
+		}
+	})
+}
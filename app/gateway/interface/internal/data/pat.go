@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// personalAccessTokenValidator 先查用户服务写入的缓存，未命中时调用用户服务校验
+type personalAccessTokenValidator struct {
+	data *Data
+}
+
+func NewPersonalAccessTokenValidator(data *Data) jwtc.PersonalAccessTokenValidator {
+	return &personalAccessTokenValidator{data: data}
+}
+
+func (v *personalAccessTokenValidator) ValidPersonalAccessToken(ctx context.Context, token string) (*jwtc.PersonalAccessToken, error) {
+	serialized, err := v.data.redisClient.Get(ctx, jwtc.PersonalAccessTokenKey(jwtc.HashPersonalAccessToken(token))).Bytes()
+	if err == nil {
+		var pat jwtc.PersonalAccessToken
+		if err := json.Unmarshal(serialized, &pat); err == nil && time.Now().Before(pat.ExpiresAt) {
+			return &pat, nil
+		}
+	} else if err != redis.Nil {
+		zap.L().Error("get personal access token cache failed", zap.Error(err))
+	}
+
+	reply, err := v.data.uc.VerifyPersonalAccessToken(ctx, &userV1.VerifyPersonalAccessTokenRequest{Token: token})
+	if errors.IsUnauthorized(err) {
+		return nil, jwtc.ErrPersonalAccessTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &jwtc.PersonalAccessToken{
+		UID:       reply.Uid,
+		Phone:     reply.Phone,
+		Scopes:    reply.Scopes,
+		ExpiresAt: time.Unix(reply.ExpiresAt, 0),
+	}, nil
+}
@@ -20,12 +20,12 @@ func NewRoleRepo(data *Data, logger log.Logger) biz.RoleRepo {
 	}
 }
 
-func (r *roleRepo) GetAvailableModelsFromRedis(ctx context.Context) ([]biz.Model, error) {
+func (r *roleRepo) GetAvailableModelsFromRedis(ctx context.Context) ([]biz.AvailableModel, error) {
 	data, err := r.data.redisClient.Get(context.Background(), "models").Bytes()
 	if err != nil {
 		return nil, err
 	}
-	var models []biz.Model
+	var models []biz.AvailableModel
 	if err := json.Unmarshal(data, &models); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"go.uber.org/zap"
+)
+
+// ErrAuthUnavailable 无法确认会话和账号状态时拒绝请求，避免已注销的会话和停用的账号继续使用
+var ErrAuthUnavailable = errors.ServiceUnavailable("AUTH_UNAVAILABLE", "暂时无法验证登录状态，请稍后重试")
+
+// sessionValidator 直接检查用户服务写入 Redis 的会话，避免每个请求都调用用户服务
+type sessionValidator struct {
+	data *Data
+}
+
+func NewSessionValidator(data *Data) jwtc.SessionValidator {
+	return &sessionValidator{data: data}
+}
+
+// ValidSession Redis 不可用时返回 ErrAuthUnavailable
+func (v *sessionValidator) ValidSession(ctx context.Context, sid string) (bool, error) {
+	n, err := v.data.redisClient.Exists(ctx, jwtc.SessionKey(sid)).Result()
+	if err != nil {
+		zap.L().Error("check session failed", zap.String("sid", sid), zap.Error(err))
+		return false, ErrAuthUnavailable
+	}
+	return n == 1, nil
+}
+
+// accountValidator 检查用户服务停用账号时写入 Redis 的标记
+type accountValidator struct {
+	data *Data
+}
+
+func NewAccountValidator(data *Data) jwtc.AccountValidator {
+	return &accountValidator{data: data}
+}
+
+// DisabledAccount Redis 不可用时返回 ErrAuthUnavailable
+func (v *accountValidator) DisabledAccount(ctx context.Context, phone string) (bool, error) {
+	n, err := v.data.redisClient.Exists(ctx, jwtc.DisabledUserKey(phone)).Result()
+	if err != nil {
+		zap.L().Error("check account status failed", zap.String("phone", phone), zap.Error(err))
+		return false, ErrAuthUnavailable
+	}
+	return n == 1, nil
+}
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+// TestValidatorsFailClosed Redis 不可用时拒绝请求而不是放行
+func TestValidatorsFailClosed(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 10 * time.Millisecond})
+	t.Cleanup(func() { rdb.Close() })
+	d := &Data{redisClient: rdb}
+
+	valid, err := NewSessionValidator(d).ValidSession(context.Background(), "sid")
+	if valid || errors.Code(err) != 503 {
+		t.Fatalf("ValidSession: got %v, %v, want false and 503", valid, err)
+	}
+	disabled, err := NewAccountValidator(d).DisabledAccount(context.Background(), "13800000000")
+	if disabled || errors.Code(err) != 503 {
+		t.Fatalf("DisabledAccount: got %v, %v, want false and 503", disabled, err)
+	}
+}
@@ -0,0 +1,296 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
+)
+
+const (
+	StorageDriverLocal = "local"
+	StorageDriverS3    = "s3"
+
+	// localImageServePath 本地存储的签名 URL 由网关的这个路由提供文件
+	localImageServePath = "/image/serving"
+)
+
+func NewObjectStorage(c *conf.Data) (biz.ObjectStorage, error) {
+	sc := c.GetStorage()
+	if sc.GetSignSecret() == "" && sc.GetDriver() != StorageDriverS3 {
+		return nil, errors.New("storage sign_secret is required")
+	}
+	switch sc.GetDriver() {
+	case StorageDriverS3:
+		s3 := sc.GetS3()
+		if s3.GetEndpoint() == "" || s3.GetBucket() == "" {
+			return nil, errors.New("s3 storage requires endpoint and bucket")
+		}
+		return newS3Storage(s3)
+	case "", StorageDriverLocal:
+		return newLocalStorage(sc.GetLocal().GetDir(), sc.GetSignSecret())
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", sc.GetDriver())
+	}
+}
+
+// localStorage 把文件保存在本地目录，签名 URL 指向网关并由网关校验 HMAC 签名
+type localStorage struct {
+	dir    string
+	secret []byte
+}
+
+func newLocalStorage(dir, secret string) (*localStorage, error) {
+	if dir == "" {
+		dir = "storage"
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &localStorage{dir: dir, secret: []byte(secret)}, nil
+}
+
+// path 把 key 限制在存储目录内，防止路径穿越
+func (s *localStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		return "", biz.ErrImageNotFound
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	// 先写临时文件再改名，避免读到写了一半的文件
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+func (s *localStorage) Open(ctx context.Context, key string) (io.ReadCloser, string, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, "", err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, "", biz.ErrImageNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return f, mime.TypeByExtension(filepath.Ext(p)), nil
+}
+
+func (s *localStorage) SignURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	q := url.Values{}
+	q.Set("key", key)
+	q.Set("expires", expires)
+	q.Set("signature", s.sign(key, expires))
+	return localImageServePath + "?" + q.Encode(), nil
+}
+
+func (s *localStorage) VerifyURL(key, expires, signature string) error {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		return biz.ErrImageURLInvalid
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+		return biz.ErrImageURLInvalid
+	}
+	return nil
+}
+
+func (s *localStorage) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// s3Storage 兼容 S3 协议的对象存储（如 MinIO），使用路径风格的地址和 SigV4 签名
+type s3Storage struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *nethttp.Client
+}
+
+func newS3Storage(c *conf.Data_Storage_S3) (*s3Storage, error) {
+	endpoint, err := url.Parse(c.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	region := c.GetRegion()
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &s3Storage{endpoint: endpoint, region: region, bucket: c.GetBucket(), accessKey: c.GetAccessKey(),
+		secretKey: c.GetSecretKey(), client: &nethttp.Client{Timeout: 30 * time.Second}}, nil
+}
+
+func (s *s3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+	return &u
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.signRequest(req, data, time.Now())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != nethttp.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 put %s: %s %s", key, resp.Status, body)
+	}
+	return nil
+}
+
+func (s *s3Storage) Open(ctx context.Context, key string) (io.ReadCloser, string, error) {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	s.signRequest(req, nil, time.Now())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode == nethttp.StatusNotFound {
+		resp.Body.Close()
+		return nil, "", biz.ErrImageNotFound
+	}
+	if resp.StatusCode != nethttp.StatusOK {
+		resp.Body.Close()
+		return nil, "", fmt.Errorf("s3 get %s: %s", key, resp.Status)
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+// SignURL 生成预签名的 GET 地址，客户端直接从对象存储下载
+func (s *s3Storage) SignURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	now := time.Now().UTC()
+	u := s.objectURL(key)
+	q := url.Values{}
+	q.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	q.Set("X-Amz-Credential", s.accessKey+"/"+s.scope(now))
+	q.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	q.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	q.Set("X-Amz-SignedHeaders", "host")
+	canonical := strings.Join([]string{
+		nethttp.MethodGet,
+		s3EscapePath(u.Path),
+		s3CanonicalQuery(q),
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	q.Set("X-Amz-Signature", s.signature(now, canonical))
+	u.RawQuery = s3CanonicalQuery(q)
+	return u.String(), nil
+}
+
+func (s *s3Storage) signRequest(req *nethttp.Request, payload []byte, now time.Time) {
+	now = now.UTC()
+	payloadHash := sha256Hex(payload)
+	amzDate := now.Format("20060102T150405Z")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonical := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		s3CanonicalQuery(req.URL.Query()),
+		"host:" + req.URL.Host + "\nx-amz-content-sha256:" + payloadHash + "\nx-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, s.scope(now), signedHeaders, s.signature(now, canonical)))
+}
+
+func (s *s3Storage) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+func (s *s3Storage) signature(now time.Time, canonicalRequest string) string {
+	stringToSign := "AWS4-HMAC-SHA256\n" + now.Format("20060102T150405Z") + "\n" + s.scope(now) + "\n" + sha256Hex([]byte(canonicalRequest))
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// s3Escape 按 SigV4 的要求编码，只保留非保留字符，空格编码为 %20
+func s3Escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func s3EscapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		segments[i] = s3Escape(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+func s3CanonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range q[k] {
+			parts = append(parts, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,39 @@
+package data
+
+import (
+	"context"
+
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// workspaceRoleResolver 先查用户服务写入的缓存，未命中时调用用户服务查询
+type workspaceRoleResolver struct {
+	data *Data
+}
+
+func NewWorkspaceRoleResolver(data *Data) workspace.RoleResolver {
+	return &workspaceRoleResolver{data: data}
+}
+
+func (r *workspaceRoleResolver) MemberRole(ctx context.Context, uid, phone string) (string, error) {
+	role, err := r.data.redisClient.Get(ctx, workspace.MemberKey(uid, phone)).Result()
+	if err == nil && workspace.ValidRole(role) {
+		return role, nil
+	}
+	if err != nil && err != redis.Nil {
+		zap.L().Error("get workspace member cache failed", zap.Error(err))
+	}
+
+	reply, err := r.data.uc.GetWorkspaceRole(ctx, &userV1.GetWorkspaceRoleRequest{Uid: uid, Phone: phone})
+	if errors.IsForbidden(err) {
+		return "", workspace.ErrForbidden
+	}
+	if err != nil {
+		return "", err
+	}
+	return reply.Role, nil
+}
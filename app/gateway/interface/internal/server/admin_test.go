@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeHeader map[string][]string
+
+func (h fakeHeader) Get(key string) string {
+	if v := h[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+func (h fakeHeader) Set(key, value string)      { h[key] = []string{value} }
+func (h fakeHeader) Add(key, value string)      { h[key] = append(h[key], value) }
+func (h fakeHeader) Values(key string) []string { return h[key] }
+func (h fakeHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type fakeTransport struct {
+	operation string
+}
+
+func (t fakeTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t fakeTransport) Endpoint() string                { return "" }
+func (t fakeTransport) Operation() string               { return t.operation }
+func (t fakeTransport) RequestHeader() transport.Header { return fakeHeader{} }
+func (t fakeTransport) ReplyHeader() transport.Header   { return fakeHeader{} }
+
+// fakeUserService 模拟用户服务当前的管理员名单和审计日志
+type fakeUserService struct {
+	admins  map[string]bool
+	err     error
+	checked []string
+	logs    []*userV1.AuditLog
+}
+
+func (f *fakeUserService) CheckAdmin(ctx context.Context, phone string) (bool, error) {
+	f.checked = append(f.checked, phone)
+	return f.admins[phone], f.err
+}
+
+func (f *fakeUserService) RecordAudit(ctx context.Context, entry *userV1.AuditLog) error {
+	f.logs = append(f.logs, entry)
+	return nil
+}
+
+func adminContext(operation, phone string, adminClaim bool) context.Context {
+	ctx := transport.NewServerContext(context.Background(), fakeTransport{operation: operation})
+	ctx = context.WithValue(ctx, jwtc.PhoneKey, phone)
+	return context.WithValue(ctx, jwtc.AdminKey, adminClaim)
+}
+
+func TestAdminMatcher(t *testing.T) {
+	match := NewAdminMatcher()
+	cases := []struct {
+		operation string
+		want      bool
+	}{
+		{operation: "/Ayana.v1.RoleManager/GetModels", want: true},
+		{operation: "/Ayana.v1.RoleManager/AdminDeleteSystemRole", want: true},
+		{operation: "/Ayana.v1.User/AdminSetUserStatus", want: true},
+		{operation: "/Ayana.v1.User/AdminGetAuditLogs", want: true},
+		{operation: "/Ayana.v1.Seminar/AdminStopTopic", want: true},
+		{operation: "/Ayana.v1.RoleManager/GetRoles", want: false},
+		{operation: "/Ayana.v1.User/RecordAuditLog", want: false},
+		{operation: "/Ayana.v1.User/CheckAdmin", want: false},
+	}
+	for _, c := range cases {
+		t.Run(c.operation, func(t *testing.T) {
+			if got := match(context.Background(), c.operation); got != c.want {
+				t.Fatalf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAdminOnly(t *testing.T) {
+	const (
+		readOnly = "/Ayana.v1.User/AdminGetUsers"
+		mutating = "/Ayana.v1.RoleManager/AdminDeleteSystemRole"
+	)
+	cases := []struct {
+		name        string
+		operation   string
+		adminClaim  bool
+		listed      bool
+		checkErr    error
+		wantCode    codes.Code
+		wantChecked bool
+	}{
+		{name: "non-admin is rejected on a read-only call", operation: readOnly, wantCode: codes.PermissionDenied},
+		{name: "non-admin is rejected on a mutating call", operation: mutating, wantCode: codes.PermissionDenied},
+		{name: "admin claim is enough for a read-only call", operation: readOnly, adminClaim: true, wantCode: codes.OK},
+		{name: "listed admin passes a mutating call", operation: mutating, adminClaim: true, listed: true, wantCode: codes.OK, wantChecked: true},
+		{name: "demoted admin is rejected on a mutating call", operation: mutating, adminClaim: true, wantCode: codes.PermissionDenied, wantChecked: true},
+		{name: "user service unavailable fails closed", operation: mutating, adminClaim: true, listed: true, checkErr: errors.New("connection refused"), wantCode: codes.Unavailable, wantChecked: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			users := &fakeUserService{admins: map[string]bool{"13800000000": c.listed}, err: c.checkErr}
+			called := false
+			handler := AdminOnly(users)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return "ok", nil
+			})
+			_, err := handler(adminContext(c.operation, "13800000000", c.adminClaim), &roleV1.AdminDeleteSystemRoleRequest{Uid: "sr-1"})
+			if got := status.Code(err); got != c.wantCode {
+				t.Fatalf("got %v (%v), want %v", got, err, c.wantCode)
+			}
+			if called != (c.wantCode == codes.OK) {
+				t.Fatalf("handler called = %v, want %v", called, c.wantCode == codes.OK)
+			}
+			if checked := len(users.checked) > 0; checked != c.wantChecked {
+				t.Fatalf("checked user service = %v, want %v", checked, c.wantChecked)
+			}
+		})
+	}
+}
+
+// TestAdminAudit 按 http.go 中的顺序组合中间件，只有通过管理员校验的管理操作才会记录
+func TestAdminAudit(t *testing.T) {
+	cases := []struct {
+		name       string
+		operation  string
+		adminClaim bool
+		listed     bool
+		req        interface{}
+		handlerErr error
+		wantLogged bool
+		wantTarget string
+		wantOK     bool
+	}{
+		{name: "read-only call is recorded", operation: "/Ayana.v1.User/AdminGetUsers", adminClaim: true,
+			req: &userV1.AdminGetUsersRequest{Keyword: "138"}, wantLogged: true, wantOK: true},
+		{name: "mutating call records its target", operation: "/Ayana.v1.User/AdminSetUserStatus", adminClaim: true, listed: true,
+			req: &userV1.AdminSetUserStatusRequest{Phone: "13900000000", Disabled: true}, wantLogged: true, wantTarget: "13900000000", wantOK: true},
+		{name: "failed call records the error", operation: "/Ayana.v1.RoleManager/AdminDeleteSystemRole", adminClaim: true, listed: true,
+			req: &roleV1.AdminDeleteSystemRoleRequest{Uid: "sr-1"}, handlerErr: status.Error(codes.NotFound, "系统角色不存在"), wantLogged: true, wantTarget: "sr-1"},
+		{name: "rejected non-admin is not recorded", operation: "/Ayana.v1.User/AdminGetUsers",
+			req: &userV1.AdminGetUsersRequest{}},
+		{name: "rejected demoted admin is not recorded", operation: "/Ayana.v1.RoleManager/AdminDeleteSystemRole", adminClaim: true,
+			req: &roleV1.AdminDeleteSystemRoleRequest{Uid: "sr-1"}},
+		{name: "non-admin operation is not recorded", operation: "/Ayana.v1.RoleManager/GetRoles",
+			req: &roleV1.GetRolesRequest{}, wantOK: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			users := &fakeUserService{admins: map[string]bool{"13800000000": c.listed}}
+			mw := selector.Server(AdminOnly(users), AdminAudit(users)).Match(NewAdminMatcher()).Build()
+			handler := mw(func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", c.handlerErr
+			})
+			_, err := handler(adminContext(c.operation, "13800000000", c.adminClaim), c.req)
+			if (err == nil) != c.wantOK {
+				t.Fatalf("got error %v, want success %v", err, c.wantOK)
+			}
+			if !c.wantLogged {
+				if len(users.logs) != 0 {
+					t.Fatalf("got %d audit logs, want none", len(users.logs))
+				}
+				return
+			}
+			if len(users.logs) != 1 {
+				t.Fatalf("got %d audit logs, want 1", len(users.logs))
+			}
+			entry := users.logs[0]
+			if entry.Actor != "13800000000" || entry.Operation != c.operation || entry.Target != c.wantTarget || entry.Success != c.wantOK {
+				t.Fatalf("unexpected audit log: %+v", entry)
+			}
+			if !c.wantOK && entry.Error == "" {
+				t.Fatal("audit log of a failed call has no error")
+			}
+		})
+	}
+}
+
+func TestAuditRequestRedactsSecrets(t *testing.T) {
+	got := auditRequest(&userV1.ChangePasswordRequest{OldPassword: "old-secret", NewPassword: "new-secret"})
+	if strings.Contains(got, "secret") {
+		t.Fatalf("password leaked into audit request: %s", got)
+	}
+}
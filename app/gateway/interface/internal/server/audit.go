@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	userV1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/limiter"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuditRecorder 保存管理操作的审计记录
+type AuditRecorder interface {
+	RecordAudit(ctx context.Context, entry *userV1.AuditLog) error
+}
+
+// sensitiveFields 请求中包含这些词的字段不写入审计记录
+var sensitiveFields = []string{"key", "secret", "password", "token"}
+
+// AdminAudit 需要在 AdminOnly 之后执行，记录每次管理操作的调用者、对象和结果。
+// 记录失败只打日志，不影响操作本身
+func AdminAudit(recorder AuditRecorder) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+
+			entry := &userV1.AuditLog{
+				Actor:     utils.GetPhoneFromContext(ctx),
+				Target:    auditTarget(req),
+				Request:   auditRequest(req),
+				Success:   err == nil,
+				Ip:        limiter.ClientIP(ctx),
+				CreatedAt: time.Now().Unix(),
+			}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				entry.Operation = tr.Operation()
+			}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+			// 客户端断开后仍然要写入记录
+			if rerr := recorder.RecordAudit(context.WithoutCancel(ctx), entry); rerr != nil {
+				zap.L().Error("record audit log failed", zap.String("operation", entry.Operation),
+					zap.String("actor", entry.Actor), zap.Error(rerr))
+			}
+			return reply, err
+		}
+	}
+}
+
+// auditTarget 取出操作的对象：用户、讨论、系统角色或模型
+func auditTarget(req interface{}) string {
+	if r, ok := req.(interface{ GetPhone() string }); ok && r.GetPhone() != "" {
+		return r.GetPhone()
+	}
+	if r, ok := req.(interface{ GetTopicId() string }); ok && r.GetTopicId() != "" {
+		return r.GetTopicId()
+	}
+	if r, ok := req.(interface{ GetUid() string }); ok && r.GetUid() != "" {
+		return r.GetUid()
+	}
+	if r, ok := req.(interface{ GetRole() *roleV1.SystemRole }); ok {
+		return r.GetRole().GetRole().GetUid()
+	}
+	if r, ok := req.(interface{ GetModel() *roleV1.Model }); ok && r.GetModel() != nil {
+		return r.GetModel().GetProvider() + "/" + r.GetModel().GetName()
+	}
+	if r, ok := req.(interface {
+		GetProvider() string
+		GetName() string
+	}); ok && r.GetProvider() != "" {
+		return r.GetProvider() + "/" + r.GetName()
+	}
+	return ""
+}
+
+// auditRequest 把请求序列化为 JSON，去掉密钥和密码等字段
+func auditRequest(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	raw, err := protojson.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return ""
+	}
+	redact(fields)
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func redact(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if isSensitiveField(k) {
+				delete(v, k)
+				continue
+			}
+			redact(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			redact(child)
+		}
+	}
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range sensitiveFields {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/conf"
 	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/service"
 	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/Fl0rencess720/Ayana/pkgs/limiter"
+	"github.com/Fl0rencess720/Ayana/pkgs/metrics"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
@@ -23,6 +26,10 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList["/Ayana.v1.User/Login"] = struct{}{}
 	whiteList["/Ayana.v1.User/Register"] = struct{}{}
 	whiteList["/Ayana.v1.User/RefreshToken"] = struct{}{}
+	whiteList["/Ayana.v1.User/SendResetCode"] = struct{}{}
+	whiteList["/Ayana.v1.User/ResetPassword"] = struct{}{}
+	whiteList["/user/oidc/login"] = struct{}{}
+	whiteList["/user/oidc/callback"] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whiteList[operation]; ok {
 			return false
@@ -31,17 +38,25 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	}
 }
 
-func NewHTTPServer(c *conf.Server, role *service.RoleService, user *service.UserService, seminar *service.SeminarService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, role *service.RoleService, user *service.UserService, seminar *service.SeminarService, rl *limiter.Limiter, sessions jwtc.SessionValidator, accounts jwtc.AccountValidator, pats jwtc.PersonalAccessTokenValidator, workspaces workspace.RoleResolver, logger log.Logger) *http.Server {
+	if err := limiter.SetTrustedProxies(c.RateLimit.GetTrustedProxies()); err != nil {
+		panic(err)
+	}
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			tracing.Server(),
-			selector.Server(jwtc.Auth()).Match(NewWhiteListMatcher()).Build(),
+			metrics.Server(),
+			selector.Server(jwtc.Auth(jwtc.WithSessionValidator(sessions), jwtc.WithAccountValidator(accounts), jwtc.WithPersonalAccessTokens(pats, operationScope))).Match(NewWhiteListMatcher()).Build(),
+			selector.Server(WorkspaceScope(workspaces)).Match(NewWhiteListMatcher()).Build(),
+			selector.Server(AdminOnly(user), AdminAudit(user)).Match(NewAdminMatcher()).Build(),
+			limiter.Server(rl, rateLimitOptions(c.RateLimit)...),
 		),
 		http.Filter(handlers.CORS(
-			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
+			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization", workspaceHeader}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
+			handlers.ExposedHeaders([]string{"Retry-After"}),
 		)),
 	}
 	if c.Http.Network != "" {
@@ -54,7 +69,8 @@ func NewHTTPServer(c *conf.Server, role *service.RoleService, user *service.User
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
-	NoneProtoRoutesRegister(srv)
+	srv.Handle("/metrics", metrics.Handler())
+	NoneProtoRoutesRegister(srv, user)
 
 	roleV1.RegisterRoleManagerHTTPServer(srv, role)
 	userV1.RegisterUserHTTPServer(srv, user)
@@ -63,14 +79,38 @@ func NewHTTPServer(c *conf.Server, role *service.RoleService, user *service.User
 	return srv
 }
 
-func NoneProtoRoutesRegister(srv *http.Server) {
+func rateLimitOptions(c *conf.Server_RateLimit) []limiter.Option {
+	toLimit := func(r *conf.Server_RateLimit_Rule) limiter.Limit {
+		return limiter.Limit{Rate: r.GetRate(), Burst: int(r.GetBurst())}
+	}
+	opts := []limiter.Option{
+		limiter.WithDefault(toLimit(c.GetDefault())),
+		limiter.WithLoginGuard("/Ayana.v1.User/Login", toLimit(c.GetLoginFailure())),
+	}
+	for _, r := range c.GetRoutes() {
+		opts = append(opts, limiter.WithRoute(r.Operation, toLimit(r)))
+	}
+	return opts
+}
+
+func NoneProtoRoutesRegister(srv *http.Server, user *service.UserService) {
 	seminarRoute := srv.Route("/seminar")
 	seminarRouter := seminarRoute.Group("/topic")
 	seminarRouter.GET("starting", service.StartTopic)
 	seminarRouter.GET("resuming", service.ResumeTopic)
 	seminarRouter.GET("streaming", service.GetTopicSream)
+	seminarRoute.Group("/role").POST("previewing", service.PreviewRole)
 
 	documentRoute := srv.Route("/document")
 	documentRoute.POST("upload", service.UploadDocument)
+
+	imageRoute := srv.Route("/image")
+	imageRoute.POST("upload", service.UploadImage)
+	imageRoute.GET("serving", service.ServeImage)
+
+	oidcRoute := srv.Route("/user/oidc")
+	oidcRoute.GET("providers", user.OIDCProviders)
+	oidcRoute.GET("login", user.OIDCLogin)
+	oidcRoute.GET("callback", user.OIDCCallback)
 }
 
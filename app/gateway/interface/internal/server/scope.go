@@ -0,0 +1,50 @@
+package server
+
+import (
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+)
+
+// operationScopes 可以使用个人访问令牌调用的接口及所需的权限范围。
+// 不在表中的接口（账号、会话、令牌、密钥和 MCP 管理等）只能使用登录令牌调用
+var operationScopes = map[string]string{
+	"/Ayana.v1.Seminar/GetTopic":               jwtc.ScopeTopicsRead,
+	"/Ayana.v1.Seminar/GetTopicsMetadata":      jwtc.ScopeTopicsRead,
+	"/Ayana.v1.Seminar/GetTopicRuns":           jwtc.ScopeTopicsRead,
+	"/Ayana.v1.Seminar/EstimateTopicCost":      jwtc.ScopeTopicsRead,
+	"/seminar/topic/streaming":                 jwtc.ScopeTopicsRead,
+	"/Ayana.v1.Seminar/CreateTopic":            jwtc.ScopeTopicsWrite,
+	"/Ayana.v1.Seminar/DeleteTopic":            jwtc.ScopeTopicsWrite,
+	"/Ayana.v1.Seminar/SetTopicTools":          jwtc.ScopeTopicsWrite,
+	"/Ayana.v1.Seminar/SetTopicDocuments":      jwtc.ScopeTopicsWrite,
+	"/seminar/topic/starting":                  jwtc.ScopeTopicsRun,
+	"/seminar/topic/resuming":                  jwtc.ScopeTopicsRun,
+	"/Ayana.v1.Seminar/StopTopic":              jwtc.ScopeTopicsRun,
+	"/Ayana.v1.Seminar/InterjectTopic":         jwtc.ScopeTopicsRun,
+	"/Ayana.v1.RoleManager/GetRoles":           jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/GetRoleVersions":    jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/DiffRoleVersions":   jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/GetSystemRoles":     jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/GetSystemRole":      jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/GetSharedRoles":     jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/GetSharedRole":      jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/GetAvailableModels": jwtc.ScopeRolesRead,
+	"/Ayana.v1.RoleManager/CreateRole":         jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/SetRole":            jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/DeleteRole":         jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/CloneSystemRole":    jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/ImportSharedRole":   jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/PublishRole":        jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/UnpublishRole":      jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/RollbackRole":       jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/GenerateRole":       jwtc.ScopeRolesWrite,
+	"/Ayana.v1.RoleManager/GenerateRolePanel":  jwtc.ScopeRolesWrite,
+	"/seminar/role/previewing":                 jwtc.ScopeRolesWrite,
+	"/Ayana.v1.Seminar/GetDocuments":           jwtc.ScopeDocumentsRead,
+	"/document/upload":                         jwtc.ScopeDocumentsWrite,
+	"/Ayana.v1.Seminar/GetUsage":               jwtc.ScopeUsageRead,
+}
+
+func operationScope(operation string) (string, bool) {
+	scope, ok := operationScopes[operation]
+	return scope, ok
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	workspaceHeader = "X-Ayana-Workspace"
+	// 浏览器的 EventSource 无法设置请求头，SSE 接口通过查询参数指定工作区
+	workspaceQuery = "workspaceUid"
+)
+
+// WorkspaceScope 需要在 jwtc.Auth 之后执行。请求指定了工作区时检查调用者是否为成员，
+// 把工作区和角色写入 ctx，由后端服务根据角色判断具体操作是否允许
+func WorkspaceScope(resolver workspace.RoleResolver) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			uid := requestWorkspace(ctx, req)
+			if uid == "" {
+				return handler(ctx, req)
+			}
+			role, err := resolver.MemberRole(ctx, uid, utils.GetPhoneFromContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			return handler(workspace.NewContext(ctx, workspace.Scope{UID: uid, Role: role}), req)
+		}
+	}
+}
+
+// requestWorkspace 依次从请求头、请求参数和查询参数中取出工作区
+func requestWorkspace(ctx context.Context, req interface{}) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	if uid := tr.RequestHeader().Get(workspaceHeader); uid != "" {
+		return uid
+	}
+	if r, ok := req.(interface{ GetWorkspaceUid() string }); ok && r.GetWorkspaceUid() != "" {
+		return r.GetWorkspaceUid()
+	}
+	if ht, ok := tr.(http.Transporter); ok {
+		return ht.Request().URL.Query().Get(workspaceQuery)
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// UploadImage 上传头像或标题图片，表单字段 kind 为 avatar 或 title
+// 返回的 ref 用于写入角色、个人资料和讨论的图片字段
+func UploadImage(ctx http.Context) error {
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		file, handler, err := ctx.Request().FormFile("file")
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		return biz.UploadImage(c, ctx.Request().FormValue("kind"), file, handler)
+	})
+	reply, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(200, reply)
+}
+
+// ServeImage 提供本地存储的图片，凭签名访问，不需要登录
+func ServeImage(ctx http.Context) error {
+	query := ctx.Request().URL.Query()
+	file, contentType, err := biz.OpenImage(ctx, query.Get("key"), query.Get("expires"), query.Get("signature"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := ctx.Response()
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	_, err = io.Copy(w, file)
+	return err
+}
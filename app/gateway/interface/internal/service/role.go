@@ -54,3 +54,195 @@ func (s *RoleService) SetRole(ctx context.Context, req *v1.SetRoleRequest) (*v1.
 	}
 	return reply, nil
 }
+
+func (s *RoleService) CreateCredential(ctx context.Context, req *v1.CreateCredentialRequest) (*v1.CreateCredentialReply, error) {
+	reply, err := s.uc.CreateCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetCredentials(ctx context.Context, req *v1.GetCredentialsRequest) (*v1.GetCredentialsReply, error) {
+	reply, err := s.uc.GetCredentials(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) SetCredential(ctx context.Context, req *v1.SetCredentialRequest) (*v1.SetCredentialReply, error) {
+	reply, err := s.uc.SetCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) DeleteCredential(ctx context.Context, req *v1.DeleteCredentialRequest) (*v1.DeleteCredentialReply, error) {
+	reply, err := s.uc.DeleteCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetSystemRoles(ctx context.Context, req *v1.GetSystemRolesRequest) (*v1.GetSystemRolesReply, error) {
+	reply, err := s.uc.GetSystemRoles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetSystemRole(ctx context.Context, req *v1.GetSystemRoleRequest) (*v1.GetSystemRoleReply, error) {
+	reply, err := s.uc.GetSystemRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) CloneSystemRole(ctx context.Context, req *v1.CloneSystemRoleRequest) (*v1.CloneSystemRoleReply, error) {
+	reply, err := s.uc.CloneSystemRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) PublishRole(ctx context.Context, req *v1.PublishRoleRequest) (*v1.PublishRoleReply, error) {
+	reply, err := s.uc.PublishRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) UnpublishRole(ctx context.Context, req *v1.UnpublishRoleRequest) (*v1.UnpublishRoleReply, error) {
+	reply, err := s.uc.UnpublishRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetSharedRoles(ctx context.Context, req *v1.GetSharedRolesRequest) (*v1.GetSharedRolesReply, error) {
+	reply, err := s.uc.GetSharedRoles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetSharedRole(ctx context.Context, req *v1.GetSharedRoleRequest) (*v1.GetSharedRoleReply, error) {
+	reply, err := s.uc.GetSharedRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) ImportSharedRole(ctx context.Context, req *v1.ImportSharedRoleRequest) (*v1.ImportSharedRoleReply, error) {
+	reply, err := s.uc.ImportSharedRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetRoleVersions(ctx context.Context, req *v1.GetRoleVersionsRequest) (*v1.GetRoleVersionsReply, error) {
+	reply, err := s.uc.GetRoleVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) DiffRoleVersions(ctx context.Context, req *v1.DiffRoleVersionsRequest) (*v1.DiffRoleVersionsReply, error) {
+	reply, err := s.uc.DiffRoleVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) RollbackRole(ctx context.Context, req *v1.RollbackRoleRequest) (*v1.RollbackRoleReply, error) {
+	reply, err := s.uc.RollbackRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetModels(ctx context.Context, req *v1.GetModelsRequest) (*v1.GetModelsReply, error) {
+	reply, err := s.uc.GetModels(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) CreateModel(ctx context.Context, req *v1.CreateModelRequest) (*v1.CreateModelReply, error) {
+	reply, err := s.uc.CreateModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) SetModel(ctx context.Context, req *v1.SetModelRequest) (*v1.SetModelReply, error) {
+	reply, err := s.uc.SetModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) DeleteModel(ctx context.Context, req *v1.DeleteModelRequest) (*v1.DeleteModelReply, error) {
+	reply, err := s.uc.DeleteModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GenerateRole(ctx context.Context, req *v1.GenerateRoleRequest) (*v1.GenerateRoleReply, error) {
+	reply, err := s.uc.GenerateRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GenerateRolePanel(ctx context.Context, req *v1.GenerateRolePanelRequest) (*v1.GenerateRolePanelReply, error) {
+	reply, err := s.uc.GenerateRolePanel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) AdminCreateSystemRole(ctx context.Context, req *v1.AdminCreateSystemRoleRequest) (*v1.AdminCreateSystemRoleReply, error) {
+	reply, err := s.uc.AdminCreateSystemRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) AdminSetSystemRole(ctx context.Context, req *v1.AdminSetSystemRoleRequest) (*v1.AdminSetSystemRoleReply, error) {
+	reply, err := s.uc.AdminSetSystemRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *RoleService) AdminDeleteSystemRole(ctx context.Context, req *v1.AdminDeleteSystemRoleRequest) (*v1.AdminDeleteSystemRoleReply, error) {
+	reply, err := s.uc.AdminDeleteSystemRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
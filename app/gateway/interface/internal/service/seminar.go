@@ -63,6 +63,22 @@ func StartTopic(ctx http.Context) error {
 	return nil
 }
 
+func PreviewRole(ctx http.Context) error {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("panic: %v", r)
+		}
+	}()
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		return biz.PreviewRole(ctx, c)
+	})
+	_, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *SeminarService) StopTopic(ctx context.Context, req *v1.StopTopicRequest) (*v1.StopTopicReply, error) {
 	reply, err := s.uc.StopTopic(ctx, req)
 	if err != nil {
@@ -71,6 +87,14 @@ func (s *SeminarService) StopTopic(ctx context.Context, req *v1.StopTopicRequest
 	return reply, nil
 }
 
+func (s *SeminarService) InterjectTopic(ctx context.Context, req *v1.InterjectTopicRequest) (*v1.InterjectTopicReply, error) {
+	reply, err := s.uc.InterjectTopic(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
 func ResumeTopic(ctx http.Context) error {
 	defer func() {
 		if r := recover(); r != nil {
@@ -94,7 +118,7 @@ func GetTopicSream(ctx http.Context) error {
 		}
 	}()
 	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
-		return biz.GetTopicStream(ctx)
+		return biz.GetTopicStream(ctx, c)
 	})
 	_, err := h(ctx, nil)
 	if err != nil {
@@ -177,3 +201,83 @@ func (s *SeminarService) DisableMCPServer(ctx context.Context, req *v1.DisableMC
 	}
 	return reply, nil
 }
+
+func (s *SeminarService) GetUsage(ctx context.Context, req *v1.GetUsageRequest) (*v1.GetUsageReply, error) {
+	reply, err := s.uc.GetUsage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) EstimateTopicCost(ctx context.Context, req *v1.EstimateTopicCostRequest) (*v1.EstimateTopicCostReply, error) {
+	reply, err := s.uc.EstimateTopicCost(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) TestCredential(ctx context.Context, req *v1.TestCredentialRequest) (*v1.TestCredentialReply, error) {
+	reply, err := s.uc.TestCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) GetTopicRuns(ctx context.Context, req *v1.GetTopicRunsRequest) (*v1.GetTopicRunsReply, error) {
+	reply, err := s.uc.GetTopicRuns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) GetMCPServerTools(ctx context.Context, req *v1.GetMCPServerToolsRequest) (*v1.GetMCPServerToolsReply, error) {
+	reply, err := s.uc.GetMCPServerTools(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) SetTopicTools(ctx context.Context, req *v1.SetTopicToolsRequest) (*v1.SetTopicToolsReply, error) {
+	reply, err := s.uc.SetTopicTools(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) SetTopicDocuments(ctx context.Context, req *v1.SetTopicDocumentsRequest) (*v1.SetTopicDocumentsReply, error) {
+	reply, err := s.uc.SetTopicDocuments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) AdminGetUsage(ctx context.Context, req *v1.AdminGetUsageRequest) (*v1.GetUsageReply, error) {
+	reply, err := s.uc.AdminGetUsage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) AdminGetRunningTopics(ctx context.Context, req *v1.AdminGetRunningTopicsRequest) (*v1.AdminGetRunningTopicsReply, error) {
+	reply, err := s.uc.AdminGetRunningTopics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) AdminStopTopic(ctx context.Context, req *v1.AdminStopTopicRequest) (*v1.AdminStopTopicReply, error) {
+	reply, err := s.uc.AdminStopTopic(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
@@ -2,19 +2,23 @@ package service
 
 import (
 	"context"
+	nethttp "net/http"
 
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
 	"github.com/Fl0rencess720/Ayana/app/gateway/interface/internal/biz"
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 type UserService struct {
 	v1.UnimplementedUserServer
 
-	uc *biz.UserUsecase
+	uc   *biz.UserUsecase
+	oidc *biz.OIDCUsecase
+	wuc  *biz.WorkspaceUsecase
 }
 
-func NewUserService(uc *biz.UserUsecase) *UserService {
-	return &UserService{uc: uc}
+func NewUserService(uc *biz.UserUsecase, oidc *biz.OIDCUsecase, wuc *biz.WorkspaceUsecase) *UserService {
+	return &UserService{uc: uc, oidc: oidc, wuc: wuc}
 }
 
 func (s *UserService) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginReply, error) {
@@ -51,9 +55,185 @@ func (s *UserService) GetProfile(ctx context.Context, req *v1.GetProfileRequest)
 }
 
 func (s *UserService) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
-	accessToken, err := s.uc.RefreshToken(ctx, req.RefreshToken)
+	reply, err := s.uc.RefreshToken(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.RefreshTokenReply{AccessToken: "Bearer " + accessToken}, nil
+	reply.AccessToken = "Bearer " + reply.AccessToken
+	reply.RefreshToken = "Bearer " + reply.RefreshToken
+	return reply, nil
+}
+
+func (s *UserService) ChangePassword(ctx context.Context, req *v1.ChangePasswordRequest) (*v1.ChangePasswordReply, error) {
+	reply, err := s.uc.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) SendResetCode(ctx context.Context, req *v1.SendResetCodeRequest) (*v1.SendResetCodeReply, error) {
+	reply, err := s.uc.SendResetCode(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest) (*v1.ResetPasswordReply, error) {
+	reply, err := s.uc.ResetPassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutReply, error) {
+	reply, err := s.uc.Logout(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) LogoutAllDevices(ctx context.Context, req *v1.LogoutAllDevicesRequest) (*v1.LogoutAllDevicesReply, error) {
+	reply, err := s.uc.LogoutAllDevices(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) ListSessions(ctx context.Context, req *v1.ListSessionsRequest) (*v1.ListSessionsReply, error) {
+	reply, err := s.uc.ListSessions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) CreatePersonalAccessToken(ctx context.Context, req *v1.CreatePersonalAccessTokenRequest) (*v1.CreatePersonalAccessTokenReply, error) {
+	reply, err := s.uc.CreatePersonalAccessToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) ListPersonalAccessTokens(ctx context.Context, req *v1.ListPersonalAccessTokensRequest) (*v1.ListPersonalAccessTokensReply, error) {
+	reply, err := s.uc.ListPersonalAccessTokens(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) RevokePersonalAccessToken(ctx context.Context, req *v1.RevokePersonalAccessTokenRequest) (*v1.RevokePersonalAccessTokenReply, error) {
+	reply, err := s.uc.RevokePersonalAccessToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// OIDCProviders 返回可用的第三方登录方式
+func (s *UserService) OIDCProviders(ctx http.Context) error {
+	return ctx.JSON(200, map[string][]string{"providers": s.oidc.Providers()})
+}
+
+// oidcStateCookie 发起登录的浏览器保存的 state，回调时与查询参数比对
+const oidcStateCookie = "oidc_state"
+
+// OIDCLogin 跳转到身份提供方的授权页面，查询参数 provider 为配置中的名称
+func (s *UserService) OIDCLogin(ctx http.Context) error {
+	var state string
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		authURL, st, err := s.oidc.AuthURL(c, ctx.Request().URL.Query().Get("provider"))
+		state = st
+		return authURL, err
+	})
+	authURL, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	setOIDCStateCookie(ctx, state, int(biz.OIDCStateTTL.Seconds()))
+	nethttp.Redirect(ctx.Response(), ctx.Request(), authURL.(string), nethttp.StatusFound)
+	return nil
+}
+
+// setOIDCStateCookie 身份提供方回调是跨站的顶层跳转，SameSite=Lax 时浏览器仍会带上 cookie
+func setOIDCStateCookie(ctx http.Context, state string, maxAge int) {
+	r := ctx.Request()
+	nethttp.SetCookie(ctx.Response(), &nethttp.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     "/user/oidc",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: nethttp.SameSiteLaxMode,
+	})
+}
+
+// OIDCCallback 身份提供方回调，登录成功后跳转到前端或直接返回令牌
+func (s *UserService) OIDCCallback(ctx http.Context) error {
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		query := ctx.Request().URL.Query()
+		if query.Get("error") != "" {
+			return nil, biz.ErrOIDCLoginFailed
+		}
+		var boundState string
+		if cookie, err := ctx.Request().Cookie(oidcStateCookie); err == nil {
+			boundState = cookie.Value
+		}
+		return s.oidc.Callback(c, query.Get("state"), boundState, query.Get("code"))
+	})
+	// state 只能使用一次，无论成功与否都清除
+	setOIDCStateCookie(ctx, "", -1)
+	resp, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reply := resp.(*v1.OIDCLoginReply)
+	reply.AccessToken = "Bearer " + reply.AccessToken
+	reply.RefreshToken = "Bearer " + reply.RefreshToken
+	if target, ok := s.oidc.SuccessRedirect(reply); ok {
+		nethttp.Redirect(ctx.Response(), ctx.Request(), target, nethttp.StatusFound)
+		return nil
+	}
+	return ctx.Result(200, reply)
+}
+
+func (s *UserService) AdminGetUsers(ctx context.Context, req *v1.AdminGetUsersRequest) (*v1.AdminGetUsersReply, error) {
+	reply, err := s.uc.AdminGetUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) AdminSetUserStatus(ctx context.Context, req *v1.AdminSetUserStatusRequest) (*v1.AdminSetUserStatusReply, error) {
+	reply, err := s.uc.AdminSetUserStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) AdminGetAuditLogs(ctx context.Context, req *v1.AdminGetAuditLogsRequest) (*v1.AdminGetAuditLogsReply, error) {
+	reply, err := s.uc.AdminGetAuditLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// CheckAdmin 供网关的管理员校验中间件使用
+func (s *UserService) CheckAdmin(ctx context.Context, phone string) (bool, error) {
+	return s.uc.CheckAdmin(ctx, phone)
+}
+
+// RecordAudit 供网关的审计中间件使用
+func (s *UserService) RecordAudit(ctx context.Context, entry *v1.AuditLog) error {
+	return s.uc.RecordAuditLog(ctx, entry)
 }
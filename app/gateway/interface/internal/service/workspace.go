@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+)
+
+func (s *UserService) CreateWorkspace(ctx context.Context, req *v1.CreateWorkspaceRequest) (*v1.CreateWorkspaceReply, error) {
+	reply, err := s.wuc.CreateWorkspace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetWorkspaces(ctx context.Context, req *v1.GetWorkspacesRequest) (*v1.GetWorkspacesReply, error) {
+	reply, err := s.wuc.GetWorkspaces(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetWorkspaceMembers(ctx context.Context, req *v1.GetWorkspaceMembersRequest) (*v1.GetWorkspaceMembersReply, error) {
+	reply, err := s.wuc.GetWorkspaceMembers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) SetWorkspaceMember(ctx context.Context, req *v1.SetWorkspaceMemberRequest) (*v1.SetWorkspaceMemberReply, error) {
+	reply, err := s.wuc.SetWorkspaceMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) RemoveWorkspaceMember(ctx context.Context, req *v1.RemoveWorkspaceMemberRequest) (*v1.RemoveWorkspaceMemberReply, error) {
+	reply, err := s.wuc.RemoveWorkspaceMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) CreateWorkspaceInvite(ctx context.Context, req *v1.CreateWorkspaceInviteRequest) (*v1.CreateWorkspaceInviteReply, error) {
+	reply, err := s.wuc.CreateWorkspaceInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetWorkspaceInvites(ctx context.Context, req *v1.GetWorkspaceInvitesRequest) (*v1.GetWorkspaceInvitesReply, error) {
+	reply, err := s.wuc.GetWorkspaceInvites(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) RevokeWorkspaceInvite(ctx context.Context, req *v1.RevokeWorkspaceInviteRequest) (*v1.RevokeWorkspaceInviteReply, error) {
+	reply, err := s.wuc.RevokeWorkspaceInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *UserService) AcceptWorkspaceInvite(ctx context.Context, req *v1.AcceptWorkspaceInviteRequest) (*v1.AcceptWorkspaceInviteReply, error) {
+	reply, err := s.wuc.AcceptWorkspaceInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
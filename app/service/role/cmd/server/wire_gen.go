@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confService *conf.Service, confData *conf.Data, secret *conf.Secret, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewMysql(confData)
 	client := data.NewRedis(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
@@ -31,12 +31,32 @@ func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Regist
 		return nil, nil, err
 	}
 	roleRepo := data.NewRoleRepo(dataData, logger)
-	roleUsecase := biz.NewRoleUsecase(roleRepo, logger)
-	roleService := service.NewRoleService(roleUsecase)
+	credentialRepo := data.NewCredentialRepo(dataData, logger)
+	modelRepo := data.NewModelRepo(dataData, logger)
+	systemRoleCatalog, cleanup2, err := data.NewSystemRoleCatalog(dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	discovery := server.NewDiscovery(registry)
+	seminarClient := data.NewSeminarServiceClient(confService, discovery)
+	usageRepo := data.NewUsageRepo(seminarClient, logger)
+	keyring, err := data.NewKeyring(secret)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	roleUsecase := biz.NewRoleUsecase(roleRepo, credentialRepo, modelRepo, systemRoleCatalog, usageRepo, keyring, logger)
+	credentialUsecase := biz.NewCredentialUsecase(credentialRepo, keyring, logger)
+	modelUsecase := biz.NewModelUsecase(modelRepo, logger)
+	roleService := service.NewRoleService(roleUsecase, credentialUsecase, modelUsecase)
 	grpcServer := server.NewGRPCServer(confServer, roleService, logger)
+	httpServer := server.NewHTTPServer(confServer, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	app := newApp(logger, grpcServer, httpServer, registrar)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SystemRolePrefix 系统角色 UID 的前缀，用于和用户角色的雪花 ID 区分
+const SystemRolePrefix = "system-"
+
+const (
+	SystemRoleKindModerator = "moderator"
+	SystemRoleKindExpert    = "expert"
+)
+
+// SystemRoleCatalog 系统内置角色目录，List 只返回在用的角色，Get 也能取到已下线的角色
+type SystemRoleCatalog interface {
+	List() []SystemRole
+	Get(uid string) (SystemRole, bool)
+	Create(ctx context.Context, role SystemRole) error
+	Update(ctx context.Context, role SystemRole) error
+	// Delete 下线角色，已经引用它的讨论不受影响
+	Delete(ctx context.Context, uid string) error
+}
+
+// SystemRole 系统内置角色，不携带密钥，讨论时使用平台密钥
+type SystemRole struct {
+	Uid         string   `yaml:"uid"`
+	Kind        string   `yaml:"kind"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Avatar      string   `yaml:"avatar"`
+	Provider    string   `yaml:"provider"`
+	ModelName   string   `yaml:"model"`
+	Tags        []string `yaml:"tags"`
+	Retired     bool     `yaml:"-"`
+}
+
+func (s SystemRole) Role() Role {
+	return Role{
+		Uid:         s.Uid,
+		RoleName:    s.Name,
+		Description: s.Description,
+		Avatar:      s.Avatar,
+		ModelName:   s.ModelName,
+		Provider:    s.Provider,
+	}
+}
+
+func IsSystemRoleUID(uid string) bool {
+	return strings.HasPrefix(uid, SystemRolePrefix)
+}
+
+var (
+	ErrSystemRoleNotFound = status.Error(codes.NotFound, "系统角色不存在")
+	ErrSystemRoleExists   = status.Error(codes.AlreadyExists, "系统角色已存在")
+	ErrSystemRoleInvalid  = status.Error(codes.InvalidArgument, "系统角色的名称、类型或模型无效")
+	ErrSystemRoleUID      = status.Error(codes.InvalidArgument, "系统角色 UID 必须以 "+SystemRolePrefix+" 开头")
+)
+
+// GetSystemRoles kind 为空时返回全部系统角色
+func (uc *RoleUsecase) GetSystemRoles(ctx context.Context, kind string) []SystemRole {
+	roles := []SystemRole{}
+	for _, role := range uc.catalog.List() {
+		if kind == "" || role.Kind == kind {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func (uc *RoleUsecase) GetSystemRole(ctx context.Context, uid string) (SystemRole, error) {
+	role, ok := uc.catalog.Get(uid)
+	if !ok || role.Retired {
+		return SystemRole{}, ErrSystemRoleNotFound
+	}
+	return role, nil
+}
+
+func (uc *RoleUsecase) CreateSystemRole(ctx context.Context, role SystemRole) (string, error) {
+	if err := uc.validateSystemRole(ctx, &role); err != nil {
+		return "", err
+	}
+	if err := uc.catalog.Create(ctx, role); err != nil {
+		return "", err
+	}
+	return role.Uid, nil
+}
+
+func (uc *RoleUsecase) SetSystemRole(ctx context.Context, role SystemRole) error {
+	if err := uc.validateSystemRole(ctx, &role); err != nil {
+		return err
+	}
+	return uc.catalog.Update(ctx, role)
+}
+
+func (uc *RoleUsecase) DeleteSystemRole(ctx context.Context, uid string) error {
+	return uc.catalog.Delete(ctx, uid)
+}
+
+// validateSystemRole 系统角色使用平台密钥，模型必须在注册表中
+func (uc *RoleUsecase) validateSystemRole(ctx context.Context, role *SystemRole) error {
+	role.Uid, role.Name = strings.TrimSpace(role.Uid), strings.TrimSpace(role.Name)
+	if !IsSystemRoleUID(role.Uid) || role.Uid == SystemRolePrefix {
+		return ErrSystemRoleUID
+	}
+	if role.Name == "" || role.Provider == "" || role.ModelName == "" {
+		return ErrSystemRoleInvalid
+	}
+	if role.Kind != SystemRoleKindModerator && role.Kind != SystemRoleKindExpert {
+		return ErrSystemRoleInvalid
+	}
+	_, err := uc.mrepo.GetModel(ctx, role.Provider, role.ModelName)
+	return err
+}
+
+// CloneSystemRole 把系统角色复制到用户自己的角色库，使用用户自己的凭证或密钥
+func (uc *RoleUsecase) CloneSystemRole(ctx context.Context, phone, uid, name, credentialUID, apiKey string) (string, error) {
+	systemRole, err := uc.GetSystemRole(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	role := systemRole.Role()
+	role.Uid = ""
+	if name != "" {
+		role.RoleName = name
+	}
+	role.CredentialUid = credentialUID
+	role.ApiKey = apiKey
+	return uc.CreateRole(ctx, phone, role)
+}
+
+// splitSystemRoles 把系统角色 UID 从列表中分离出来，系统角色直接从目录中取出
+func (uc *RoleUsecase) splitSystemRoles(uids []string) ([]string, map[string]Role, error) {
+	userUIDs := []string{}
+	systemRoles := map[string]Role{}
+	for _, uid := range uids {
+		if !IsSystemRoleUID(uid) {
+			userUIDs = append(userUIDs, uid)
+			continue
+		}
+		role, ok := uc.catalog.Get(uid)
+		if !ok {
+			return nil, nil, ErrRoleNotFound
+		}
+		systemRoles[uid] = role.Role()
+	}
+	return userUIDs, systemRoles, nil
+}
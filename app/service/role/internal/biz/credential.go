@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCredentialNotFound = status.Error(codes.NotFound, "凭证不存在")
+	ErrCredentialInUse    = status.Error(codes.FailedPrecondition, "凭证正在被角色使用")
+)
+
+type CredentialRepo interface {
+	CreateCredential(ctx context.Context, credential *Credential) error
+	GetCredentials(ctx context.Context, phone string) ([]Credential, error)
+	GetCredential(ctx context.Context, phone, uid string) (Credential, error)
+	GetCredentialsByUIDs(ctx context.Context, phone string, uids []string) ([]Credential, error)
+	SetCredential(ctx context.Context, phone, uid string, credential Credential) error
+	DeleteCredential(ctx context.Context, phone, uid string) error
+	CountRolesByCredential(ctx context.Context, phone, uid string) (int64, error)
+	RecordCredentialUsage(ctx context.Context, uid, errMsg string, at time.Time) error
+	GetCredentialsNeedingRotation(ctx context.Context, keyring *secret.Keyring) ([]Credential, error)
+	UpdateCredentialSecrets(ctx context.Context, id uint, apiKey string) error
+}
+
+// Credential 用户保存的模型服务凭证，角色通过 CredentialUid 引用，修改密钥时无需逐个修改角色。
+// 讨论使用的 deepseek 客户端无法附带自定义请求头，所以凭证只保存地址和密钥
+type Credential struct {
+	gorm.Model
+	Phone       string `gorm:"type:varchar(50);index"`
+	Uid         string `gorm:"type:varchar(50);uniqueIndex"`
+	Name        string `gorm:"type:varchar(50)"`
+	Provider    string `gorm:"type:varchar(50)"`
+	BaseURL     string `gorm:"type:varchar(200)"`
+	ApiKey      string `gorm:"type:text"`
+	ApiKeyMask  string `gorm:"type:varchar(50)"`
+	LastUsedAt  *time.Time
+	LastError   string `gorm:"type:text"`
+	LastErrorAt *time.Time
+}
+
+type CredentialUsecase struct {
+	repo    CredentialRepo
+	keyring *secret.Keyring
+	log     *log.Helper
+}
+
+func NewCredentialUsecase(repo CredentialRepo, keyring *secret.Keyring, logger log.Logger) *CredentialUsecase {
+	uc := &CredentialUsecase{repo: repo, keyring: keyring, log: log.NewHelper(logger)}
+	go func() {
+		if err := uc.RotateSecrets(context.Background()); err != nil {
+			uc.log.Errorf("rotate credential secrets failed: %v", err)
+		}
+	}()
+	return uc
+}
+
+// seal 加密 API Key，ApiKey 为空时不修改密钥
+func (uc *CredentialUsecase) seal(credential *Credential) error {
+	if credential.ApiKey == "" {
+		return nil
+	}
+	credential.ApiKeyMask = secret.Mask(credential.ApiKey)
+	encrypted, err := uc.keyring.Encrypt(credential.ApiKey)
+	if err != nil {
+		return err
+	}
+	credential.ApiKey = encrypted
+	return nil
+}
+
+func (uc *CredentialUsecase) CreateCredential(ctx context.Context, phone string, credential Credential) (string, error) {
+	if credential.Name == "" || credential.BaseURL == "" || credential.ApiKey == "" {
+		return "", status.Error(codes.InvalidArgument, "凭证名称、地址和密钥不能为空")
+	}
+	if err := uc.seal(&credential); err != nil {
+		return "", err
+	}
+	credential.Phone = phone
+	if err := uc.repo.CreateCredential(ctx, &credential); err != nil {
+		return "", err
+	}
+	return credential.Uid, nil
+}
+
+func (uc *CredentialUsecase) GetCredentials(ctx context.Context, phone string) ([]Credential, error) {
+	return uc.repo.GetCredentials(ctx, phone)
+}
+
+func (uc *CredentialUsecase) GetCredential(ctx context.Context, phone, uid string) (Credential, error) {
+	if phone == "" {
+		return Credential{}, ErrCredentialNotFound
+	}
+	return uc.repo.GetCredential(ctx, phone, uid)
+}
+
+func (uc *CredentialUsecase) SetCredential(ctx context.Context, phone, uid string, credential Credential) error {
+	// 前端回传的掩码表示不修改密钥
+	if strings.Contains(credential.ApiKey, "****") {
+		credential.ApiKey = ""
+	}
+	if err := uc.seal(&credential); err != nil {
+		return err
+	}
+	return uc.repo.SetCredential(ctx, phone, uid, credential)
+}
+
+func (uc *CredentialUsecase) DeleteCredential(ctx context.Context, phone, uid string) error {
+	count, err := uc.repo.CountRolesByCredential(ctx, phone, uid)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCredentialInUse
+	}
+	return uc.repo.DeleteCredential(ctx, phone, uid)
+}
+
+// ReportUsage 记录凭证最近一次被使用的时间，errMsg 不为空时同时记录错误
+func (uc *CredentialUsecase) ReportUsage(ctx context.Context, uid, errMsg string) error {
+	return uc.repo.RecordCredentialUsage(ctx, uid, errMsg, time.Now())
+}
+
+// RotateSecrets 把明文或旧主密钥包装的凭证密钥重新包装到当前主密钥下
+func (uc *CredentialUsecase) RotateSecrets(ctx context.Context) error {
+	credentials, err := uc.repo.GetCredentialsNeedingRotation(ctx, uc.keyring)
+	if err != nil {
+		return err
+	}
+	for _, credential := range credentials {
+		apiKey, err := uc.keyring.Rotate(credential.ApiKey)
+		if err != nil {
+			uc.log.Errorf("rotate api key of credential %s failed: %v", credential.Uid, err)
+			continue
+		}
+		if err := uc.repo.UpdateCredentialSecrets(ctx, credential.ID, apiKey); err != nil {
+			uc.log.Errorf("update credential %s failed: %v", credential.Uid, err)
+		}
+	}
+	return nil
+}
+
+// NeedsRotation 判断凭证的密钥是否为明文或由旧主密钥包装
+func (c Credential) NeedsRotation(keyring *secret.Keyring) bool {
+	return keyring.NeedsRotation(c.ApiKey)
+}
@@ -0,0 +1,164 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/eino-ext/components/model/deepseek"
+	"github.com/cloudwego/eino/schema"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// generatorModelName 生成人设使用平台密钥和固定的模型
+const generatorModelName = "deepseek-chat"
+
+const (
+	defaultPanelSize = 4
+	maxPanelSize     = 8
+	maxBriefLength   = 500
+)
+
+var (
+	ErrBriefInvalid     = status.Error(codes.InvalidArgument, "角色简介不能为空，且不能超过 500 字")
+	ErrGenerationFailed = status.Error(codes.Unavailable, "生成角色失败，请稍后重试")
+)
+
+// GeneratedRole 生成的角色草稿，可以直接用于 CreateRole
+type GeneratedRole struct {
+	Role
+	SpeakingStyle string
+	// Stance 生成讨论阵容时该角色在主题上的立场
+	Stance string
+}
+
+// generatedPersona 模型返回的 JSON 结构
+type generatedPersona struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	SpeakingStyle  string `json:"speaking_style"`
+	Stance         string `json:"stance"`
+	NeedsTools     bool   `json:"needs_tools"`
+	NeedsReasoning bool   `json:"needs_reasoning"`
+}
+
+const personaSchema = `{"name": "", "description": "", "speaking_style": "", "stance": "", "needs_tools": false, "needs_reasoning": false}
+其中 name 为角色名，不超过 10 个字；description 为详细的人物特质，包括身份背景、专业领域、价值观和思考方式，200 字左右；speaking_style 为发言风格，50 字以内；stance 为在主题上的立场，没有主题时留空；needs_tools 表示是否需要联网搜索等工具；needs_reasoning 表示是否需要深度推理。`
+
+// GenerateRole 把一句话简介扩写成完整的人设，用量计入 phone 的配额
+func (uc *RoleUsecase) GenerateRole(ctx context.Context, phone, brief string) (GeneratedRole, error) {
+	brief = strings.TrimSpace(brief)
+	if brief == "" || len([]rune(brief)) > maxBriefLength {
+		return GeneratedRole{}, ErrBriefInvalid
+	}
+	prompt := fmt.Sprintf("请根据下面的简介为多角色研讨会设计一个参与者角色：\n%s\n\n只输出一个 JSON 对象，格式如下：\n%s", brief, personaSchema)
+	var persona generatedPersona
+	if err := uc.generate(ctx, phone, prompt, &persona); err != nil {
+		return GeneratedRole{}, err
+	}
+	return uc.toGeneratedRole(ctx, persona), nil
+}
+
+// GenerateRolePanel 为主题生成一组立场互补的角色
+func (uc *RoleUsecase) GenerateRolePanel(ctx context.Context, phone, topic string, count int) ([]GeneratedRole, error) {
+	topic = strings.TrimSpace(topic)
+	if topic == "" || len([]rune(topic)) > maxBriefLength {
+		return nil, ErrBriefInvalid
+	}
+	if count <= 0 {
+		count = defaultPanelSize
+	}
+	if count > maxPanelSize {
+		count = maxPanelSize
+	}
+	prompt := fmt.Sprintf("研讨会的主题是：%s\n\n请为这个主题设计 %d 位参与者，立场要均衡，既有支持也有反对和中立，专业背景互不相同，避免重名。只输出一个 JSON 数组，每个元素的格式如下：\n%s", topic, count, personaSchema)
+	var personas []generatedPersona
+	if err := uc.generate(ctx, phone, prompt, &personas); err != nil {
+		return nil, err
+	}
+	roles := make([]GeneratedRole, 0, len(personas))
+	for _, persona := range personas {
+		if persona.Name == "" || persona.Description == "" {
+			continue
+		}
+		roles = append(roles, uc.toGeneratedRole(ctx, persona))
+	}
+	if len(roles) == 0 {
+		return nil, ErrGenerationFailed
+	}
+	return roles, nil
+}
+
+func (uc *RoleUsecase) generate(ctx context.Context, phone, prompt string, v any) error {
+	if err := uc.urepo.CheckQuota(ctx, phone); err != nil {
+		return err
+	}
+	cm, err := deepseek.NewChatModel(ctx, &deepseek.ChatModelConfig{
+		APIKey: viper.GetString("DEEPSEEK_API_KEY"),
+		Model:  generatorModelName,
+	})
+	if err != nil {
+		return err
+	}
+	input := []*schema.Message{
+		schema.SystemMessage("你是一名擅长设计辩论和研讨会角色的编剧，角色要鲜明、具体、可信。"),
+		schema.UserMessage(prompt),
+	}
+	output, err := cm.Generate(ctx, input)
+	if err != nil {
+		uc.log.Errorf("generate persona failed: %v", err)
+		return ErrGenerationFailed
+	}
+	// 输出无法解析时模型也已经计费，先记录用量；客户端断开后仍然要写入
+	if err := uc.urepo.RecordUsage(context.WithoutCancel(ctx), generationUsage(phone, input, output)); err != nil {
+		uc.log.Errorf("record generation usage failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(extractJSON(output.Content)), v); err != nil {
+		uc.log.Errorf("parse generated persona failed: %v, output: %s", err, output.Content)
+		return ErrGenerationFailed
+	}
+	return nil
+}
+
+// toGeneratedRole 把发言风格写进描述，保存后讨论中也能体现
+func (uc *RoleUsecase) toGeneratedRole(ctx context.Context, persona generatedPersona) GeneratedRole {
+	role := Role{RoleName: persona.Name, Description: persona.Description}
+	if persona.SpeakingStyle != "" {
+		role.Description += "\n发言风格：" + persona.SpeakingStyle
+	}
+	if model, ok := uc.recommendModel(ctx, persona.NeedsTools, persona.NeedsReasoning); ok {
+		role.Provider, role.ModelName = model.Provider, model.ModelName
+	}
+	return GeneratedRole{Role: role, SpeakingStyle: persona.SpeakingStyle, Stance: persona.Stance}
+}
+
+// recommendModel 从注册表中挑选满足能力要求的模型，都不满足时退回第一个可用模型
+func (uc *RoleUsecase) recommendModel(ctx context.Context, needsTools, needsReasoning bool) (AIModel, bool) {
+	models, err := uc.mrepo.GetModels(ctx, true)
+	if err != nil {
+		uc.log.Error(err)
+		return AIModel{}, false
+	}
+	if len(models) == 0 {
+		return AIModel{}, false
+	}
+	for _, m := range models {
+		if (!needsTools || m.SupportsTools) && (!needsReasoning || m.SupportsReasoningStream) {
+			return m, true
+		}
+	}
+	return models[0], true
+}
+
+// extractJSON 去掉模型输出中 JSON 之外的内容，如代码块标记
+func extractJSON(s string) string {
+	start := strings.IndexAny(s, "{[")
+	end := strings.LastIndexAny(s, "}]")
+	if start < 0 || end < start {
+		return s
+	}
+	return s[start : end+1]
+}
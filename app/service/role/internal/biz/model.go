@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrModelNotFound = status.Error(codes.NotFound, "模型不存在")
+	ErrModelExists   = status.Error(codes.AlreadyExists, "模型已存在")
+	ErrModelInvalid  = status.Error(codes.InvalidArgument, "模型的提供商和名称不能为空")
+)
+
+type ModelRepo interface {
+	CreateModel(ctx context.Context, model *AIModel) error
+	GetModels(ctx context.Context, enabledOnly bool) ([]AIModel, error)
+	GetModel(ctx context.Context, provider, name string) (AIModel, error)
+	SetModel(ctx context.Context, provider, name string, model AIModel) error
+	DeleteModel(ctx context.Context, provider, name string) error
+	CountModels(ctx context.Context) (int64, error)
+	ModelsToRedis(ctx context.Context, models []AIModel) error
+}
+
+// AIModel 模型注册表中的一项，记录模型的能力和上下文限制，讨论服务据此决定是否绑定工具和裁剪上下文
+type AIModel struct {
+	gorm.Model
+	ModelName               string `gorm:"type:varchar(50);uniqueIndex:idx_provider_model"`
+	Provider                string `gorm:"type:varchar(50);uniqueIndex:idx_provider_model"`
+	DisplayName             string `gorm:"type:varchar(50)"`
+	ContextLength           int32
+	MaxOutput               int32
+	SupportsTools           bool
+	SupportsReasoningStream bool
+	SupportsVision          bool
+	// 停用的模型不出现在可选列表中，已经使用它的角色不受影响
+	Enabled bool
+}
+
+// defaultModels 注册表为空时写入的初始模型，与系统角色使用的模型一致
+var defaultModels = []AIModel{
+	{Provider: "deepseek", ModelName: "deepseek-chat", DisplayName: "DeepSeek-V3", ContextLength: 65536, MaxOutput: 8192,
+		SupportsTools: true, Enabled: true},
+	{Provider: "deepseek", ModelName: "deepseek-reasoner", DisplayName: "DeepSeek-R1", ContextLength: 65536, MaxOutput: 32768,
+		SupportsReasoningStream: true, Enabled: true},
+}
+
+type ModelUsecase struct {
+	repo ModelRepo
+	log  *log.Helper
+}
+
+func NewModelUsecase(repo ModelRepo, logger log.Logger) *ModelUsecase {
+	uc := &ModelUsecase{repo: repo, log: log.NewHelper(logger)}
+	go func() {
+		if err := uc.seed(context.Background()); err != nil {
+			uc.log.Errorf("seed model registry failed: %v", err)
+		}
+	}()
+	return uc
+}
+
+func (uc *ModelUsecase) seed(ctx context.Context) error {
+	count, err := uc.repo.CountModels(ctx)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		for _, m := range defaultModels {
+			if err := uc.repo.CreateModel(ctx, &m); err != nil {
+				return err
+			}
+		}
+	}
+	uc.refreshCache(ctx)
+	return nil
+}
+
+// GetAvailableModels 返回启用的模型，供用户创建角色时选择
+func (uc *ModelUsecase) GetAvailableModels(ctx context.Context) ([]AIModel, error) {
+	return uc.repo.GetModels(ctx, true)
+}
+
+// GetModels 返回全部模型，包括停用的，供管理员使用
+func (uc *ModelUsecase) GetModels(ctx context.Context) ([]AIModel, error) {
+	return uc.repo.GetModels(ctx, false)
+}
+
+func (uc *ModelUsecase) GetModel(ctx context.Context, provider, name string) (AIModel, error) {
+	return uc.repo.GetModel(ctx, provider, name)
+}
+
+func (uc *ModelUsecase) CreateModel(ctx context.Context, model AIModel) error {
+	model.Provider, model.ModelName = strings.TrimSpace(model.Provider), strings.TrimSpace(model.ModelName)
+	if model.Provider == "" || model.ModelName == "" {
+		return ErrModelInvalid
+	}
+	if _, err := uc.repo.GetModel(ctx, model.Provider, model.ModelName); err == nil {
+		return ErrModelExists
+	}
+	if err := uc.repo.CreateModel(ctx, &model); err != nil {
+		return err
+	}
+	uc.refreshCache(ctx)
+	return nil
+}
+
+func (uc *ModelUsecase) SetModel(ctx context.Context, provider, name string, model AIModel) error {
+	if err := uc.repo.SetModel(ctx, provider, name, model); err != nil {
+		return err
+	}
+	uc.refreshCache(ctx)
+	return nil
+}
+
+func (uc *ModelUsecase) DeleteModel(ctx context.Context, provider, name string) error {
+	if err := uc.repo.DeleteModel(ctx, provider, name); err != nil {
+		return err
+	}
+	uc.refreshCache(ctx)
+	return nil
+}
+
+// refreshCache 网关从缓存中读取可选模型列表
+func (uc *ModelUsecase) refreshCache(ctx context.Context) {
+	models, err := uc.repo.GetModels(ctx, true)
+	if err != nil {
+		uc.log.Error(err)
+		return
+	}
+	if err := uc.repo.ModelsToRedis(ctx, models); err != nil {
+		uc.log.Error(err)
+	}
+}
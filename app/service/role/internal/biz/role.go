@@ -2,25 +2,39 @@ package biz
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
+var ErrRoleNotFound = status.Error(codes.NotFound, "角色不存在")
+
 type RoleRepo interface {
 	CreateRole(ctx context.Context, phone string, role Role) (string, error)
 	GetRoles(ctx context.Context, phone string) ([]Role, error)
 	GetRolesFromRedis(ctx context.Context, phone string) ([]Role, error)
-	GetModeratorAndParticipantsByUIDs(ctx context.Context, phone string, moderatorUID string, participantsUIDs []string) (Role, []Role, error)
-	DeleteRole(ctx context.Context, uid string) error
+	GetRolesByUIDs(ctx context.Context, phone string, uids []string) ([]Role, error)
+	DeleteRole(ctx context.Context, phone, uid string) error
 	RolesToRedis(ctx context.Context, phone string, roles []Role) error
 	SetRole(ctx context.Context, phone, uid string, role Role) error
-}
-
-type AIModel struct {
-	gorm.Model
-	ModelName string `gorm:"type:varchar(50)"`
-	Provider  string `gorm:"type:varchar(50)"`
+	PublishRole(ctx context.Context, phone, uid, visibility, shareCode string, at time.Time) error
+	UnpublishRole(ctx context.Context, phone, uid string) error
+	GetRoleByShareCode(ctx context.Context, shareCode string) (Role, error)
+	SearchSharedRoles(ctx context.Context, keyword, order string, offset, limit int) ([]Role, int64, error)
+	IncrementImportCount(ctx context.Context, id uint) error
+	SnapshotRole(ctx context.Context, phone, uid string) (int32, error)
+	GetRoleVersions(ctx context.Context, phone, uid string) ([]RoleVersion, error)
+	GetRoleVersion(ctx context.Context, phone, uid string, version int32) (RoleVersion, error)
+	RestoreRolePersona(ctx context.Context, phone, uid string, version RoleVersion) error
+	GetRolesWithoutVersion(ctx context.Context) ([]Role, error)
+	GetRolesNeedingRotation(ctx context.Context, keyring *secret.Keyring) ([]Role, error)
+	UpdateApiKey(ctx context.Context, id uint, apiKey, apiKeyMask string) error
+	DeleteRolesFromRedis(ctx context.Context, phone string) error
 }
 
 type Role struct {
@@ -31,25 +45,117 @@ type Role struct {
 	Description string `gorm:"type:text"`
 	Avatar      string `gorm:"type:varchar(200)"`
 	ApiPath     string `gorm:"type:varchar(50)"`
-	ApiKey      string `gorm:"type:varchar(50)"`
+	ApiKey      string `gorm:"type:text"`
+	ApiKeyMask  string `gorm:"type:varchar(50)"`
 	ModelName   string `gorm:"type:varchar(50)"`
 	Provider    string `gorm:"type:varchar(50)"`
+	// 引用的凭证，不为空时模型地址和密钥以凭证为准
+	CredentialUid string `gorm:"type:varchar(50);index"`
+	// 分享相关，公开的只有描述、头像和模型推荐，不包含任何密钥
+	Visibility  string `gorm:"type:varchar(20);default:private;index"`
+	ShareCode   string `gorm:"type:varchar(20);index"`
+	ImportCount int64
+	PublishedAt *time.Time
+	// 当前人设对应的版本号，见 RoleVersion
+	Version int32
 }
 
 type RoleUsecase struct {
-	repo RoleRepo
-	log  *log.Helper
+	repo    RoleRepo
+	crepo   CredentialRepo
+	mrepo   ModelRepo
+	catalog SystemRoleCatalog
+	urepo   UsageRepo
+	keyring *secret.Keyring
+	log     *log.Helper
 }
 
-func NewRoleUsecase(repo RoleRepo, logger log.Logger) *RoleUsecase {
-	return &RoleUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewRoleUsecase(repo RoleRepo, crepo CredentialRepo, mrepo ModelRepo, catalog SystemRoleCatalog, urepo UsageRepo, keyring *secret.Keyring, logger log.Logger) *RoleUsecase {
+	uc := &RoleUsecase{repo: repo, crepo: crepo, mrepo: mrepo, catalog: catalog, urepo: urepo, keyring: keyring, log: log.NewHelper(logger)}
+	go func() {
+		if err := uc.RotateApiKeys(context.Background()); err != nil {
+			uc.log.Errorf("rotate role api keys failed: %v", err)
+		}
+		if err := uc.BackfillRoleVersions(context.Background()); err != nil {
+			uc.log.Errorf("backfill role versions failed: %v", err)
+		}
+	}()
+	return uc
+}
+
+// sealApiKey 加密 API Key 并生成用于展示的掩码，明文只在请求处理过程中短暂存在
+func (uc *RoleUsecase) sealApiKey(role *Role) error {
+	if role.ApiKey == "" {
+		return nil
+	}
+	role.ApiKeyMask = secret.Mask(role.ApiKey)
+	encrypted, err := uc.keyring.Encrypt(role.ApiKey)
+	if err != nil {
+		return err
+	}
+	role.ApiKey = encrypted
+	return nil
+}
+
+// checkCredential 确认角色引用的凭证属于同一用户
+func (uc *RoleUsecase) checkCredential(ctx context.Context, phone string, role Role) error {
+	if role.CredentialUid == "" {
+		return nil
+	}
+	_, err := uc.crepo.GetCredential(ctx, phone, role.CredentialUid)
+	return err
+}
+
+// resolveCredentials 用引用的凭证覆盖角色的模型地址和密钥，返回的密钥仍为密文
+func (uc *RoleUsecase) resolveCredentials(ctx context.Context, phone string, roles ...*Role) error {
+	uids := []string{}
+	for _, role := range roles {
+		if role.CredentialUid != "" {
+			uids = append(uids, role.CredentialUid)
+		}
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+	credentials, err := uc.crepo.GetCredentialsByUIDs(ctx, phone, uids)
+	if err != nil {
+		return err
+	}
+	byUID := make(map[string]Credential, len(credentials))
+	for _, c := range credentials {
+		byUID[c.Uid] = c
+	}
+	for _, role := range roles {
+		if role.CredentialUid == "" {
+			continue
+		}
+		c, ok := byUID[role.CredentialUid]
+		if !ok {
+			return ErrCredentialNotFound
+		}
+		role.ApiPath = c.BaseURL
+		role.ApiKey = c.ApiKey
+		if c.Provider != "" {
+			role.Provider = c.Provider
+		}
+	}
+	return nil
 }
 
 func (uc *RoleUsecase) CreateRole(ctx context.Context, phone string, role Role) (string, error) {
+	if err := uc.checkCredential(ctx, phone, role); err != nil {
+		return "", err
+	}
+	if err := uc.sealApiKey(&role); err != nil {
+		return "", err
+	}
 	uid, err := uc.repo.CreateRole(ctx, phone, role)
 	if err != nil {
 		return "", err
 	}
+	if _, err := uc.repo.SnapshotRole(ctx, phone, uid); err != nil {
+		return "", err
+	}
 	roles, err := uc.repo.GetRoles(ctx, phone)
 	if err != nil {
 		return "", err
@@ -71,29 +177,86 @@ func (uc *RoleUsecase) GetRoles(ctx context.Context, phone string) ([]Role, erro
 	return roles, nil
 }
 
+// GetModeratorAndParticipantsByUIDs 按请求顺序返回角色，UID 可以是用户自己的角色或系统角色
 func (uc *RoleUsecase) GetModeratorAndParticipantsByUIDs(ctx context.Context, phone string, moderatorUID string, participantsUIDs []string) (Role, []Role, error) {
-	rolesFromRedis, err := uc.repo.GetRolesFromRedis(ctx, phone)
-	if err == nil {
-		moderator, participants := rolesFilter(rolesFromRedis, moderatorUID, participantsUIDs)
-		return moderator, participants, nil
-	} else {
-		uc.log.Error(err)
+	if phone == "" {
+		return Role{}, nil, ErrRoleNotFound
 	}
-	moderatorFromDB, participantsFromDB, err := uc.repo.GetModeratorAndParticipantsByUIDs(ctx, phone, moderatorUID, participantsUIDs)
+	userUIDs, roles, err := uc.splitSystemRoles(append([]string{moderatorUID}, participantsUIDs...))
 	if err != nil {
 		return Role{}, nil, err
 	}
+	userRoles, err := uc.getRolesByUIDs(ctx, phone, userUIDs)
+	if err != nil {
+		return Role{}, nil, err
+	}
+	for uid, role := range userRoles {
+		roles[uid] = role
+	}
+
+	moderator, ok := roles[moderatorUID]
+	if !ok {
+		return Role{}, nil, ErrRoleNotFound
+	}
+	participants := make([]Role, 0, len(participantsUIDs))
+	for _, uid := range participantsUIDs {
+		role, ok := roles[uid]
+		if !ok {
+			return Role{}, nil, ErrRoleNotFound
+		}
+		participants = append(participants, role)
+	}
+	return uc.resolved(ctx, phone, moderator, participants)
+}
+
+// getRolesByUIDs 优先从缓存读取用户角色，缓存可能落后于数据库，不完整时回源
+func (uc *RoleUsecase) getRolesByUIDs(ctx context.Context, phone string, uids []string) (map[string]Role, error) {
+	result := map[string]Role{}
+	if len(uids) == 0 {
+		return result, nil
+	}
+	wanted := make(map[string]struct{}, len(uids))
+	for _, uid := range uids {
+		wanted[uid] = struct{}{}
+	}
+	collect := func(roles []Role) {
+		for _, role := range roles {
+			if _, ok := wanted[role.Uid]; ok {
+				result[role.Uid] = role
+			}
+		}
+	}
 
-	allRoles := append(participantsFromDB, moderatorFromDB)
-	moderator, roles := rolesFilter(allRoles, moderatorUID, participantsUIDs)
-	if err = uc.repo.RolesToRedis(ctx, phone, allRoles); err != nil {
+	rolesFromRedis, err := uc.repo.GetRolesFromRedis(ctx, phone)
+	if err != nil {
 		uc.log.Error(err)
 	}
-	return moderator, roles, nil
+	collect(rolesFromRedis)
+	if len(result) == len(wanted) {
+		return result, nil
+	}
+
+	rolesFromDB, err := uc.repo.GetRolesByUIDs(ctx, phone, uids)
+	if err != nil {
+		return nil, err
+	}
+	collect(rolesFromDB)
+	return result, nil
+}
+
+func (uc *RoleUsecase) resolved(ctx context.Context, phone string, moderator Role, participants []Role) (Role, []Role, error) {
+	roles := []*Role{&moderator}
+	for i := range participants {
+		roles = append(roles, &participants[i])
+	}
+	if err := uc.resolveCredentials(ctx, phone, roles...); err != nil {
+		return Role{}, nil, err
+	}
+	return moderator, participants, nil
 }
 
 func (uc *RoleUsecase) DeleteRole(ctx context.Context, phone, uid string) error {
-	if err := uc.repo.DeleteRole(ctx, uid); err != nil {
+	if err := uc.repo.DeleteRole(ctx, phone, uid); err != nil {
 		return err
 	}
 	roles, err := uc.repo.GetRoles(ctx, phone)
@@ -107,33 +270,65 @@ func (uc *RoleUsecase) DeleteRole(ctx context.Context, phone, uid string) error
 	return nil
 }
 
-func (uc *RoleUsecase) SetRole(ctx context.Context, phone, uid string, role Role) error {
+// SetRole 修改角色，人设有变化时生成新版本，返回修改后的版本号
+func (uc *RoleUsecase) SetRole(ctx context.Context, phone, uid string, role Role) (int32, error) {
+	// 前端回传的掩码表示不修改 API Key
+	if strings.Contains(role.ApiKey, "****") {
+		role.ApiKey = ""
+	}
+	if err := uc.checkCredential(ctx, phone, role); err != nil {
+		return 0, err
+	}
+	if err := uc.sealApiKey(&role); err != nil {
+		return 0, err
+	}
 	if err := uc.repo.SetRole(ctx, phone, uid, role); err != nil {
-		return err
+		return 0, err
 	}
-	roles, err := uc.repo.GetRoles(ctx, phone)
+	version, err := uc.repo.SnapshotRole(ctx, phone, uid)
 	if err != nil {
-		uc.log.Error(err)
-		return nil
-	}
-	if err = uc.repo.RolesToRedis(ctx, phone, roles); err != nil {
-		uc.log.Error(err)
+		return 0, err
 	}
-	return nil
+	uc.refreshCache(ctx, phone)
+	return version, nil
 }
 
-func rolesFilter(roles []Role, moderatorUID string, RoleUIDs []string) (Role, []Role) {
-	rolesResult := []Role{}
-	moderator := Role{}
+// RotateApiKeys 把明文或旧主密钥包装的 API Key 重新包装到当前主密钥下
+func (uc *RoleUsecase) RotateApiKeys(ctx context.Context) error {
+	roles, err := uc.repo.GetRolesNeedingRotation(ctx, uc.keyring)
+	if err != nil {
+		return err
+	}
+	phones := map[string]struct{}{}
 	for _, role := range roles {
-		for _, uid := range RoleUIDs {
-			if role.Uid == uid {
-				rolesResult = append(rolesResult, role)
+		mask := role.ApiKeyMask
+		if mask == "" {
+			plaintext, err := uc.keyring.Decrypt(role.ApiKey)
+			if err != nil {
+				uc.log.Errorf("decrypt api key of role %s failed: %v", role.Uid, err)
+				continue
 			}
+			mask = secret.Mask(plaintext)
 		}
-		if role.Uid == moderatorUID {
-			moderator = role
+		rotated, err := uc.keyring.Rotate(role.ApiKey)
+		if err != nil {
+			uc.log.Errorf("rotate api key of role %s failed: %v", role.Uid, err)
+			continue
+		}
+		if err := uc.repo.UpdateApiKey(ctx, role.ID, rotated, mask); err != nil {
+			uc.log.Errorf("update api key of role %s failed: %v", role.Uid, err)
+			continue
+		}
+		phones[role.Phone] = struct{}{}
+	}
+	// 缓存中可能还是明文，直接删除等待下次回源
+	for phone := range phones {
+		if err := uc.repo.DeleteRolesFromRedis(ctx, phone); err != nil {
+			uc.log.Error(err)
 		}
 	}
-	return moderator, rolesResult
+	if len(roles) > 0 {
+		uc.log.Infof("rotated %d role api keys", len(roles))
+	}
+	return nil
 }
@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	VisibilityPrivate  = "private"
+	VisibilityUnlisted = "unlisted" // 只能通过分享码访问
+	VisibilityPublic   = "public"   // 出现在公开列表中
+)
+
+const (
+	SharedOrderLatest  = "latest"
+	SharedOrderPopular = "popular"
+
+	defaultSharedPageSize = 20
+	maxSharedPageSize     = 100
+)
+
+var ErrSharedRoleNotFound = status.Error(codes.NotFound, "分享的角色不存在")
+
+// PublishRole 发布角色，重复发布时沿用已有的分享码
+func (uc *RoleUsecase) PublishRole(ctx context.Context, phone, uid, visibility string) (string, error) {
+	if visibility == "" {
+		visibility = VisibilityPublic
+	}
+	if visibility != VisibilityPublic && visibility != VisibilityUnlisted {
+		return "", status.Error(codes.InvalidArgument, "可见性只能是 public 或 unlisted")
+	}
+	roles, err := uc.repo.GetRolesByUIDs(ctx, phone, []string{uid})
+	if err != nil {
+		return "", err
+	}
+	if len(roles) == 0 {
+		return "", ErrRoleNotFound
+	}
+	shareCode := roles[0].ShareCode
+	if shareCode == "" {
+		if shareCode, err = newShareCode(); err != nil {
+			return "", err
+		}
+	}
+	if err := uc.repo.PublishRole(ctx, phone, uid, visibility, shareCode, time.Now()); err != nil {
+		return "", err
+	}
+	uc.refreshCache(ctx, phone)
+	return shareCode, nil
+}
+
+// UnpublishRole 取消发布，分享码保留以便再次发布时不变
+func (uc *RoleUsecase) UnpublishRole(ctx context.Context, phone, uid string) error {
+	if err := uc.repo.UnpublishRole(ctx, phone, uid); err != nil {
+		return err
+	}
+	uc.refreshCache(ctx, phone)
+	return nil
+}
+
+// GetSharedRoles 分页搜索公开的角色，keyword 匹配名称和描述
+func (uc *RoleUsecase) GetSharedRoles(ctx context.Context, keyword, order string, page, pageSize int) ([]Role, int64, error) {
+	if order != SharedOrderPopular {
+		order = SharedOrderLatest
+	}
+	if pageSize <= 0 {
+		pageSize = defaultSharedPageSize
+	}
+	if pageSize > maxSharedPageSize {
+		pageSize = maxSharedPageSize
+	}
+	if page <= 0 {
+		page = 1
+	}
+	return uc.repo.SearchSharedRoles(ctx, strings.TrimSpace(keyword), order, (page-1)*pageSize, pageSize)
+}
+
+func (uc *RoleUsecase) GetSharedRole(ctx context.Context, shareCode string) (Role, error) {
+	return uc.repo.GetRoleByShareCode(ctx, strings.ToUpper(strings.TrimSpace(shareCode)))
+}
+
+// ImportSharedRole 把分享的角色复制到自己的角色库，使用自己的凭证或密钥
+func (uc *RoleUsecase) ImportSharedRole(ctx context.Context, phone, shareCode, name, credentialUID, apiKey string) (string, error) {
+	shared, err := uc.GetSharedRole(ctx, shareCode)
+	if err != nil {
+		return "", err
+	}
+	role := Role{
+		RoleName:      shared.RoleName,
+		Description:   shared.Description,
+		Avatar:        shared.Avatar,
+		ModelName:     shared.ModelName,
+		Provider:      shared.Provider,
+		CredentialUid: credentialUID,
+		ApiKey:        apiKey,
+	}
+	if name != "" {
+		role.RoleName = name
+	}
+	uid, err := uc.CreateRole(ctx, phone, role)
+	if err != nil {
+		return "", err
+	}
+	if err := uc.repo.IncrementImportCount(ctx, shared.ID); err != nil {
+		uc.log.Error(err)
+	}
+	return uid, nil
+}
+
+func (uc *RoleUsecase) refreshCache(ctx context.Context, phone string) {
+	roles, err := uc.repo.GetRoles(ctx, phone)
+	if err != nil {
+		uc.log.Error(err)
+		return
+	}
+	if err = uc.repo.RolesToRedis(ctx, phone, roles); err != nil {
+		uc.log.Error(err)
+	}
+}
+
+// newShareCode 生成 8 位大写分享码，5 字节经 base32 编码后正好没有填充
+func newShareCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(b), nil
+}
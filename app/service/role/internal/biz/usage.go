@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"context"
+	"math"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// UsageRepo 生成角色使用平台密钥，用量计入调用者在讨论服务中的配额
+type UsageRepo interface {
+	CheckQuota(ctx context.Context, phone string) error
+	RecordUsage(ctx context.Context, usage GenerationUsage) error
+}
+
+// GenerationUsage 一次生成的用量，模型未返回 usage 时按文本长度估算并标记 Estimated
+type GenerationUsage struct {
+	Phone            string
+	ModelName        string
+	PromptTokens     int64
+	CompletionTokens int64
+	Estimated        bool
+}
+
+func generationUsage(phone string, input []*schema.Message, output *schema.Message) GenerationUsage {
+	usage := GenerationUsage{Phone: phone, ModelName: generatorModelName}
+	if output.ResponseMeta != nil && output.ResponseMeta.Usage != nil {
+		usage.PromptTokens = int64(output.ResponseMeta.Usage.PromptTokens)
+		usage.CompletionTokens = int64(output.ResponseMeta.Usage.CompletionTokens)
+		return usage
+	}
+	for _, msg := range input {
+		usage.PromptTokens += estimateTokens(msg.Content)
+	}
+	usage.CompletionTokens = estimateTokens(output.Content)
+	usage.Estimated = true
+	return usage
+}
+
+// estimateTokens 与讨论服务的估算方式一致：中日韩字符约 0.6 个 token，其余字符约 0.3 个
+func estimateTokens(s string) int64 {
+	var n float64
+	for _, r := range s {
+		if r >= 0x2E80 {
+			n += 0.6
+		} else {
+			n += 0.3
+		}
+	}
+	return int64(math.Ceil(n))
+}
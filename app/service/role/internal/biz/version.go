@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var ErrRoleVersionNotFound = status.Error(codes.NotFound, "角色版本不存在")
+
+// RoleVersion 角色人设的不可变快照，每次修改人设都会生成新版本
+// 只记录影响发言内容的字段，密钥和凭证属于运行配置，不进入版本
+type RoleVersion struct {
+	gorm.Model
+	RoleUid     string `gorm:"type:varchar(50);uniqueIndex:idx_role_version"`
+	Version     int32  `gorm:"uniqueIndex:idx_role_version"`
+	Phone       string `gorm:"type:varchar(50);index"`
+	RoleName    string `gorm:"type:varchar(50)"`
+	Description string `gorm:"type:text"`
+	Avatar      string `gorm:"type:varchar(200)"`
+	ModelName   string `gorm:"type:varchar(50)"`
+	Provider    string `gorm:"type:varchar(50)"`
+}
+
+// SamePersona 判断快照和角色当前的人设是否一致
+func (v RoleVersion) SamePersona(role Role) bool {
+	return v.RoleName == role.RoleName && v.Description == role.Description && v.Avatar == role.Avatar &&
+		v.ModelName == role.ModelName && v.Provider == role.Provider
+}
+
+// FieldChange 两个版本之间某个字段的变化
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+func (uc *RoleUsecase) GetRoleVersions(ctx context.Context, phone, uid string) ([]RoleVersion, error) {
+	return uc.repo.GetRoleVersions(ctx, phone, uid)
+}
+
+// DiffRoleVersions 比较同一角色的两个版本
+func (uc *RoleUsecase) DiffRoleVersions(ctx context.Context, phone, uid string, from, to int32) ([]FieldChange, error) {
+	fromVersion, err := uc.repo.GetRoleVersion(ctx, phone, uid, from)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := uc.repo.GetRoleVersion(ctx, phone, uid, to)
+	if err != nil {
+		return nil, err
+	}
+	changes := []FieldChange{}
+	add := func(field, a, b string) {
+		if a != b {
+			changes = append(changes, FieldChange{Field: field, From: a, To: b})
+		}
+	}
+	add("name", fromVersion.RoleName, toVersion.RoleName)
+	add("description", fromVersion.Description, toVersion.Description)
+	add("avatar", fromVersion.Avatar, toVersion.Avatar)
+	add("model", fromVersion.ModelName, toVersion.ModelName)
+	add("provider", fromVersion.Provider, toVersion.Provider)
+	return changes, nil
+}
+
+// RollbackRole 用历史版本的人设覆盖角色，回滚本身也会生成一个新版本，历史不会被改写
+func (uc *RoleUsecase) RollbackRole(ctx context.Context, phone, uid string, version int32) (int32, error) {
+	target, err := uc.repo.GetRoleVersion(ctx, phone, uid, version)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.repo.RestoreRolePersona(ctx, phone, uid, target); err != nil {
+		return 0, err
+	}
+	current, err := uc.repo.SnapshotRole(ctx, phone, uid)
+	if err != nil {
+		return 0, err
+	}
+	uc.refreshCache(ctx, phone)
+	return current, nil
+}
+
+// BackfillRoleVersions 为版本功能上线前创建的角色生成第一个版本
+func (uc *RoleUsecase) BackfillRoleVersions(ctx context.Context) error {
+	roles, err := uc.repo.GetRolesWithoutVersion(ctx)
+	if err != nil {
+		return err
+	}
+	phones := map[string]struct{}{}
+	for _, role := range roles {
+		if _, err := uc.repo.SnapshotRole(ctx, role.Phone, role.Uid); err != nil {
+			uc.log.Errorf("snapshot role %s failed: %v", role.Uid, err)
+			continue
+		}
+		phones[role.Phone] = struct{}{}
+	}
+	for phone := range phones {
+		if err := uc.repo.DeleteRolesFromRedis(ctx, phone); err != nil {
+			uc.log.Error(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package data
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// systemRolesYAML 系统角色表为空时写入的初始目录
+//
+//go:embed system_roles.yaml
+var systemRolesYAML []byte
+
+// systemRoleReloadInterval 其他实例修改目录后，本实例最迟在这个间隔内看到变化
+const systemRoleReloadInterval = time.Minute
+
+// SystemRoleRecord 系统角色在数据库中的记录，软删除的记录表示已下线
+type SystemRoleRecord struct {
+	gorm.Model
+	Uid         string   `gorm:"uniqueIndex;type:varchar(100)"`
+	Kind        string   `gorm:"type:varchar(20)"`
+	Name        string   `gorm:"type:varchar(50)"`
+	Description string   `gorm:"type:text"`
+	Avatar      string   `gorm:"type:varchar(255)"`
+	Provider    string   `gorm:"type:varchar(50)"`
+	ModelName   string   `gorm:"type:varchar(50)"`
+	Tags        []string `gorm:"type:json;serializer:json"`
+}
+
+func (r SystemRoleRecord) toSystemRole() biz.SystemRole {
+	return biz.SystemRole{
+		Uid:         r.Uid,
+		Kind:        r.Kind,
+		Name:        r.Name,
+		Description: r.Description,
+		Avatar:      r.Avatar,
+		Provider:    r.Provider,
+		ModelName:   r.ModelName,
+		Tags:        r.Tags,
+		Retired:     r.DeletedAt.Valid,
+	}
+}
+
+func fromSystemRole(role biz.SystemRole) SystemRoleRecord {
+	return SystemRoleRecord{
+		Uid:         role.Uid,
+		Kind:        role.Kind,
+		Name:        role.Name,
+		Description: role.Description,
+		Avatar:      role.Avatar,
+		Provider:    role.Provider,
+		ModelName:   role.ModelName,
+		Tags:        role.Tags,
+	}
+}
+
+// systemRoleCatalog 以 MySQL 为准，内存中保留一份快照供讨论时快速读取
+type systemRoleCatalog struct {
+	data *Data
+	log  *log.Helper
+
+	mu    sync.RWMutex
+	roles []biz.SystemRole
+	byUID map[string]biz.SystemRole
+}
+
+// NewSystemRoleCatalog 加载系统角色目录，表为空时写入随服务打包的初始角色，返回的清理函数停止定时刷新
+func NewSystemRoleCatalog(data *Data, logger log.Logger) (biz.SystemRoleCatalog, func(), error) {
+	c := &systemRoleCatalog{data: data, log: log.NewHelper(logger)}
+	if err := c.seed(); err != nil {
+		return nil, nil, err
+	}
+	if err := c.reload(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(systemRoleReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.reload(ctx); err != nil && ctx.Err() == nil {
+					c.log.Errorf("reload system roles failed: %v", err)
+				}
+			}
+		}
+	}()
+	cleanup := func() {
+		cancel()
+		<-done
+	}
+	return c, cleanup, nil
+}
+
+func (c *systemRoleCatalog) seed() error {
+	var count int64
+	if err := c.data.mysqlClient.Unscoped().Model(&SystemRoleRecord{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	var file struct {
+		Roles []biz.SystemRole `yaml:"roles"`
+	}
+	if err := yaml.Unmarshal(systemRolesYAML, &file); err != nil {
+		return fmt.Errorf("parse system roles: %w", err)
+	}
+	records := make([]SystemRoleRecord, 0, len(file.Roles))
+	seen := map[string]bool{}
+	for _, role := range file.Roles {
+		if !strings.HasPrefix(role.Uid, biz.SystemRolePrefix) {
+			return fmt.Errorf("system role uid %q must start with %q", role.Uid, biz.SystemRolePrefix)
+		}
+		if seen[role.Uid] {
+			return fmt.Errorf("duplicate system role uid %q", role.Uid)
+		}
+		seen[role.Uid] = true
+		records = append(records, fromSystemRole(role))
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	// 多个实例同时启动时都可能看到空表，已经写入的角色跳过
+	return c.data.mysqlClient.Clauses(clause.OnConflict{DoNothing: true}).Create(&records).Error
+}
+
+// reload 下线的角色也保留在快照中，已有讨论仍然可以使用它们
+func (c *systemRoleCatalog) reload(ctx context.Context) error {
+	records := []SystemRoleRecord{}
+	if err := c.data.mysqlClient.WithContext(ctx).Unscoped().Order("id").Find(&records).Error; err != nil {
+		return err
+	}
+	roles := make([]biz.SystemRole, 0, len(records))
+	byUID := make(map[string]biz.SystemRole, len(records))
+	for _, r := range records {
+		role := r.toSystemRole()
+		byUID[role.Uid] = role
+		if !role.Retired {
+			roles = append(roles, role)
+		}
+	}
+	c.mu.Lock()
+	c.roles, c.byUID = roles, byUID
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *systemRoleCatalog) List() []biz.SystemRole {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.roles
+}
+
+func (c *systemRoleCatalog) Get(uid string) (biz.SystemRole, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	role, ok := c.byUID[uid]
+	return role, ok
+}
+
+// Create 下线角色的 UID 不能复用，否则已有讨论引用的角色会被替换
+func (c *systemRoleCatalog) Create(ctx context.Context, role biz.SystemRole) error {
+	err := c.data.mysqlClient.WithContext(ctx).Unscoped().Where("uid = ?", role.Uid).First(&SystemRoleRecord{}).Error
+	if err == nil {
+		return biz.ErrSystemRoleExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	record := fromSystemRole(role)
+	if err := c.data.mysqlClient.WithContext(ctx).Create(&record).Error; err != nil {
+		return err
+	}
+	return c.reload(ctx)
+}
+
+func (c *systemRoleCatalog) Update(ctx context.Context, role biz.SystemRole) error {
+	record := fromSystemRole(role)
+	result := c.data.mysqlClient.WithContext(ctx).Model(&SystemRoleRecord{}).Where("uid = ?", role.Uid).
+		Select("kind", "name", "description", "avatar", "provider", "model_name", "tags").
+		Updates(&record)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrSystemRoleNotFound
+	}
+	return c.reload(ctx)
+}
+
+func (c *systemRoleCatalog) Delete(ctx context.Context, uid string) error {
+	result := c.data.mysqlClient.WithContext(ctx).Delete(&SystemRoleRecord{}, "uid = ?", uid)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrSystemRoleNotFound
+	}
+	return c.reload(ctx)
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type credentialRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCredentialRepo(data *Data, logger log.Logger) biz.CredentialRepo {
+	return &credentialRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *credentialRepo) CreateCredential(ctx context.Context, credential *biz.Credential) error {
+	uid, err := utils.GetSnowflakeID(0)
+	if err != nil {
+		return err
+	}
+	credential.Uid = uid
+	return r.data.mysqlClient.Create(credential).Error
+}
+
+func (r *credentialRepo) GetCredentials(ctx context.Context, phone string) ([]biz.Credential, error) {
+	credentials := []biz.Credential{}
+	if err := r.data.mysqlClient.Where("phone = ?", phone).Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+func (r *credentialRepo) GetCredential(ctx context.Context, phone, uid string) (biz.Credential, error) {
+	credential := biz.Credential{}
+	err := r.data.mysqlClient.Where("phone = ? AND uid = ?", phone, uid).First(&credential).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return biz.Credential{}, biz.ErrCredentialNotFound
+	}
+	if err != nil {
+		return biz.Credential{}, err
+	}
+	return credential, nil
+}
+
+func (r *credentialRepo) GetCredentialsByUIDs(ctx context.Context, phone string, uids []string) ([]biz.Credential, error) {
+	credentials := []biz.Credential{}
+	if err := r.data.mysqlClient.Where("phone = ? AND uid IN ?", phone, uids).Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+func (r *credentialRepo) SetCredential(ctx context.Context, phone, uid string, credential biz.Credential) error {
+	result := r.data.mysqlClient.Model(&biz.Credential{}).Where("phone = ? AND uid = ?", phone, uid).Updates(&credential)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrCredentialNotFound
+	}
+	return nil
+}
+
+func (r *credentialRepo) DeleteCredential(ctx context.Context, phone, uid string) error {
+	result := r.data.mysqlClient.Delete(&biz.Credential{}, "phone = ? AND uid = ?", phone, uid)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrCredentialNotFound
+	}
+	return nil
+}
+
+func (r *credentialRepo) CountRolesByCredential(ctx context.Context, phone, uid string) (int64, error) {
+	var count int64
+	if err := r.data.mysqlClient.Model(&biz.Role{}).Where("phone = ? AND credential_uid = ?", phone, uid).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *credentialRepo) RecordCredentialUsage(ctx context.Context, uid, errMsg string, at time.Time) error {
+	updates := map[string]interface{}{"last_used_at": at}
+	if errMsg != "" {
+		updates["last_error"] = errMsg
+		updates["last_error_at"] = at
+	}
+	return r.data.mysqlClient.Model(&biz.Credential{}).Where("uid = ?", uid).Updates(updates).Error
+}
+
+func (r *credentialRepo) GetCredentialsNeedingRotation(ctx context.Context, keyring *secret.Keyring) ([]biz.Credential, error) {
+	credentials := []biz.Credential{}
+	if err := r.data.mysqlClient.Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	result := []biz.Credential{}
+	for _, credential := range credentials {
+		if credential.NeedsRotation(keyring) {
+			result = append(result, credential)
+		}
+	}
+	return result, nil
+}
+
+func (r *credentialRepo) UpdateCredentialSecrets(ctx context.Context, id uint, apiKey string) error {
+	return r.data.mysqlClient.Model(&biz.Credential{}).Where("id = ?", id).
+		Update("api_key", apiKey).Error
+}
@@ -1,18 +1,29 @@
 package data
 
 import (
+	"context"
+	"time"
+
+	seminarV1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
 	"github.com/Fl0rencess720/Ayana/app/service/role/internal/conf"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-redis/redis/extra/redisotel"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	grpcx "google.golang.org/grpc"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewRoleRepo, NewMysql, NewRedis)
+var ProviderSet = wire.NewSet(NewData, NewKeyring, NewRoleRepo, NewCredentialRepo, NewModelRepo, NewSystemRoleCatalog, NewUsageRepo, NewSeminarServiceClient, NewMysql, NewRedis)
 
 // Data .
 type Data struct {
@@ -41,6 +52,15 @@ func NewMysql(c *conf.Data) *gorm.DB {
 	if err := db.AutoMigrate(biz.Role{}); err != nil {
 		panic("failed to migrate mysql")
 	}
+	if err := db.AutoMigrate(biz.Credential{}); err != nil {
+		panic("failed to migrate mysql")
+	}
+	if err := db.AutoMigrate(biz.RoleVersion{}); err != nil {
+		panic("failed to migrate mysql")
+	}
+	if err := db.AutoMigrate(SystemRoleRecord{}); err != nil {
+		panic("failed to migrate mysql")
+	}
 	return db
 }
 
@@ -56,3 +76,28 @@ func NewRedis(c *conf.Data) *redis.Client {
 	rdb.AddHook(redisotel.TracingHook{})
 	return rdb
 }
+
+// NewKeyring 加载角色 API Key 和 MCP 请求头加密使用的主密钥
+func NewKeyring(c *conf.Secret) (*secret.Keyring, error) {
+	return secret.NewKeyring(c.CurrentKey, c.MasterKeys)
+}
+
+func NewSeminarServiceClient(sr *conf.Service, rr registry.Discovery) seminarV1.SeminarClient {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(sr.Seminar.Endpoint),
+		grpc.WithDiscovery(rr),
+		grpc.WithMiddleware(
+			tracing.Client(),
+			identity.Client(),
+			recovery.Recovery(),
+		),
+		grpc.WithTimeout(2*time.Second),
+		grpc.WithOptions(grpcx.WithStatsHandler(&tracing.ClientHandler{})),
+	)
+	if err != nil {
+		panic(err)
+	}
+	c := seminarV1.NewSeminarClient(conn)
+	return c
+}
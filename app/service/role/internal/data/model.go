@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type modelRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewModelRepo(data *Data, logger log.Logger) biz.ModelRepo {
+	return &modelRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *modelRepo) CreateModel(ctx context.Context, model *biz.AIModel) error {
+	return r.data.mysqlClient.Create(model).Error
+}
+
+func (r *modelRepo) GetModels(ctx context.Context, enabledOnly bool) ([]biz.AIModel, error) {
+	models := []biz.AIModel{}
+	db := r.data.mysqlClient.Order("provider, model_name")
+	if enabledOnly {
+		db = db.Where("enabled = ?", true)
+	}
+	if err := db.Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+func (r *modelRepo) GetModel(ctx context.Context, provider, name string) (biz.AIModel, error) {
+	model := biz.AIModel{}
+	err := r.data.mysqlClient.Where("provider = ? AND model_name = ?", provider, name).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return biz.AIModel{}, biz.ErrModelNotFound
+	}
+	if err != nil {
+		return biz.AIModel{}, err
+	}
+	return model, nil
+}
+
+// SetModel 布尔字段需要允许改为 false，因此按字段名整体更新
+func (r *modelRepo) SetModel(ctx context.Context, provider, name string, model biz.AIModel) error {
+	result := r.data.mysqlClient.Model(&biz.AIModel{}).Where("provider = ? AND model_name = ?", provider, name).
+		Select("display_name", "context_length", "max_output", "supports_tools", "supports_reasoning_stream", "supports_vision", "enabled").
+		Updates(&model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrModelNotFound
+	}
+	return nil
+}
+
+// DeleteModel 硬删除，以便之后可以用相同的提供商和名称重新注册
+func (r *modelRepo) DeleteModel(ctx context.Context, provider, name string) error {
+	result := r.data.mysqlClient.Unscoped().Delete(&biz.AIModel{}, "provider = ? AND model_name = ?", provider, name)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrModelNotFound
+	}
+	return nil
+}
+
+func (r *modelRepo) CountModels(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.data.mysqlClient.Model(&biz.AIModel{}).Count(&count).Error
+	return count, err
+}
+
+func (r *modelRepo) ModelsToRedis(ctx context.Context, models []biz.AIModel) error {
+	serialized, err := json.Marshal(models)
+	if err != nil {
+		return err
+	}
+	return r.data.redisClient.Set(ctx, "models", serialized, 0).Err()
+}
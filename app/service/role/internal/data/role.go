@@ -3,11 +3,16 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
 	"github.com/Fl0rencess720/Ayana/pkgs/utils"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type roleRepo struct {
@@ -43,22 +48,21 @@ func (r *roleRepo) GetRoles(ctx context.Context, phone string) ([]biz.Role, erro
 	return roles, nil
 }
 
-func (r *roleRepo) GetModeratorAndParticipantsByUIDs(ctx context.Context, phone string, moderatorUID string, participantsUIDs []string) (biz.Role, []biz.Role, error) {
-	participantsResult := []biz.Role{}
-	moderator := biz.Role{}
-	if err := r.data.mysqlClient.Debug().Where("uid IN ?", participantsUIDs).Find(&participantsResult).Error; err != nil {
-		return biz.Role{}, nil, err
-	}
-
-	if err := r.data.mysqlClient.Debug().Where("uid = ?", moderatorUID).Find(&moderator).Error; err != nil {
-		return biz.Role{}, nil, err
+func (r *roleRepo) GetRolesByUIDs(ctx context.Context, phone string, uids []string) ([]biz.Role, error) {
+	roles := []biz.Role{}
+	if err := r.data.mysqlClient.Where("phone = ? AND uid IN ?", phone, uids).Find(&roles).Error; err != nil {
+		return nil, err
 	}
-	return moderator, participantsResult, nil
+	return roles, nil
 }
 
-func (r *roleRepo) DeleteRole(ctx context.Context, uid string) error {
-	if err := r.data.mysqlClient.Delete(&biz.Role{}, "uid = ?", uid).Error; err != nil {
-		return err
+func (r *roleRepo) DeleteRole(ctx context.Context, phone, uid string) error {
+	result := r.data.mysqlClient.Delete(&biz.Role{}, "phone = ? AND uid = ?", phone, uid)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrRoleNotFound
 	}
 	return nil
 }
@@ -83,5 +87,189 @@ func (r *roleRepo) GetRolesFromRedis(ctx context.Context, phone string) ([]biz.R
 	return roles, nil
 }
 func (r *roleRepo) SetRole(ctx context.Context, phone, uid string, role biz.Role) error {
-	return r.data.mysqlClient.Model(&biz.Role{}).Where("uid = ?", uid).Updates(&role).Error
+	result := r.data.mysqlClient.Model(&biz.Role{}).Where("phone = ? AND uid = ?", phone, uid).Updates(&role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrRoleNotFound
+	}
+	return nil
+}
+
+// GetRolesNeedingRotation 找出明文存储或不是由当前主密钥包装的 API Key
+func (r *roleRepo) GetRolesNeedingRotation(ctx context.Context, keyring *secret.Keyring) ([]biz.Role, error) {
+	roles := []biz.Role{}
+	if err := r.data.mysqlClient.Where("api_key <> ''").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	result := []biz.Role{}
+	for _, role := range roles {
+		if keyring.NeedsRotation(role.ApiKey) {
+			result = append(result, role)
+		}
+	}
+	return result, nil
+}
+
+func (r *roleRepo) UpdateApiKey(ctx context.Context, id uint, apiKey, apiKeyMask string) error {
+	return r.data.mysqlClient.Model(&biz.Role{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"api_key": apiKey, "api_key_mask": apiKeyMask}).Error
+}
+
+func (r *roleRepo) DeleteRolesFromRedis(ctx context.Context, phone string) error {
+	return r.data.redisClient.Del(ctx, "roles:"+phone).Err()
+}
+
+func (r *roleRepo) PublishRole(ctx context.Context, phone, uid, visibility, shareCode string, at time.Time) error {
+	result := r.data.mysqlClient.Model(&biz.Role{}).Where("phone = ? AND uid = ?", phone, uid).
+		Updates(map[string]interface{}{"visibility": visibility, "share_code": shareCode, "published_at": at})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepo) UnpublishRole(ctx context.Context, phone, uid string) error {
+	result := r.data.mysqlClient.Model(&biz.Role{}).Where("phone = ? AND uid = ?", phone, uid).
+		Update("visibility", biz.VisibilityPrivate)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepo) GetRoleByShareCode(ctx context.Context, shareCode string) (biz.Role, error) {
+	role := biz.Role{}
+	err := r.data.mysqlClient.Where("share_code = ? AND visibility IN ?", shareCode,
+		[]string{biz.VisibilityPublic, biz.VisibilityUnlisted}).First(&role).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return biz.Role{}, biz.ErrSharedRoleNotFound
+	}
+	if err != nil {
+		return biz.Role{}, err
+	}
+	return role, nil
+}
+
+func (r *roleRepo) SearchSharedRoles(ctx context.Context, keyword, order string, offset, limit int) ([]biz.Role, int64, error) {
+	query := r.data.mysqlClient.Model(&biz.Role{}).Where("visibility = ?", biz.VisibilityPublic)
+	if keyword != "" {
+		like := "%" + escapeLike(keyword) + "%"
+		query = query.Where("role_name LIKE ? OR description LIKE ?", like, like)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	orderBy := "published_at DESC"
+	if order == biz.SharedOrderPopular {
+		orderBy = "import_count DESC, published_at DESC"
+	}
+	roles := []biz.Role{}
+	if err := query.Order(orderBy).Offset(offset).Limit(limit).Find(&roles).Error; err != nil {
+		return nil, 0, err
+	}
+	return roles, total, nil
+}
+
+func (r *roleRepo) IncrementImportCount(ctx context.Context, id uint) error {
+	return r.data.mysqlClient.Model(&biz.Role{}).Where("id = ?", id).
+		UpdateColumn("import_count", gorm.Expr("import_count + ?", 1)).Error
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// SnapshotRole 在事务中锁住角色，人设和最新版本不同时写入新版本，返回角色当前的版本号
+func (r *roleRepo) SnapshotRole(ctx context.Context, phone, uid string) (int32, error) {
+	var version int32
+	err := r.data.mysqlClient.Transaction(func(tx *gorm.DB) error {
+		role := biz.Role{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("phone = ? AND uid = ?", phone, uid).First(&role).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.ErrRoleNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if role.Version > 0 {
+			latest := biz.RoleVersion{}
+			err := tx.Where("role_uid = ? AND version = ?", uid, role.Version).First(&latest).Error
+			if err == nil && latest.SamePersona(role) {
+				version = role.Version
+				return nil
+			}
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+		version = role.Version + 1
+		if err := tx.Create(&biz.RoleVersion{
+			RoleUid:     uid,
+			Version:     version,
+			Phone:       phone,
+			RoleName:    role.RoleName,
+			Description: role.Description,
+			Avatar:      role.Avatar,
+			ModelName:   role.ModelName,
+			Provider:    role.Provider,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&biz.Role{}).Where("id = ?", role.ID).Update("version", version).Error
+	})
+	return version, err
+}
+
+func (r *roleRepo) GetRoleVersions(ctx context.Context, phone, uid string) ([]biz.RoleVersion, error) {
+	versions := []biz.RoleVersion{}
+	if err := r.data.mysqlClient.Where("phone = ? AND role_uid = ?", phone, uid).Order("version DESC").Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (r *roleRepo) GetRoleVersion(ctx context.Context, phone, uid string, version int32) (biz.RoleVersion, error) {
+	v := biz.RoleVersion{}
+	err := r.data.mysqlClient.Where("phone = ? AND role_uid = ? AND version = ?", phone, uid, version).First(&v).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return biz.RoleVersion{}, biz.ErrRoleVersionNotFound
+	}
+	if err != nil {
+		return biz.RoleVersion{}, err
+	}
+	return v, nil
+}
+
+func (r *roleRepo) RestoreRolePersona(ctx context.Context, phone, uid string, version biz.RoleVersion) error {
+	result := r.data.mysqlClient.Model(&biz.Role{}).Where("phone = ? AND uid = ?", phone, uid).Updates(map[string]interface{}{
+		"role_name":   version.RoleName,
+		"description": version.Description,
+		"avatar":      version.Avatar,
+		"model_name":  version.ModelName,
+		"provider":    version.Provider,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepo) GetRolesWithoutVersion(ctx context.Context) ([]biz.Role, error) {
+	roles := []biz.Role{}
+	if err := r.data.mysqlClient.Where("version = 0").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	return roles, nil
 }
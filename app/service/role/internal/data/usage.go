@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+
+	seminarV1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
+	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// usageKindGenerate 与讨论服务中生成角色的用量类型一致
+const usageKindGenerate = "generate"
+
+type usageRepo struct {
+	sc  seminarV1.SeminarClient
+	log *log.Helper
+}
+
+func NewUsageRepo(sc seminarV1.SeminarClient, logger log.Logger) biz.UsageRepo {
+	return &usageRepo{sc: sc, log: log.NewHelper(logger)}
+}
+
+func (r *usageRepo) CheckQuota(ctx context.Context, phone string) error {
+	_, err := r.sc.CheckQuota(ctx, &seminarV1.CheckQuotaRequest{Phone: phone})
+	return err
+}
+
+func (r *usageRepo) RecordUsage(ctx context.Context, usage biz.GenerationUsage) error {
+	_, err := r.sc.RecordUsage(ctx, &seminarV1.RecordUsageRequest{
+		Phone:            usage.Phone,
+		Kind:             usageKindGenerate,
+		Model:            usage.ModelName,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		Estimated:        usage.Estimated,
+	})
+	return err
+}
@@ -1,9 +1,14 @@
 package server
 
 import (
+	"time"
+
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/role/internal/conf"
 	"github.com/Fl0rencess720/Ayana/app/service/role/internal/service"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+	"github.com/Fl0rencess720/Ayana/pkgs/metrics"
+	"github.com/Fl0rencess720/Ayana/pkgs/timeout"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
@@ -12,14 +17,30 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
+// generateTimeouts 生成角色要等模型输出完整的人设，只有这两个接口放宽超时
+var generateTimeouts = timeout.Operations{
+	v1.OperationRoleManagerGenerateRole:      60 * time.Second,
+	v1.OperationRoleManagerGenerateRolePanel: 60 * time.Second,
+}
+
 // NewGRPCServer new a gRPC server.
 func NewGRPCServer(c *conf.Server, role *service.RoleService, logger log.Logger) *grpc.Server {
+	// 与 kratos 未配置时的默认值一致
+	d := time.Second
+	if c.Grpc.Timeout != nil {
+		d = c.Grpc.Timeout.AsDuration()
+	}
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			tracing.Server(),
+			metrics.Server(),
+			identity.Server(),
 			ratelimit.Server(),
+			timeout.Middleware(d, generateTimeouts),
 		),
+		// 超时由 timeout 中间件按接口设置
+		grpc.Timeout(0),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -27,9 +48,6 @@ func NewGRPCServer(c *conf.Server, role *service.RoleService, logger log.Logger)
 	if c.Grpc.Addr != "" {
 		opts = append(opts, grpc.Address(c.Grpc.Addr))
 	}
-	if c.Grpc.Timeout != nil {
-		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
-	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterRoleManagerServer(srv, role)
 	return srv
@@ -5,28 +5,39 @@ import (
 
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/role/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type RoleService struct {
 	v1.UnimplementedRoleManagerServer
 
-	uc *biz.RoleUsecase
+	uc  *biz.RoleUsecase
+	cuc *biz.CredentialUsecase
+	muc *biz.ModelUsecase
 }
 
-func NewRoleService(uc *biz.RoleUsecase) *RoleService {
-	return &RoleService{uc: uc}
+func NewRoleService(uc *biz.RoleUsecase, cuc *biz.CredentialUsecase, muc *biz.ModelUsecase) *RoleService {
+	return &RoleService{uc: uc, cuc: cuc, muc: muc}
 }
 
 func (s *RoleService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest) (*v1.CreateRoleReply, error) {
-	uid, err := s.uc.CreateRole(ctx, req.Phone, biz.Role{
-		Uid:         req.Role.Uid,
-		RoleName:    req.Role.Name,
-		Description: req.Role.Description,
-		Avatar:      req.Role.Avatar,
-		ApiPath:     req.Role.ApiPath,
-		ApiKey:      req.Role.ApiKey,
-		ModelName:   req.Role.Model.Name,
-		Provider:    req.Role.Model.Provider,
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := s.uc.CreateRole(ctx, owner, biz.Role{
+		Uid:           req.Role.Uid,
+		RoleName:      req.Role.Name,
+		Description:   req.Role.Description,
+		Avatar:        req.Role.Avatar,
+		ApiPath:       req.Role.ApiPath,
+		ApiKey:        req.Role.ApiKey,
+		ModelName:     req.Role.Model.Name,
+		Provider:      req.Role.Model.Provider,
+		CredentialUid: req.Role.CredentialId,
 	})
 	if err != nil {
 		return nil, err
@@ -36,17 +47,32 @@ func (s *RoleService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest)
 	}, nil
 }
 func (s *RoleService) DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest) (*v1.DeleteRoleReply, error) {
-	if err := s.uc.DeleteRole(ctx, req.Phone, req.Uid); err != nil {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteRole(ctx, owner, req.Uid); err != nil {
 		return nil, err
 	}
 	return &v1.DeleteRoleReply{Message: "success"}, nil
 }
 func (s *RoleService) GetAvailableModels(ctx context.Context, req *v1.GetAvailableModelsRequest) (*v1.GetAvailableModelsReply, error) {
-
-	return nil, nil
+	models, err := s.muc.GetAvailableModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetAvailableModelsReply{}
+	for _, m := range models {
+		reply.Models = append(reply.Models, toModelReply(m))
+	}
+	return reply, nil
 }
 func (s *RoleService) GetRoles(ctx context.Context, req *v1.GetRolesRequest) (*v1.GetRolesReply, error) {
-	roles, err := s.uc.GetRoles(ctx, req.Phone)
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.uc.GetRoles(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -57,18 +83,27 @@ func (s *RoleService) GetRoles(ctx context.Context, req *v1.GetRolesRequest) (*v
 			Name:        role.RoleName,
 			Description: role.Description,
 			ApiPath:     role.ApiPath,
-			ApiKey:      role.ApiKey,
+			ApiKey:      role.ApiKeyMask,
 			Model: &v1.Model{
 				Name:     role.ModelName,
 				Provider: role.Provider,
 			},
+			CredentialId: role.CredentialUid,
+			Version:      role.Version,
+			Visibility:   role.Visibility,
+			ShareCode:    role.ShareCode,
+			ImportCount:  role.ImportCount,
 		})
 	}
 	return reply, nil
 }
 
 func (s *RoleService) GetModeratorAndParticipantsByUIDs(ctx context.Context, req *v1.GetModeratorAndParticipantsByUIDsRequest) (*v1.GetModeratorAndParticipantsByUIDsReply, error) {
-	moderator, roles, err := s.uc.GetModeratorAndParticipantsByUIDs(ctx, req.Phone, req.Moderator, req.Uids)
+	if req.Phone == "" {
+		return nil, identity.ErrUnauthenticated
+	}
+	owner := req.Phone
+	moderator, roles, err := s.uc.GetModeratorAndParticipantsByUIDs(ctx, owner, req.Moderator, req.Uids)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +119,9 @@ func (s *RoleService) GetModeratorAndParticipantsByUIDs(ctx context.Context, req
 				Name:     role.ModelName,
 				Provider: role.Provider,
 			},
+			CredentialId: role.CredentialUid,
+			Version:      role.Version,
+			System:       biz.IsSystemRoleUID(role.Uid),
 		})
 	}
 	reply.Moderator = &v1.Role{
@@ -96,20 +134,465 @@ func (s *RoleService) GetModeratorAndParticipantsByUIDs(ctx context.Context, req
 			Name:     moderator.ModelName,
 			Provider: moderator.Provider,
 		},
+		CredentialId: moderator.CredentialUid,
+		Version:      moderator.Version,
+		System:       biz.IsSystemRoleUID(moderator.Uid),
 	}
 	return reply, nil
 }
 func (s *RoleService) SetRole(ctx context.Context, req *v1.SetRoleRequest) (*v1.SetRoleReply, error) {
 
-	if err := s.uc.SetRole(ctx, req.Phone, req.Uid, biz.Role{RoleName: req.Role.Name,
-		Description: req.Role.Description,
-		Avatar:      req.Role.Avatar,
-		ApiPath:     req.Role.ApiPath,
-		ApiKey:      req.Role.ApiKey,
-		ModelName:   req.Role.Model.Name,
-		Provider:    req.Role.Model.Provider,
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.uc.SetRole(ctx, owner, req.Uid, biz.Role{RoleName: req.Role.Name,
+		Description:   req.Role.Description,
+		Avatar:        req.Role.Avatar,
+		ApiPath:       req.Role.ApiPath,
+		ApiKey:        req.Role.ApiKey,
+		ModelName:     req.Role.Model.Name,
+		Provider:      req.Role.Model.Provider,
+		CredentialUid: req.Role.CredentialId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetRoleReply{Message: "success", Version: version}, nil
+}
+
+func (s *RoleService) CreateCredential(ctx context.Context, req *v1.CreateCredentialRequest) (*v1.CreateCredentialReply, error) {
+	if req.Credential == nil {
+		return nil, status.Error(codes.InvalidArgument, "凭证不能为空")
+	}
+	owner, err := identity.Owner(ctx, workspace.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := s.cuc.CreateCredential(ctx, owner, biz.Credential{
+		Name:     req.Credential.Name,
+		Provider: req.Credential.Provider,
+		BaseURL:  req.Credential.BaseUrl,
+		ApiKey:   req.Credential.ApiKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateCredentialReply{Uid: uid}, nil
+}
+
+func (s *RoleService) GetCredentials(ctx context.Context, req *v1.GetCredentialsRequest) (*v1.GetCredentialsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := s.cuc.GetCredentials(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetCredentialsReply{}
+	for _, c := range credentials {
+		credential := toCredential(c)
+		credential.ApiKey = c.ApiKeyMask
+		reply.Credentials = append(reply.Credentials, credential)
+	}
+	return reply, nil
+}
+
+func (s *RoleService) SetCredential(ctx context.Context, req *v1.SetCredentialRequest) (*v1.SetCredentialReply, error) {
+	if req.Credential == nil {
+		return nil, status.Error(codes.InvalidArgument, "凭证不能为空")
+	}
+	owner, err := identity.Owner(ctx, workspace.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cuc.SetCredential(ctx, owner, req.Uid, biz.Credential{
+		Name:     req.Credential.Name,
+		Provider: req.Credential.Provider,
+		BaseURL:  req.Credential.BaseUrl,
+		ApiKey:   req.Credential.ApiKey,
 	}); err != nil {
 		return nil, err
 	}
-	return &v1.SetRoleReply{Message: "success"}, nil
+	return &v1.SetCredentialReply{Message: "success"}, nil
+}
+
+func (s *RoleService) DeleteCredential(ctx context.Context, req *v1.DeleteCredentialRequest) (*v1.DeleteCredentialReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cuc.DeleteCredential(ctx, owner, req.Uid); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCredentialReply{Message: "success"}, nil
+}
+
+// GetCredential 供 seminar 服务构造模型和测试连接使用，密钥保持密文
+func (s *RoleService) GetCredential(ctx context.Context, req *v1.GetCredentialRequest) (*v1.GetCredentialReply, error) {
+	if req.Phone == "" {
+		return nil, identity.ErrUnauthenticated
+	}
+	owner := req.Phone
+	c, err := s.cuc.GetCredential(ctx, owner, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	credential := toCredential(c)
+	credential.ApiKey = c.ApiKey
+	return &v1.GetCredentialReply{Credential: credential}, nil
+}
+
+func (s *RoleService) ReportCredentialUsage(ctx context.Context, req *v1.ReportCredentialUsageRequest) (*v1.ReportCredentialUsageReply, error) {
+	if err := s.cuc.ReportUsage(ctx, req.Uid, req.Error); err != nil {
+		return nil, err
+	}
+	return &v1.ReportCredentialUsageReply{}, nil
+}
+
+func toCredential(c biz.Credential) *v1.Credential {
+	credential := &v1.Credential{
+		Uid:       c.Uid,
+		Name:      c.Name,
+		Provider:  c.Provider,
+		BaseUrl:   c.BaseURL,
+		LastError: c.LastError,
+	}
+	if c.LastUsedAt != nil {
+		credential.LastUsedAt = c.LastUsedAt.Unix()
+	}
+	if c.LastErrorAt != nil {
+		credential.LastErrorAt = c.LastErrorAt.Unix()
+	}
+	return credential
+}
+
+func (s *RoleService) GetSystemRoles(ctx context.Context, req *v1.GetSystemRolesRequest) (*v1.GetSystemRolesReply, error) {
+	reply := &v1.GetSystemRolesReply{}
+	for _, role := range s.uc.GetSystemRoles(ctx, req.Kind) {
+		reply.Roles = append(reply.Roles, toSystemRole(role))
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetSystemRole(ctx context.Context, req *v1.GetSystemRoleRequest) (*v1.GetSystemRoleReply, error) {
+	role, err := s.uc.GetSystemRole(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetSystemRoleReply{Role: toSystemRole(role)}, nil
+}
+
+func (s *RoleService) CloneSystemRole(ctx context.Context, req *v1.CloneSystemRoleRequest) (*v1.CloneSystemRoleReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := s.uc.CloneSystemRole(ctx, owner, req.Uid, req.Name, req.CredentialId, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CloneSystemRoleReply{Uid: uid}, nil
+}
+
+func toSystemRole(role biz.SystemRole) *v1.SystemRole {
+	return &v1.SystemRole{
+		Role: &v1.Role{
+			Uid:         role.Uid,
+			Name:        role.Name,
+			Description: role.Description,
+			Avatar:      role.Avatar,
+			Model: &v1.Model{
+				Name:     role.ModelName,
+				Provider: role.Provider,
+			},
+		},
+		Kind: role.Kind,
+		Tags: role.Tags,
+	}
+}
+
+// fromSystemRole 管理员提交的系统角色，缺少角色或模型时返回空角色交给业务层校验
+func fromSystemRole(r *v1.SystemRole) biz.SystemRole {
+	if r == nil || r.Role == nil {
+		return biz.SystemRole{}
+	}
+	role := biz.SystemRole{
+		Uid:         r.Role.Uid,
+		Kind:        r.Kind,
+		Name:        r.Role.Name,
+		Description: r.Role.Description,
+		Avatar:      r.Role.Avatar,
+		Tags:        r.Tags,
+	}
+	if r.Role.Model != nil {
+		role.Provider, role.ModelName = r.Role.Model.Provider, r.Role.Model.Name
+	}
+	return role
+}
+
+func (s *RoleService) AdminCreateSystemRole(ctx context.Context, req *v1.AdminCreateSystemRoleRequest) (*v1.AdminCreateSystemRoleReply, error) {
+	uid, err := s.uc.CreateSystemRole(ctx, fromSystemRole(req.Role))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminCreateSystemRoleReply{Uid: uid}, nil
+}
+
+func (s *RoleService) AdminSetSystemRole(ctx context.Context, req *v1.AdminSetSystemRoleRequest) (*v1.AdminSetSystemRoleReply, error) {
+	if err := s.uc.SetSystemRole(ctx, fromSystemRole(req.Role)); err != nil {
+		return nil, err
+	}
+	return &v1.AdminSetSystemRoleReply{Message: "success"}, nil
+}
+
+func (s *RoleService) AdminDeleteSystemRole(ctx context.Context, req *v1.AdminDeleteSystemRoleRequest) (*v1.AdminDeleteSystemRoleReply, error) {
+	if err := s.uc.DeleteSystemRole(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+	return &v1.AdminDeleteSystemRoleReply{Message: "success"}, nil
+}
+
+func (s *RoleService) PublishRole(ctx context.Context, req *v1.PublishRoleRequest) (*v1.PublishRoleReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	shareCode, err := s.uc.PublishRole(ctx, owner, req.Uid, req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PublishRoleReply{ShareCode: shareCode}, nil
+}
+
+func (s *RoleService) UnpublishRole(ctx context.Context, req *v1.UnpublishRoleRequest) (*v1.UnpublishRoleReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.UnpublishRole(ctx, owner, req.Uid); err != nil {
+		return nil, err
+	}
+	return &v1.UnpublishRoleReply{Message: "success"}, nil
+}
+
+func (s *RoleService) GetSharedRoles(ctx context.Context, req *v1.GetSharedRolesRequest) (*v1.GetSharedRolesReply, error) {
+	roles, total, err := s.uc.GetSharedRoles(ctx, req.Keyword, req.Order, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetSharedRolesReply{Total: total}
+	for _, role := range roles {
+		reply.Roles = append(reply.Roles, toSharedRole(role))
+	}
+	return reply, nil
+}
+
+func (s *RoleService) GetSharedRole(ctx context.Context, req *v1.GetSharedRoleRequest) (*v1.GetSharedRoleReply, error) {
+	role, err := s.uc.GetSharedRole(ctx, req.ShareCode)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetSharedRoleReply{Role: toSharedRole(role)}, nil
+}
+
+func (s *RoleService) ImportSharedRole(ctx context.Context, req *v1.ImportSharedRoleRequest) (*v1.ImportSharedRoleReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := s.uc.ImportSharedRole(ctx, owner, req.ShareCode, req.Name, req.CredentialId, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ImportSharedRoleReply{Uid: uid}, nil
+}
+
+// toSharedRole 只暴露可以公开的字段，不包含作者、地址和密钥
+func toSharedRole(role biz.Role) *v1.SharedRole {
+	shared := &v1.SharedRole{
+		ShareCode:   role.ShareCode,
+		Name:        role.RoleName,
+		Description: role.Description,
+		Avatar:      role.Avatar,
+		Model: &v1.Model{
+			Name:     role.ModelName,
+			Provider: role.Provider,
+		},
+		ImportCount: role.ImportCount,
+	}
+	if role.PublishedAt != nil {
+		shared.PublishedAt = role.PublishedAt.Unix()
+	}
+	return shared
+}
+
+func (s *RoleService) GetRoleVersions(ctx context.Context, req *v1.GetRoleVersionsRequest) (*v1.GetRoleVersionsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.uc.GetRoleVersions(ctx, owner, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetRoleVersionsReply{}
+	for _, v := range versions {
+		reply.Versions = append(reply.Versions, &v1.RoleVersion{
+			Version:     v.Version,
+			Name:        v.RoleName,
+			Description: v.Description,
+			Avatar:      v.Avatar,
+			Model: &v1.Model{
+				Name:     v.ModelName,
+				Provider: v.Provider,
+			},
+			CreatedAt: v.CreatedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *RoleService) DiffRoleVersions(ctx context.Context, req *v1.DiffRoleVersionsRequest) (*v1.DiffRoleVersionsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.uc.DiffRoleVersions(ctx, owner, req.Uid, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.DiffRoleVersionsReply{}
+	for _, c := range changes {
+		reply.Changes = append(reply.Changes, &v1.FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+	return reply, nil
+}
+
+func (s *RoleService) RollbackRole(ctx context.Context, req *v1.RollbackRoleRequest) (*v1.RollbackRoleReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.uc.RollbackRole(ctx, owner, req.Uid, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RollbackRoleReply{Version: version}, nil
+}
+
+func (s *RoleService) GetModels(ctx context.Context, req *v1.GetModelsRequest) (*v1.GetModelsReply, error) {
+	models, err := s.muc.GetModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetModelsReply{}
+	for _, m := range models {
+		reply.Models = append(reply.Models, toModelReply(m))
+	}
+	return reply, nil
+}
+
+func (s *RoleService) CreateModel(ctx context.Context, req *v1.CreateModelRequest) (*v1.CreateModelReply, error) {
+	if req.Model == nil {
+		return nil, status.Error(codes.InvalidArgument, "模型不能为空")
+	}
+	if err := s.muc.CreateModel(ctx, toModel(req.Model)); err != nil {
+		return nil, err
+	}
+	return &v1.CreateModelReply{Message: "success"}, nil
+}
+
+func (s *RoleService) SetModel(ctx context.Context, req *v1.SetModelRequest) (*v1.SetModelReply, error) {
+	if req.Model == nil {
+		return nil, status.Error(codes.InvalidArgument, "模型不能为空")
+	}
+	if err := s.muc.SetModel(ctx, req.Model.Provider, req.Model.Name, toModel(req.Model)); err != nil {
+		return nil, err
+	}
+	return &v1.SetModelReply{Message: "success"}, nil
+}
+
+func (s *RoleService) DeleteModel(ctx context.Context, req *v1.DeleteModelRequest) (*v1.DeleteModelReply, error) {
+	if err := s.muc.DeleteModel(ctx, req.Provider, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteModelReply{Message: "success"}, nil
+}
+
+func (s *RoleService) GetModel(ctx context.Context, req *v1.GetModelRequest) (*v1.GetModelReply, error) {
+	model, err := s.muc.GetModel(ctx, req.Provider, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetModelReply{Model: toModelReply(model)}, nil
+}
+
+func toModel(m *v1.Model) biz.AIModel {
+	return biz.AIModel{
+		Provider:                m.Provider,
+		ModelName:               m.Name,
+		DisplayName:             m.DisplayName,
+		ContextLength:           m.ContextLength,
+		MaxOutput:               m.MaxOutput,
+		SupportsTools:           m.SupportsTools,
+		SupportsReasoningStream: m.SupportsReasoningStream,
+		SupportsVision:          m.SupportsVision,
+		Enabled:                 m.Enabled,
+	}
+}
+
+func toModelReply(m biz.AIModel) *v1.Model {
+	return &v1.Model{
+		Provider:                m.Provider,
+		Name:                    m.ModelName,
+		DisplayName:             m.DisplayName,
+		ContextLength:           m.ContextLength,
+		MaxOutput:               m.MaxOutput,
+		SupportsTools:           m.SupportsTools,
+		SupportsReasoningStream: m.SupportsReasoningStream,
+		SupportsVision:          m.SupportsVision,
+		Enabled:                 m.Enabled,
+	}
+}
+
+func (s *RoleService) GenerateRole(ctx context.Context, req *v1.GenerateRoleRequest) (*v1.GenerateRoleReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.uc.GenerateRole(ctx, caller, req.Brief)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GenerateRoleReply{Role: toGeneratedRoleReply(role)}, nil
+}
+
+func (s *RoleService) GenerateRolePanel(ctx context.Context, req *v1.GenerateRolePanelRequest) (*v1.GenerateRolePanelReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.uc.GenerateRolePanel(ctx, caller, req.Topic, int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GenerateRolePanelReply{}
+	for _, role := range roles {
+		reply.Roles = append(reply.Roles, toGeneratedRoleReply(role))
+	}
+	return reply, nil
+}
+
+func toGeneratedRoleReply(role biz.GeneratedRole) *v1.GeneratedRole {
+	return &v1.GeneratedRole{
+		Role: &v1.Role{
+			Name:        role.RoleName,
+			Description: role.Description,
+			Model:       &v1.Model{Provider: role.Provider, Name: role.ModelName},
+		},
+		SpeakingStyle: role.SpeakingStyle,
+		Stance:        role.Stance,
+	}
 }
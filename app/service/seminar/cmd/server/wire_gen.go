@@ -23,17 +23,17 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confService *conf.Service, confData *conf.Data, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confService *conf.Service, confData *conf.Data, usage *conf.Usage, secret *conf.Secret, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewMysql(confData)
 	client := data.NewRedis(confData)
 	milvusclientClient := data.NewMilvus(confData)
-	kafkaClient := data.NewKafkaClient(confData)
+	broker := data.NewBroker(confData, client)
 	discovery := server.NewDiscovery(registry)
 	roleManagerClient := data.NewRoleServiceClient(confService, discovery)
 	embedder := data.NewEmbedder(confData)
 	hybridIndexer := data.NewIndexer(milvusclientClient, embedder)
 	hybridRetriever := data.NewRetriever(milvusclientClient, embedder)
-	dataData, cleanup, err := data.NewData(confData, logger, db, client, milvusclientClient, kafkaClient, roleManagerClient, embedder, hybridIndexer, hybridRetriever)
+	dataData, cleanup, err := data.NewData(confData, logger, db, client, milvusclientClient, broker, roleManagerClient, embedder, hybridIndexer, hybridRetriever)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,13 +41,21 @@ func wireApp(confServer *conf.Server, confService *conf.Service, confData *conf.
 	broadcastRepo := data.NewBroadcastRepo(dataData, logger)
 	topicCache := biz.NewTopicCache()
 	roleCache := biz.NewRoleCache()
-	seminarUsecase := biz.NewSeminarUsecase(seminarRepo, broadcastRepo, topicCache, roleCache, roleManagerClient, logger)
+	usageRepo := data.NewUsageRepo(dataData, logger)
+	usageUsecase := biz.NewUsageUsecase(usage, usageRepo, logger)
+	keyring, err := data.NewKeyring(secret)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	seminarUsecase := biz.NewSeminarUsecase(seminarRepo, broadcastRepo, topicCache, roleCache, roleManagerClient, usageUsecase, keyring, logger)
 	ragRepo := data.NewRAGRepo(dataData, logger)
-	ragUsecase := biz.NewRAGUsecase(ragRepo, logger)
-	seminarService := service.NewSeminarService(seminarUsecase, ragUsecase)
+	ragUsecase := biz.NewRAGUsecase(ragRepo, usageUsecase, logger)
+	seminarService := service.NewSeminarService(seminarUsecase, ragUsecase, usageUsecase)
 	grpcServer := server.NewGRPCServer(confServer, seminarService, logger)
+	httpServer := server.NewHTTPServer(confServer, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	app := newApp(logger, grpcServer, httpServer, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
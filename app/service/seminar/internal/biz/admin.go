@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RunningTopic 尚未结束的一次讨论运行
+type RunningTopic struct {
+	TopicUID  string
+	RunUID    string
+	Phone     string
+	Content   string
+	StartedAt time.Time
+}
+
+// GetRunningTopics 列出正在运行的讨论，phone 为空时返回所有用户的。
+// 服务实例崩溃时运行记录不会结束，因此只返回仍被某个实例认领的讨论
+func (uc *SeminarUsecase) GetRunningTopics(ctx context.Context, phone string) ([]RunningTopic, error) {
+	runs, err := uc.repo.GetUnfinishedTopicRuns(ctx, phone)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]RunningTopic, 0, len(runs))
+	for _, run := range runs {
+		owner, err := uc.brepo.GetTopicOwner(ctx, run.TopicUID)
+		if err != nil {
+			zap.L().Error("get topic owner failed", zap.String("topic", run.TopicUID), zap.Error(err))
+			continue
+		}
+		if owner != "" {
+			topics = append(topics, run)
+		}
+	}
+	return topics, nil
+}
+
+// ForceStopTopic 管理员暂停任意用户的讨论，不检查所有者
+func (uc *SeminarUsecase) ForceStopTopic(ctx context.Context, topicUID string) error {
+	if _, err := uc.repo.GetTopic(ctx, topicUID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTopicNotFound
+		}
+		return err
+	}
+	return uc.sendCommand(ctx, topicUID, CommandPause, "")
+}
@@ -15,10 +15,16 @@ type TokenMessage struct {
 }
 
 type BroadcastRepo interface {
-	SendTokenToKafka(ctx context.Context, topic string, message *TokenMessage) error
-	SendTokensToKafkaBatch(ctx context.Context, topicUID string, tokens []*TokenMessage) error
-	AddTopicPauseChannel(ctx context.Context, topicUID string, pauseChan chan StateSignal) error
-	DeleteTopicPauseContext(ctx context.Context, topicUID string) error
-	SendPauseSignalToKafka(ctx context.Context, topic string) error
-	ReadPauseSignalFromKafka(ctx context.Context) error
+	SendToken(ctx context.Context, topic string, message *TokenMessage) error
+	SendTokensBatch(ctx context.Context, topicUID string, tokens []*TokenMessage) error
+	// ClaimTopic 登记当前实例为讨论的运行者并维持心跳，讨论已在任何实例（包括当前实例）运行时返回错误。
+	// 返回本次登记的 ID，以及在心跳发现归属已失去时关闭的 channel
+	ClaimTopic(ctx context.Context, topicUID string) (string, <-chan struct{}, error)
+	// ReleaseTopic 释放 ClaimTopic 返回的那次登记
+	ReleaseTopic(ctx context.Context, topicUID, claimID string) error
+	// GetTopicOwner 返回正在运行讨论的实例，讨论未运行时返回空字符串
+	GetTopicOwner(ctx context.Context, topicUID string) (string, error)
+	SendCommand(ctx context.Context, cmd *TopicCommand) (*CommandAck, error)
+	// ReadCommands 读取命令和确认直到订阅失败，由调用方负责重试
+	ReadCommands(ctx context.Context, handler CommandHandler) error
 }
@@ -0,0 +1,168 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/broadcast"
+	"github.com/cloudwego/eino/schema"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CommandType string
+
+const (
+	CommandPause     CommandType = "pause"
+	CommandInterject CommandType = "interject"
+)
+
+// TopicCommand 通过命令总线路由到正在运行该讨论的实例
+type TopicCommand struct {
+	ID       string      `json:"id"`
+	TopicUID string      `json:"topic_uid"`
+	Target   string      `json:"target"`
+	Type     CommandType `json:"type"`
+	Content  string      `json:"content"`
+}
+
+type CommandAck struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+type CommandHandler func(ctx context.Context, cmd *TopicCommand) error
+
+var (
+	ErrTopicNotRunning = status.Error(codes.FailedPrecondition, "讨论未在进行中")
+	ErrTopicRunning    = status.Error(codes.AlreadyExists, "讨论已在进行中")
+)
+
+func (uc *SeminarUsecase) addRunningTopic(topicUID string, rs *RoleScheduler) error {
+	uc.rmu.Lock()
+	defer uc.rmu.Unlock()
+	if _, ok := uc.running[topicUID]; ok {
+		return ErrTopicRunning
+	}
+	uc.running[topicUID] = rs
+	activeTopics.Set(float64(len(uc.running)))
+	return nil
+}
+
+// removeRunningTopic 只移除 rs 自己的记录
+func (uc *SeminarUsecase) removeRunningTopic(topicUID string, rs *RoleScheduler) {
+	uc.rmu.Lock()
+	if uc.running[topicUID] == rs {
+		delete(uc.running, topicUID)
+	}
+	activeTopics.Set(float64(len(uc.running)))
+	uc.rmu.Unlock()
+}
+
+func (uc *SeminarUsecase) getRunningTopic(topicUID string) (*RoleScheduler, bool) {
+	uc.rmu.RLock()
+	defer uc.rmu.RUnlock()
+	rs, ok := uc.running[topicUID]
+	return rs, ok
+}
+
+// sendCommand 查询讨论当前所属的实例并把命令发送给它，等待对方确认
+func (uc *SeminarUsecase) sendCommand(ctx context.Context, topicUID string, typ CommandType, content string) error {
+	owner, err := uc.brepo.GetTopicOwner(ctx, topicUID)
+	if err != nil {
+		return err
+	}
+	if owner == "" {
+		return ErrTopicNotRunning
+	}
+	ack, err := uc.brepo.SendCommand(ctx, &TopicCommand{
+		ID:       uuid.NewString(),
+		TopicUID: topicUID,
+		Target:   owner,
+		Type:     typ,
+		Content:  content,
+	})
+	if err != nil {
+		return err
+	}
+	if ack.Error != "" {
+		return status.Error(codes.FailedPrecondition, ack.Error)
+	}
+	return nil
+}
+
+// readCommands 命令订阅失败后按退避间隔重新订阅，否则暂停和插话会一直不可用
+func (uc *SeminarUsecase) readCommands(ctx context.Context) {
+	backoff := broadcast.NewBackoff()
+	for {
+		start := time.Now()
+		err := uc.brepo.ReadCommands(ctx, uc.handleCommand)
+		if ctx.Err() != nil {
+			return
+		}
+		zap.L().Error("read topic commands failed, resubscribing", zap.Error(err))
+		// 订阅正常运行过一段时间后再失败，从最短的间隔开始重试
+		if time.Since(start) > backoff.Max {
+			backoff.Reset()
+		}
+		if backoff.Wait(ctx) != nil {
+			return
+		}
+	}
+}
+
+// stopOnOwnershipLost 归属被其他实例取得后暂停本实例上的运行，避免同一讨论在两个实例上同时进行
+func (uc *SeminarUsecase) stopOnOwnershipLost(topic *Topic, lost <-chan struct{}, done <-chan struct{}) {
+	select {
+	case <-lost:
+		zap.L().Warn("stop topic run after losing ownership", zap.String("topic", topic.UID))
+		select {
+		case topic.signalChan <- Pause:
+		default:
+		}
+	case <-done:
+	}
+}
+
+// handleCommand 在讨论所属实例上执行命令
+func (uc *SeminarUsecase) handleCommand(ctx context.Context, cmd *TopicCommand) error {
+	rs, ok := uc.getRunningTopic(cmd.TopicUID)
+	if !ok {
+		return ErrTopicNotRunning
+	}
+	switch cmd.Type {
+	case CommandPause:
+		select {
+		case rs.topic.signalChan <- Pause:
+		default:
+			// 已经有未处理的暂停信号
+		}
+	case CommandInterject:
+		rs.AddInterjection(cmd.Content)
+	default:
+		return fmt.Errorf("unknown command type: %s", cmd.Type)
+	}
+	zap.L().Info("topic command handled", zap.String("topic", cmd.TopicUID), zap.String("type", string(cmd.Type)))
+	return nil
+}
+
+func (rs *RoleScheduler) AddInterjection(content string) {
+	rs.imu.Lock()
+	rs.interjections = append(rs.interjections, &schema.Message{
+		Role:    schema.User,
+		Content: "@用户:" + content,
+	})
+	rs.imu.Unlock()
+}
+
+// takeInterjections 取出尚未加入对话历史的用户插话
+func (rs *RoleScheduler) takeInterjections() []*schema.Message {
+	rs.imu.Lock()
+	defer rs.imu.Unlock()
+	msgs := rs.interjections
+	rs.interjections = nil
+	return msgs
+}
@@ -0,0 +1,69 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	"go.uber.org/zap"
+)
+
+const credentialTestTimeout = 10 * time.Second
+
+// CredentialTestResult 凭证连接测试结果，连接失败不作为接口错误返回
+type CredentialTestResult struct {
+	OK      bool
+	Message string
+	Latency time.Duration
+}
+
+// TestCredential 用凭证请求一次模型列表，检查地址和密钥是否可用
+func (uc *SeminarUsecase) TestCredential(ctx context.Context, phone, uid string) (*CredentialTestResult, error) {
+	reply, err := uc.roleClient.GetCredential(ctx, &roleV1.GetCredentialRequest{Phone: phone, Uid: uid})
+	if err != nil {
+		return nil, err
+	}
+	credential := reply.Credential
+	apiKey, err := uc.keyring.Decrypt(credential.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, credentialTestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, strings.TrimRight(credential.BaseUrl, "/")+"/models", nil)
+	if err != nil {
+		return &CredentialTestResult{Message: err.Error()}, nil
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	result := &CredentialTestResult{}
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Message = err.Error()
+	} else {
+		defer resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			result.OK = true
+			result.Message = "连接成功"
+		} else {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			result.Message = fmt.Sprintf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+	}
+
+	report := &roleV1.ReportCredentialUsageRequest{Uid: uid}
+	if !result.OK {
+		report.Error = result.Message
+	}
+	if _, err := uc.roleClient.ReportCredentialUsage(ctx, report); err != nil {
+		zap.L().Warn("report credential usage failed", zap.String("credential", uid), zap.Error(err))
+	}
+	return result, nil
+}
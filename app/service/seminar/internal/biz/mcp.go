@@ -2,10 +2,18 @@ package biz
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
+	"github.com/cloudwego/eino-ext/components/model/deepseek"
 	mcpp "github.com/cloudwego/eino-ext/components/tool/mcp"
+	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/schema"
 	"github.com/mark3labs/mcp-go/client"
@@ -56,54 +64,329 @@ func checkMCPServerHealthByPing(mcpServer MCPServer) (int32, error) {
 	return 0, nil
 }
 
-func getHealthyMCPServers(ctx context.Context, mcpServers []MCPServer) ([]tool.BaseTool, []*schema.ToolInfo, error) {
+// parseRequestHeader 解析用户配置的请求头，支持 JSON 对象或每行一个 "Key: Value"
+func parseRequestHeader(raw string) map[string]string {
+	headers := map[string]string{}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return headers
+	}
+	if err := json.Unmarshal([]byte(raw), &headers); err == nil {
+		return headers
+	}
+	for _, line := range strings.Split(raw, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return headers
+}
+
+// connectMCPServer 解密请求头并完成 MCP 初始化握手
+func connectMCPServer(ctx context.Context, keyring *secret.Keyring, mcpServer MCPServer) (*client.Client, error) {
+	requestHeader, err := keyring.Decrypt(mcpServer.RequestHeader)
+	if err != nil {
+		zap.L().Error("failed to decrypt MCP request header", zap.String("uid", mcpServer.UID), zap.Error(err))
+		return nil, err
+	}
+	cli, err := client.NewSSEMCPClient(mcpServer.URL, client.WithHeaders(parseRequestHeader(requestHeader)))
+	if err != nil {
+		zap.L().Error("failed to create MCP client", zap.Error(err))
+		return nil, err
+	}
+
+	if err = cli.Start(ctx); err != nil {
+		zap.L().Error("failed to start MCP client", zap.Error(err))
+		return nil, err
+	}
+
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{
+		Name:    "eino-llm-app",
+		Version: "1.0.0",
+	}
+
+	_, err = cli.Initialize(ctx, initRequest)
+	if err != nil {
+		zap.L().Error("failed to initialize MCP client", zap.Error(err))
+		cli.Close()
+		return nil, err
+	}
+	return cli, nil
+}
+
+func getHealthyMCPServers(ctx context.Context, keyring *secret.Keyring, mcpServers []MCPServer) ([]tool.BaseTool, []*schema.ToolInfo, error) {
 	tools := []tool.BaseTool{}
 	toolsInfo := []*schema.ToolInfo{}
 	for _, mcpServer := range mcpServers {
 		if mcpServer.Status == 0 {
 			continue
 		}
-		cli, err := client.NewSSEMCPClient(mcpServer.URL)
+		cli, err := connectMCPServer(ctx, keyring, mcpServer)
 		if err != nil {
-			zap.L().Error("failed to create MCP client", zap.Error(err))
 			continue
 		}
 
-		if err = cli.Start(ctx); err != nil {
-			zap.L().Error("failed to start MCP client", zap.Error(err))
-			continue
-		}
-
-		initRequest := mcp.InitializeRequest{}
-		initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
-		initRequest.Params.ClientInfo = mcp.Implementation{
-			Name:    "eino-llm-app",
-			Version: "1.0.0",
-		}
-
-		_, err = cli.Initialize(ctx, initRequest)
-		if err != nil {
-			fmt.Printf("err: %v\n", err)
-			zap.L().Error("failed to initialize MCP client", zap.Error(err))
-			continue
-		}
-
-		tools, err := mcpp.GetTools(ctx, &mcpp.Config{
+		serverTools, err := mcpp.GetTools(ctx, &mcpp.Config{
 			Cli: cli,
 		})
 		if err != nil {
 			fmt.Printf("err: %v\n", err)
 			zap.L().Error("failed to get tools", zap.Error(err))
 		}
-		for _, tool := range tools {
-			t, err := tool.Info(ctx)
+		for _, tl := range serverTools {
+			t, err := tl.Info(ctx)
 			if err != nil {
 				zap.L().Error("failed to get tool info", zap.Error(err))
 				continue
 			}
-			tools = append(tools, tool)
+			if invokable, ok := tl.(tool.InvokableTool); ok {
+				tl = &instrumentedTool{InvokableTool: invokable, name: t.Name}
+			}
+			tools = append(tools, tl)
 			toolsInfo = append(toolsInfo, t)
 		}
 	}
 	return tools, toolsInfo, nil
 }
+
+// listMCPServerTools 列出服务器提供的工具，用完即关闭连接
+func listMCPServerTools(ctx context.Context, keyring *secret.Keyring, mcpServer MCPServer) ([]*schema.ToolInfo, error) {
+	cli, err := connectMCPServer(ctx, keyring, mcpServer)
+	if err != nil {
+		return nil, ErrMCPServerUnavailable
+	}
+	defer cli.Close()
+
+	serverTools, err := mcpp.GetTools(ctx, &mcpp.Config{Cli: cli})
+	if err != nil {
+		zap.L().Error("failed to get tools", zap.Error(err))
+		return nil, ErrMCPServerUnavailable
+	}
+	toolsInfo := make([]*schema.ToolInfo, 0, len(serverTools))
+	for _, tl := range serverTools {
+		info, err := tl.Info(ctx)
+		if err != nil {
+			zap.L().Error("failed to get tool info", zap.Error(err))
+			continue
+		}
+		toolsInfo = append(toolsInfo, info)
+	}
+	return toolsInfo, nil
+}
+
+// instrumentedTool 记录 MCP 工具调用的耗时和失败次数
+type instrumentedTool struct {
+	tool.InvokableTool
+	name string
+}
+
+func (t *instrumentedTool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
+	start := time.Now()
+	result, err := t.InvokableTool.InvokableRun(ctx, argumentsInJSON, opts...)
+	mcpToolCallSeconds.WithLabelValues(t.name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		mcpToolCallFailures.WithLabelValues(t.name).Inc()
+	}
+	return result, err
+}
+
+// selectMCPServers 只保留讨论选择的服务器，未做过选择的旧讨论沿用全部服务器
+func selectMCPServers(servers []MCPServer, selected []string) []MCPServer {
+	if selected == nil {
+		return servers
+	}
+	picked := make(map[string]struct{}, len(selected))
+	for _, uid := range selected {
+		picked[uid] = struct{}{}
+	}
+	result := []MCPServer{}
+	for _, server := range servers {
+		if _, ok := picked[server.UID]; ok {
+			result = append(result, server)
+		}
+	}
+	return result
+}
+
+// filterTools 按工具名白名单过滤，tools 和 toolsInfo 按下标一一对应
+func filterTools(tools []tool.BaseTool, toolsInfo []*schema.ToolInfo, allow []string) ([]tool.BaseTool, []*schema.ToolInfo) {
+	allowed := make(map[string]struct{}, len(allow))
+	for _, name := range allow {
+		allowed[name] = struct{}{}
+	}
+	filtered, filteredInfo := []tool.BaseTool{}, []*schema.ToolInfo{}
+	for i, info := range toolsInfo {
+		if _, ok := allowed[info.Name]; ok {
+			filtered = append(filtered, tools[i])
+			filteredInfo = append(filteredInfo, info)
+		}
+	}
+	return filtered, filteredInfo
+}
+
+// toolsFor 返回角色在当前讨论中可以使用的工具，模型不支持工具调用时返回空
+func (rs *RoleScheduler) toolsFor(role *Role) ([]tool.BaseTool, []*schema.ToolInfo) {
+	if !role.Capability.SupportsTools {
+		return []tool.BaseTool{}, []*schema.ToolInfo{}
+	}
+	allow, ok := rs.roleTools[role.Uid]
+	if !ok {
+		return rs.mcpTools, rs.mcpToolsInfo
+	}
+	return filterTools(rs.mcpTools, rs.mcpToolsInfo, allow)
+}
+
+// roleChatModel 按当前发言角色选择它自己的模型实例，并传入该角色允许的工具
+type roleChatModel struct {
+	models map[string]*deepseek.ChatModel
+	rs     *RoleScheduler
+}
+
+func (m *roleChatModel) model() (*deepseek.ChatModel, error) {
+	cm, ok := m.models[m.rs.current.Uid]
+	if !ok {
+		return nil, fmt.Errorf("no chat model for role %s", m.rs.current.Uid)
+	}
+	return cm, nil
+}
+
+// maxToolRounds 一次发言中模型连续调用工具的最大轮数
+const maxToolRounds = 5
+
+// roundUsage 汇总一次发言中多轮工具调用的用量。每轮流式返回的 usage 是该轮的累计值，
+// 轮内取最大值，转发给下游前再加上之前各轮的总量，下游取到的最大值就是整次发言的用量
+type roundUsage struct {
+	done  schema.TokenUsage
+	round schema.TokenUsage
+}
+
+func (u *roundUsage) add(chunk *schema.Message) *schema.Message {
+	if chunk == nil || chunk.ResponseMeta == nil || chunk.ResponseMeta.Usage == nil {
+		return chunk
+	}
+	cur := chunk.ResponseMeta.Usage
+	u.round.PromptTokens = max(u.round.PromptTokens, cur.PromptTokens)
+	u.round.CompletionTokens = max(u.round.CompletionTokens, cur.CompletionTokens)
+	u.round.TotalTokens = max(u.round.TotalTokens, cur.TotalTokens)
+	if u.done == (schema.TokenUsage{}) {
+		return chunk
+	}
+	meta := *chunk.ResponseMeta
+	meta.Usage = &schema.TokenUsage{
+		PromptTokens:     u.done.PromptTokens + cur.PromptTokens,
+		CompletionTokens: u.done.CompletionTokens + cur.CompletionTokens,
+		TotalTokens:      u.done.TotalTokens + cur.TotalTokens,
+	}
+	out := *chunk
+	out.ResponseMeta = &meta
+	return &out
+}
+
+// next 结束当前轮，把该轮的用量计入总量
+func (u *roundUsage) next() {
+	u.done.PromptTokens += u.round.PromptTokens
+	u.done.CompletionTokens += u.round.CompletionTokens
+	u.done.TotalTokens += u.round.TotalTokens
+	u.round = schema.TokenUsage{}
+}
+
+// Generate 模型要求调用工具时执行白名单内的工具，把结果交给模型继续生成
+func (m *roleChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	cm, err := m.model()
+	if err != nil {
+		return nil, err
+	}
+	tools, toolsInfo := m.rs.toolsFor(m.rs.current)
+	opts = append(opts, model.WithTools(toolsInfo))
+	messages := slices.Clone(input)
+	var usage roundUsage
+	for round := 0; ; round++ {
+		output, err := cm.Generate(ctx, messages, opts...)
+		if err != nil {
+			return nil, err
+		}
+		output = usage.add(output)
+		if len(output.ToolCalls) == 0 || round == maxToolRounds {
+			return output, nil
+		}
+		usage.next()
+		messages = append(messages, output)
+		messages = append(messages, invoke(ctx, output.ToolCalls, tools)...)
+	}
+}
+
+// Stream 与 Generate 相同，工具调用的分片不转发给下游，下游只收到内容和用量
+func (m *roleChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	cm, err := m.model()
+	if err != nil {
+		return nil, err
+	}
+	tools, toolsInfo := m.rs.toolsFor(m.rs.current)
+	opts = append(opts, model.WithTools(toolsInfo))
+	stream, err := cm.Stream(ctx, input, opts...)
+	if err != nil || len(toolsInfo) == 0 {
+		return stream, err
+	}
+
+	sr, sw := schema.Pipe[*schema.Message](10)
+	go func() {
+		defer sw.Close()
+		messages := slices.Clone(input)
+		var usage roundUsage
+		for round := 0; ; round++ {
+			toolCall, err := forwardContent(stream, sw, &usage)
+			if err != nil {
+				sw.Send(nil, err)
+				return
+			}
+			if toolCall == nil {
+				return
+			}
+			if round == maxToolRounds {
+				zap.L().Warn("too many tool rounds", zap.String("role", m.rs.current.Uid))
+				return
+			}
+			usage.next()
+			messages = append(messages, toolCall)
+			messages = append(messages, invoke(ctx, toolCall.ToolCalls, tools)...)
+			if stream, err = cm.Stream(ctx, messages, opts...); err != nil {
+				sw.Send(nil, err)
+				return
+			}
+		}
+	}()
+	return sr, nil
+}
+
+// forwardContent 转发一轮输出，返回合并后的工具调用消息，没有工具调用或下游已关闭时返回 nil
+func forwardContent(stream *schema.StreamReader[*schema.Message], sw *schema.StreamWriter[*schema.Message], usage *roundUsage) (*schema.Message, error) {
+	defer stream.Close()
+	var toolChunks []*schema.Message
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(chunk.ToolCalls) > 0 {
+			toolChunks = append(toolChunks, chunk)
+			if chunk.ResponseMeta == nil {
+				continue
+			}
+			chunk = &schema.Message{Role: schema.Assistant, ResponseMeta: chunk.ResponseMeta}
+		}
+		if closed := sw.Send(usage.add(chunk), nil); closed {
+			return nil, nil
+		}
+	}
+	if len(toolChunks) == 0 {
+		return nil, nil
+	}
+	return schema.ConcatMessages(toolChunks)
+}
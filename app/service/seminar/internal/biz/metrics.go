@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const defaultProvider = "deepseek"
+
+var (
+	activeTopics = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "active_topics",
+		Help:      "Number of seminars currently running on this instance.",
+	})
+	speechesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "speeches_total",
+		Help:      "Number of speeches finished and saved.",
+	}, []string{"role_type"})
+	llmFirstTokenSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "llm_first_token_seconds",
+		Help:      "Time from sending a request to the model until the first streamed chunk.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 4, 8, 16, 32},
+	}, []string{"provider", "model"})
+	llmResponseSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "llm_response_seconds",
+		Help:      "Time from sending a request to the model until the stream ends.",
+		Buckets:   []float64{0.5, 1, 2, 4, 8, 16, 32, 64, 128},
+	}, []string{"provider", "model"})
+	llmOutputTokens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "llm_output_tokens_total",
+		Help:      "Completion tokens reported by the model.",
+	}, []string{"provider", "model"})
+	tokenBufferFlushSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "token_buffer_flush_size",
+		Help:      "Number of token messages sent in one TokenBuffer flush.",
+		Buckets:   []float64{1, 2, 5, 10, 20, 50, 100},
+	})
+	ragIngestSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "rag_ingest_seconds",
+		Help:      "Time spent splitting, embedding and storing an uploaded document.",
+		Buckets:   prometheus.ExponentialBuckets(0.25, 2, 10),
+	})
+	mcpToolCallSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "mcp_tool_call_seconds",
+		Help:      "Latency of MCP tool calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"tool"})
+	mcpToolCallFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ayana",
+		Subsystem: "seminar",
+		Name:      "mcp_tool_call_failures_total",
+		Help:      "MCP tool calls that returned an error.",
+	}, []string{"tool"})
+)
+
+// llmTurn 记录一次模型流式调用的首 token 时间、总耗时和模型返回的 usage
+type llmTurn struct {
+	role     *Role
+	provider string
+	model    string
+	prompt   []*schema.Message
+	start    time.Time
+	first    bool
+	reported schema.TokenUsage
+}
+
+func startLLMTurn(role *Role) *llmTurn {
+	provider := role.Provider
+	if provider == "" {
+		provider = defaultProvider
+	}
+	return &llmTurn{role: role, provider: provider, model: role.ModelName, start: time.Now()}
+}
+
+func (t *llmTurn) observe(msg *schema.Message) {
+	if t == nil {
+		return
+	}
+	if !t.first {
+		t.first = true
+		llmFirstTokenSeconds.WithLabelValues(t.provider, t.model).Observe(time.Since(t.start).Seconds())
+	}
+	// 流式返回的 usage 是累计值，取最大的一次。多轮工具调用时 roleChatModel 已经把之前各轮的用量加在后续分片上
+	if msg != nil && msg.ResponseMeta != nil && msg.ResponseMeta.Usage != nil {
+		u := msg.ResponseMeta.Usage
+		t.reported.PromptTokens = max(t.reported.PromptTokens, u.PromptTokens)
+		t.reported.CompletionTokens = max(t.reported.CompletionTokens, u.CompletionTokens)
+	}
+}
+
+func (t *llmTurn) finish() {
+	if t == nil {
+		return
+	}
+	llmResponseSeconds.WithLabelValues(t.provider, t.model).Observe(time.Since(t.start).Seconds())
+}
+
+func RoleTypeLabel(t RoleType) string {
+	if t == MODERATOR {
+		return "moderator"
+	}
+	return "participant"
+}
@@ -0,0 +1,216 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
+	"github.com/cloudwego/eino-ext/components/model/deepseek"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PreviewModeParticipant = "participant"
+	PreviewModePersona     = "persona"
+
+	// maxPreviewToolRounds 限制试聊中连续工具调用的轮数
+	maxPreviewToolRounds = 5
+)
+
+var ErrPreviewInvalid = status.Error(codes.InvalidArgument, "需要提供角色或角色草稿，以及试聊内容")
+
+// PreviewRequest Phone 为角色的所有者，Caller 为发起试聊的用户，用量记在 Caller 名下
+type PreviewRequest struct {
+	Phone    string
+	Caller   string
+	RoleUID  string
+	Draft    *Role
+	Prompt   string
+	Mode     string
+	UseTools bool
+}
+
+// PreviewSender 把试聊的输出逐段发送给调用方，contentType 为 reasoning、text、tool 或 end
+type PreviewSender func(contentType, content string) error
+
+// PreviewRole 向角色发送一次提问并流式返回回答，不创建讨论，也不保存发言
+func (uc *SeminarUsecase) PreviewRole(ctx context.Context, req PreviewRequest, send PreviewSender) error {
+	if req.Prompt == "" || (req.RoleUID == "" && req.Draft == nil) {
+		return ErrPreviewInvalid
+	}
+	if err := uc.usage.CheckQuota(ctx, req.Caller); err != nil {
+		return err
+	}
+	role, err := uc.previewRole(ctx, req)
+	if err != nil {
+		return err
+	}
+	loadModelCapabilities(ctx, uc.roleClient, []*Role{role})
+
+	// 试聊复用讨论的调度器来构造提示词和记录用量，topic 只携带用户信息
+	rs := &RoleScheduler{
+		topic:      &Topic{Phone: req.Phone},
+		current:    role,
+		roleMap:    map[string]*Role{role.RoleName: role},
+		roleNames:  []string{role.RoleName},
+		usage:      uc.usage,
+		keyring:    uc.keyring,
+		roleClient: uc.roleClient,
+		caller:     req.Caller,
+	}
+	history := []*schema.Message{schema.UserMessage(req.Prompt)}
+	var messages []*schema.Message
+	if req.Mode == PreviewModePersona {
+		messages = append([]*schema.Message{schema.SystemMessage("你是" + role.RoleName + "。" + role.Description)}, history...)
+	} else {
+		if messages, err = (ParticipantState{}).buildMessages(rs, history, ""); err != nil {
+			return err
+		}
+	}
+	messages = fitContext(messages, role.Capability)
+
+	cm, err := newChatModel(ctx, uc.keyring, role)
+	if err != nil {
+		return err
+	}
+	var mcpTools []tool.BaseTool
+	if req.UseTools && role.Capability.SupportsTools {
+		mcpservers, err := uc.repo.GetMCPServersFromMysql(ctx, req.Phone)
+		if err != nil {
+			zap.L().Error("get mcp servers from mysql failed", zap.Error(err))
+		}
+		var toolsInfo []*schema.ToolInfo
+		mcpTools, toolsInfo, err = getHealthyMCPServers(ctx, uc.keyring, mcpservers)
+		if err != nil {
+			zap.L().Error("Error getting healthy MCP servers", zap.Error(err))
+		}
+		if len(toolsInfo) > 0 {
+			if err := cm.BindTools(toolsInfo); err != nil {
+				zap.L().Error("Error binding tools to chat model", zap.Error(err))
+			}
+		}
+	}
+
+	for round := 0; ; round++ {
+		toolMsg, err := rs.previewTurn(ctx, cm, messages, send)
+		if err != nil {
+			rs.reportCredential(role, err)
+			return err
+		}
+		if toolMsg == nil || round >= maxPreviewToolRounds {
+			break
+		}
+		for _, call := range toolMsg.ToolCalls {
+			if err := send("tool", call.Function.Name); err != nil {
+				return err
+			}
+		}
+		messages = append(messages, toolMsg)
+		messages = append(messages, invoke(ctx, toolMsg.ToolCalls, mcpTools)...)
+	}
+	return send("end", "")
+}
+
+// previewTurn 完成一次模型调用，模型请求调用工具时返回合并后的工具调用消息
+func (rs *RoleScheduler) previewTurn(ctx context.Context, cm *deepseek.ChatModel, messages []*schema.Message, send PreviewSender) (*schema.Message, error) {
+	turn := startLLMTurn(rs.current)
+	turn.prompt = messages
+	stream, err := cm.Stream(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	var output string
+	var toolCallMessages []*schema.Message
+	defer func() {
+		usage := turn.usage(output)
+		usage.Phone = rs.caller
+		usage.Kind = UsagePreview
+		usage.ModelName = turn.model
+		usage.RoleUID = rs.current.Uid
+		if err := rs.usage.Record(context.Background(), usage); err != nil {
+			zap.L().Error("record usage failed", zap.String("phone", rs.caller), zap.Error(err))
+		}
+	}()
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			turn.finish()
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		turn.observe(resp)
+		if len(resp.ToolCalls) > 0 {
+			toolCallMessages = append(toolCallMessages, resp)
+			continue
+		}
+		if reasoning, ok := reasoningContent(rs.current, resp); ok {
+			output += reasoning
+			if err := send("reasoning", reasoning); err != nil {
+				return nil, err
+			}
+		}
+		if len(resp.Content) > 0 {
+			output += resp.Content
+			if err := send("text", resp.Content); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(toolCallMessages) == 0 {
+		return nil, nil
+	}
+	return schema.ConcatMessages(toolCallMessages)
+}
+
+// previewRole 加载已保存的角色，或者把草稿整理成可以直接构造模型的角色
+func (uc *SeminarUsecase) previewRole(ctx context.Context, req PreviewRequest) (*Role, error) {
+	if req.RoleUID != "" {
+		reply, err := uc.roleClient.GetModeratorAndParticipantsByUIDs(ctx, &roleV1.GetModeratorAndParticipantsByUIDsRequest{Phone: req.Phone, Moderator: req.RoleUID})
+		if err != nil {
+			return nil, err
+		}
+		r := reply.Moderator
+		return &Role{
+			Uid:           r.Uid,
+			RoleName:      r.Name,
+			Description:   r.Description,
+			ApiPath:       r.ApiPath,
+			ApiKey:        r.ApiKey,
+			ModelName:     r.Model.Name,
+			Provider:      r.Model.Provider,
+			CredentialUid: r.CredentialId,
+			Version:       r.Version,
+			System:        r.System,
+			RoleType:      PARTICIPANT,
+		}, nil
+	}
+
+	role := *req.Draft
+	role.RoleType = PARTICIPANT
+	// 草稿只接受明文密钥，防止拿别人的密文借用密钥
+	if secret.IsEncrypted(role.ApiKey) {
+		return nil, status.Error(codes.InvalidArgument, "API Key 格式不正确")
+	}
+	if role.CredentialUid != "" {
+		reply, err := uc.roleClient.GetCredential(ctx, &roleV1.GetCredentialRequest{Phone: req.Phone, Uid: role.CredentialUid})
+		if err != nil {
+			return nil, err
+		}
+		role.ApiPath = reply.Credential.BaseUrl
+		role.ApiKey = reply.Credential.ApiKey
+		if reply.Credential.Provider != "" {
+			role.Provider = reply.Credential.Provider
+		}
+	}
+	return &role, nil
+}
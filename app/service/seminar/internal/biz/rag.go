@@ -4,14 +4,19 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"strings"
+	"time"
 
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
 	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
 	"github.com/cloudwego/eino-ext/components/document/parser/pdf"
 	"github.com/cloudwego/eino-ext/components/document/transformer/splitter/recursive"
 	"github.com/cloudwego/eino/schema"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,8 +29,9 @@ type RAGRepo interface {
 }
 
 type RAGUsecase struct {
-	repo RAGRepo
-	log  *log.Helper
+	repo  RAGRepo
+	usage *UsageUsecase
+	log   *log.Helper
 }
 
 type Document struct {
@@ -44,10 +50,18 @@ type LoadDocument struct {
 	TopicUID    string `gorm:"foreignKey:TopicUID;references:UID"`
 }
 
+// RoleDocument 只对讨论中某个角色可见的私有资料，其他角色和主持人都检索不到
+type RoleDocument struct {
+	ID          uint   `gorm:"primaryKey"`
+	TopicUID    string `gorm:"index;column:topic_uid;type:varchar(50)"`
+	RoleUID     string `gorm:"column:role_uid;type:varchar(50)"`
+	DocumentUID string `gorm:"column:document_uid;type:varchar(50)"`
+}
+
 var globalRAGUsecase *RAGUsecase
 
-func NewRAGUsecase(repo RAGRepo, logger log.Logger) *RAGUsecase {
-	globalRAGUsecase = &RAGUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewRAGUsecase(repo RAGRepo, usage *UsageUsecase, logger log.Logger) *RAGUsecase {
+	globalRAGUsecase = &RAGUsecase{repo: repo, usage: usage, log: log.NewHelper(logger)}
 	return globalRAGUsecase
 }
 
@@ -56,6 +70,7 @@ func (uc *RAGUsecase) UploadDocument(stream v1.Seminar_UploadDocumentServer) err
 		buf         bytes.Buffer
 		filename    string
 		phone       string
+		caller      string
 		contentType string
 		firstChunk  = true
 		ctx         = context.Background()
@@ -74,7 +89,15 @@ func (uc *RAGUsecase) UploadDocument(stream v1.Seminar_UploadDocumentServer) err
 			firstChunk = false
 			filename = req.GetFilename()
 			contentType = req.GetContentType()
-			phone = req.GetPhone()
+			// 上传到工作区时文档归工作区所有，需要编辑权限
+			scoped := identity.ServerContext(stream.Context())
+			if phone, err = identity.Owner(scoped, workspace.RoleEditor); err != nil {
+				return err
+			}
+			// 文档归所有者，嵌入的用量记在上传的用户名下
+			if caller, err = identity.Phone(scoped); err != nil {
+				return err
+			}
 		} else {
 			if req.GetFilename() != filename || req.GetContentType() != contentType {
 				return status.Error(codes.InvalidArgument, "元数据不可变更")
@@ -84,6 +107,7 @@ func (uc *RAGUsecase) UploadDocument(stream v1.Seminar_UploadDocumentServer) err
 			return status.Errorf(codes.Internal, "内存写入失败: %v", err)
 		}
 	}
+	start := time.Now()
 	reader := bytes.NewReader(buf.Bytes())
 	docs, err := parseDocument(ctx, reader)
 	if err != nil {
@@ -97,9 +121,10 @@ func (uc *RAGUsecase) UploadDocument(stream v1.Seminar_UploadDocumentServer) err
 	if err != nil {
 		return err
 	}
-	if err := uc.repo.DocumentToVectorDB(ctx, uid, splittedDocs); err != nil {
+	if err := uc.repo.DocumentToVectorDB(uc.usage.WithEmbeddingMeter(ctx, caller), uid, splittedDocs); err != nil {
 		return err
 	}
+	ragIngestSeconds.Observe(time.Since(start).Seconds())
 	if err := uc.repo.DocumentToMysql(ctx, Document{
 		UID:         uid,
 		Filename:    filename,
@@ -156,3 +181,43 @@ func splitDocument(ctx context.Context, docs []*schema.Document) ([]*schema.Docu
 	}
 	return splittedDocs, nil
 }
+
+// retrieveRoleDocs 按角色可见的范围检索资料，key 为角色 UID。
+// 参与者能看到共享资料和自己的私有资料，主持人是否能看到共享资料由讨论的开关决定
+func retrieveRoleDocs(ctx context.Context, topic *Topic, roles []*Role) map[string]string {
+	shared := make([]string, 0, len(topic.Documents))
+	for _, document := range topic.Documents {
+		shared = append(shared, document.UID)
+	}
+	sharedDocs := retrieveDocs(ctx, topic.Content, shared)
+
+	docs := make(map[string]string, len(roles))
+	for _, role := range roles {
+		var b strings.Builder
+		if role.RoleType != MODERATOR || topic.ModeratorSharedDocs {
+			b.WriteString(sharedDocs)
+		}
+		if private := retrieveDocs(ctx, topic.Content, topic.RoleDocuments[role.Uid]); private != "" {
+			b.WriteString("以下资料只有你能看到：\n\n")
+			b.WriteString(private)
+		}
+		docs[role.Uid] = b.String()
+	}
+	return docs
+}
+
+func retrieveDocs(ctx context.Context, query string, documentUIDs []string) string {
+	var b strings.Builder
+	for _, uid := range documentUIDs {
+		documents, err := globalRAGUsecase.repo.RetrieveDocuments(ctx, query, uid)
+		if err != nil {
+			zap.L().Error("retrieve documents failed", zap.String("document", uid), zap.Error(err))
+			continue
+		}
+		for _, doc := range documents {
+			b.WriteString(doc.Content)
+			b.WriteString("\n\n")
+		}
+	}
+	return b.String()
+}
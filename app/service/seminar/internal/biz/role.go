@@ -9,11 +9,16 @@ import (
 	"sync"
 	"time"
 
+	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
 	"github.com/cloudwego/eino-ext/components/model/deepseek"
 
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/schema"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -24,11 +29,39 @@ type Role struct {
 	RoleName    string   `gorm:"type:varchar(50)"`
 	RoleType    RoleType `gorm:"type:enum(0, 1)"`
 	Description string   `gorm:"type:text"`
-	Avatar      string   `gorm:"type:varchar(50)"`
+	Avatar      string   `gorm:"type:varchar(200)"`
 	ApiPath     string   `gorm:"type:varchar(50)"`
-	ApiKey      string   `gorm:"type:varchar(50)"`
+	ApiKey      string   `gorm:"type:text"`
 	ModelName   string   `gorm:"type:varchar(50)"`
 	Provider    string   `gorm:"type:varchar(50)"`
+	// 引用的凭证，此时 ApiPath 和 ApiKey 来自凭证
+	CredentialUid string `gorm:"type:varchar(50)"`
+	Version       int32
+	// 角色服务的内置系统角色，只有它们可以使用平台密钥，不落库
+	System bool `gorm:"-"`
+	// 来自角色服务的模型注册表，不落库
+	Capability ModelCapability `gorm:"-"`
+}
+
+// ModelCapability 模型的能力和上下文限制，长度为 0 表示未知，不做限制
+type ModelCapability struct {
+	ContextLength           int32
+	MaxOutput               int32
+	SupportsTools           bool
+	SupportsReasoningStream bool
+	SupportsVision          bool
+}
+
+// defaultCapability 未在注册表中登记的模型沿用原来的行为，允许绑定工具、输出推理内容且不裁剪上下文
+var defaultCapability = ModelCapability{SupportsTools: true, SupportsReasoningStream: true}
+
+// reasoningContent 只有注册为支持推理流的模型才输出推理内容，能力按发言角色各自的模型判断
+func reasoningContent(role *Role, resp *schema.Message) (string, bool) {
+	if !role.Capability.SupportsReasoningStream {
+		return "", false
+	}
+	reasoning, ok := deepseek.GetReasoningContent(resp)
+	return reasoning, ok && reasoning != ""
 }
 
 type RoleCache struct {
@@ -47,10 +80,24 @@ type RoleScheduler struct {
 	msgs         []*schema.Message
 	brepo        BroadcastRepo
 	tokenChan    chan *TokenMessage
-	docs         string
+	docs         map[string]string
 	mcpTools     []tool.BaseTool
 	mcpToolsInfo []*schema.ToolInfo
 	TokenBuffer  *TokenBuffer
+	turn         *llmTurn
+	usage        *UsageUsecase
+	keyring      *secret.Keyring
+	roleClient   roleV1.RoleManagerClient
+	runUID       string
+	// caller 启动本次运行的用户。工作区讨论的 topic.Phone 是工作区，用量和配额按 caller 计算
+	caller string
+
+	// mcpServers 和 roleTools 是本次运行开始时的工具配置，运行中修改不影响本次运行
+	mcpServers []string
+	roleTools  map[string][]string
+
+	imu           sync.Mutex
+	interjections []*schema.Message
 }
 
 func NewRoleCache() *RoleCache {
@@ -143,6 +190,7 @@ func (tb *TokenBuffer) Flush() {
 	copy(toSend, tb.messages)
 	tb.messages = tb.messages[:0]
 	tb.mu.Unlock()
+	tokenBufferFlushSize.Observe(float64(len(toSend)))
 
 	select {
 	case tb.sendChan <- toSend:
@@ -255,7 +303,106 @@ func (rs *RoleScheduler) NextRole(msgContent string) error {
 }
 
 func (rs *RoleScheduler) BuildMessages(msgs []*schema.Message, docs string) ([]*schema.Message, error) {
-	return rs.state.buildMessages(rs, msgs, docs)
+	messages, err := rs.state.buildMessages(rs, msgs, docs)
+	if err != nil {
+		return nil, err
+	}
+	return fitContext(messages, rs.current.Capability), nil
+}
+
+// loadModelCapabilities 从模型注册表读取每个角色所用模型的能力，查询失败时使用默认值
+func loadModelCapabilities(ctx context.Context, roleClient roleV1.RoleManagerClient, roles []*Role) {
+	capabilities := map[string]ModelCapability{}
+	for _, role := range roles {
+		key := role.Provider + "/" + role.ModelName
+		capability, ok := capabilities[key]
+		if !ok {
+			capability = defaultCapability
+			reply, err := roleClient.GetModel(ctx, &roleV1.GetModelRequest{Provider: role.Provider, Name: role.ModelName})
+			if err == nil {
+				capability = ModelCapability{
+					ContextLength:           reply.Model.ContextLength,
+					MaxOutput:               reply.Model.MaxOutput,
+					SupportsTools:           reply.Model.SupportsTools,
+					SupportsReasoningStream: reply.Model.SupportsReasoningStream,
+					SupportsVision:          reply.Model.SupportsVision,
+				}
+			} else if status.Code(err) != codes.NotFound {
+				zap.L().Warn("get model capability failed", zap.String("model", key), zap.Error(err))
+			}
+			capabilities[key] = capability
+		}
+		role.Capability = capability
+	}
+}
+
+// fitContext 估算的提示词超出模型上下文时，从最早的对话开始丢弃，系统提示词和最后一条消息始终保留
+func fitContext(messages []*schema.Message, capability ModelCapability) []*schema.Message {
+	if capability.ContextLength <= 0 {
+		return messages
+	}
+	budget := int64(capability.ContextLength - capability.MaxOutput)
+	if budget <= 0 {
+		budget = int64(capability.ContextLength) / 2
+	}
+	start := 0
+	for start < len(messages) && messages[start].Role == schema.System {
+		start++
+	}
+	system, history := messages[:start], messages[start:]
+	for len(history) > 1 && estimateMessagesTokens(system)+estimateMessagesTokens(history) > budget {
+		history = history[1:]
+		// 工具结果不能脱离发起调用的消息单独出现
+		for len(history) > 1 && history[0].Role == schema.Tool {
+			history = history[1:]
+		}
+	}
+	if len(history) == len(messages)-start {
+		return messages
+	}
+	return append(append([]*schema.Message{}, system...), history...)
+}
+
+// newChatModel 在构造模型时才解密角色的 API Key，明文不会离开该函数
+func newChatModel(ctx context.Context, keyring *secret.Keyring, role *Role) (*deepseek.ChatModel, error) {
+	apiKey, err := keyring.Decrypt(role.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	// 系统角色不携带密钥，使用平台密钥；用户角色必须使用自己的密钥或凭证
+	if apiKey == "" {
+		if !role.System {
+			return nil, status.Errorf(codes.FailedPrecondition, "角色 %s 没有配置 API Key 或凭证", role.RoleName)
+		}
+		apiKey = viper.GetString("DEEPSEEK_API_KEY")
+	}
+	config := &deepseek.ChatModelConfig{
+		APIKey:    apiKey,
+		Model:     role.ModelName,
+		MaxTokens: int(role.Capability.MaxOutput),
+	}
+	if role.CredentialUid != "" && role.ApiPath != "" {
+		config.BaseURL = role.ApiPath
+	}
+	return deepseek.NewChatModel(ctx, config)
+}
+
+// reportCredential 异步上报凭证的使用结果，供用户在凭证列表中查看最近一次使用和错误
+func (rs *RoleScheduler) reportCredential(role *Role, err error) {
+	if rs.roleClient == nil || role == nil || role.CredentialUid == "" {
+		return
+	}
+	req := &roleV1.ReportCredentialUsageRequest{Uid: role.CredentialUid}
+	if err != nil {
+		req.Error = err.Error()
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := rs.roleClient.ReportCredentialUsage(ctx, req); err != nil {
+			zap.L().Warn("report credential usage failed", zap.String("credential", req.Uid), zap.Error(err))
+		}
+	}()
 }
 
 // Call 负责初始化资源并处理整个对话流程
@@ -280,18 +427,19 @@ func (rs *RoleScheduler) Call(messages []*schema.Message, mcpservers []MCPServer
 		}
 	}()
 
-	cm, err := deepseek.NewChatModel(ctx, &deepseek.ChatModelConfig{
-		APIKey: role.ApiKey,
-		Model:  role.ModelName,
-	})
+	cm, err := newChatModel(ctx, rs.keyring, role)
 	if err != nil {
 		return nil, Error, err
 	}
 
-	mcpTools, mcpToolsInfo, err := getHealthyMCPServers(ctx, mcpservers)
-	if err != nil {
-		zap.L().Error("Error getting healthy MCP servers", zap.Error(err))
+	// 不支持工具调用的模型不绑定也不执行工具，其他角色只能使用白名单内的工具
+	if role.Capability.SupportsTools {
+		rs.mcpTools, rs.mcpToolsInfo, err = getHealthyMCPServers(ctx, rs.keyring, selectMCPServers(mcpservers, rs.mcpServers))
+		if err != nil {
+			zap.L().Error("Error getting healthy MCP servers", zap.Error(err))
+		}
 	}
+	mcpTools, mcpToolsInfo := rs.toolsFor(role)
 
 	if len(mcpToolsInfo) > 0 {
 		if err := cm.BindTools(mcpToolsInfo); err != nil {
@@ -302,7 +450,7 @@ func (rs *RoleScheduler) Call(messages []*schema.Message, mcpservers []MCPServer
 	tokenBuffer := NewTokenBuffer(10, 50*time.Millisecond)
 
 	batchSender := func(sendCtx context.Context, tokens []*TokenMessage) error {
-		return rs.brepo.SendTokensToKafkaBatch(sendCtx, rs.topic.UID, tokens)
+		return rs.brepo.SendTokensBatch(sendCtx, rs.topic.UID, tokens)
 	}
 
 	tokenBuffer.Start(ctx, batchSender)
@@ -354,15 +502,20 @@ func invoke(ctx context.Context, toolCalls []schema.ToolCall, mcpTools []tool.Ba
 			}
 		}
 
-		if selectedTool != nil {
-			fmt.Println("正在调用工具：", toolCall.Function.Name)
-			result, err := selectedTool.InvokableRun(ctx, toolCall.Function.Arguments)
-			if err != nil {
-				zap.L().Error("工具执行失败", zap.Error(err))
-				continue
-			}
-			toolsReply = append(toolsReply, schema.ToolMessage(result, toolCall.ID))
+		// 不在角色白名单内的工具不执行，告诉模型该工具不可用
+		if selectedTool == nil {
+			zap.L().Warn("tool not allowed", zap.String("tool", toolCall.Function.Name))
+			toolsReply = append(toolsReply, schema.ToolMessage("工具 "+toolCall.Function.Name+" 不可用", toolCall.ID))
+			continue
 		}
+		result, err := selectedTool.InvokableRun(ctx, toolCall.Function.Arguments)
+		// 每个工具调用都要有对应的工具消息，否则模型接口会拒绝下一轮请求
+		if err != nil {
+			zap.L().Error("工具执行失败", zap.Error(err))
+			toolsReply = append(toolsReply, schema.ToolMessage("工具执行失败: "+err.Error(), toolCall.ID))
+			continue
+		}
+		toolsReply = append(toolsReply, schema.ToolMessage(result, toolCall.ID))
 	}
 	return toolsReply
 }
@@ -397,12 +550,16 @@ func (rs *RoleScheduler) processConversation(
 			}
 		}()
 
+		turn := startLLMTurn(rs.current)
+		turn.prompt = currentMessages
+		outputStart := len(message.Content)
 		aiStream, err := cm.Stream(streamCtx, currentMessages)
 		if err != nil {
 			streamCancel()
 			if pauseCtx.Err() != nil {
 				return nil, Pause, nil
 			} else {
+				rs.reportCredential(rs.current, err)
 				return nil, Error, err
 			}
 		}
@@ -426,6 +583,7 @@ func (rs *RoleScheduler) processConversation(
 
 					// 正常结束
 					if errors.Is(err, io.EOF) {
+						turn.finish()
 						// 如果有工具调用，准备下一轮
 						if hasTool && len(toolCallMessages) > 0 {
 							hasToolCall = true
@@ -440,6 +598,7 @@ func (rs *RoleScheduler) processConversation(
 					// 其他错误
 					return fmt.Errorf("stream error: %w", err)
 				}
+				turn.observe(resp)
 
 				// 处理工具调用
 				if len(resp.ToolCalls) > 0 {
@@ -449,7 +608,7 @@ func (rs *RoleScheduler) processConversation(
 				}
 
 				// 处理 reasoning 内容
-				if reasoning, ok := deepseek.GetReasoningContent(resp); ok && reasoning != "" {
+				if reasoning, ok := reasoningContent(rs.current, resp); ok {
 					message.Content += reasoning
 					token := &TokenMessage{
 						TopicUID:    rs.topic.UID,
@@ -480,6 +639,8 @@ func (rs *RoleScheduler) processConversation(
 			}
 		}()
 
+		rs.meterTurn(ctx, turn, message.Content[outputStart:])
+
 		// 检查流处理结果
 		if streamErr != nil {
 			// 如果发生暂停
@@ -487,6 +648,7 @@ func (rs *RoleScheduler) processConversation(
 				return nil, Pause, nil
 			}
 			// 其他错误
+			rs.reportCredential(rs.current, streamErr)
 			return nil, Error, streamErr
 		}
 
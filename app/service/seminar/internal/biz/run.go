@@ -0,0 +1,74 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// TopicRun 讨论的一次运行，记录运行时每个角色使用的版本和人设快照
+// 角色之后被修改也不影响已有发言的归属，文字记录和分叉都以快照为准
+type TopicRun struct {
+	gorm.Model
+	UID       string         `gorm:"uniqueIndex;column:uid;type:varchar(50)"`
+	TopicUID  string         `gorm:"index;column:topic_uid;type:varchar(255)"`
+	Phone     string         `gorm:"column:phone;type:varchar(255)"`
+	StartedAt time.Time      `gorm:"column:started_at"`
+	EndedAt   *time.Time     `gorm:"column:ended_at"`
+	Roles     []TopicRunRole `gorm:"foreignKey:RunUID;references:UID;constraint:OnDelete:CASCADE;"`
+}
+
+type TopicRunRole struct {
+	gorm.Model
+	RunUID      string   `gorm:"index;column:run_uid;type:varchar(50)"`
+	RoleUID     string   `gorm:"column:role_uid;type:varchar(50)"`
+	RoleVersion int32    `gorm:"column:role_version"`
+	RoleType    RoleType `gorm:"column:role_type"`
+	RoleName    string   `gorm:"column:role_name;type:varchar(50)"`
+	Description string   `gorm:"column:description;type:text"`
+	Avatar      string   `gorm:"column:avatar;type:varchar(200)"`
+	ModelName   string   `gorm:"column:model_name;type:varchar(50)"`
+	Provider    string   `gorm:"column:provider;type:varchar(50)"`
+}
+
+// startRun 记录本次运行使用的角色版本
+func (uc *SeminarUsecase) startRun(ctx context.Context, topic *Topic, roles []*Role) (*TopicRun, error) {
+	uid, err := utils.GetSnowflakeID(0)
+	if err != nil {
+		return nil, err
+	}
+	run := &TopicRun{UID: uid, TopicUID: topic.UID, Phone: topic.Phone, StartedAt: time.Now()}
+	for _, role := range roles {
+		run.Roles = append(run.Roles, TopicRunRole{
+			RoleUID:     role.Uid,
+			RoleVersion: role.Version,
+			RoleType:    role.RoleType,
+			RoleName:    role.RoleName,
+			Description: role.Description,
+			Avatar:      role.Avatar,
+			ModelName:   role.ModelName,
+			Provider:    role.Provider,
+		})
+	}
+	if err := uc.repo.CreateTopicRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+func (uc *SeminarUsecase) finishRun(runUID string) {
+	if err := uc.repo.FinishTopicRun(context.Background(), runUID, time.Now()); err != nil {
+		zap.L().Error("finish topic run failed", zap.String("run", runUID), zap.Error(err))
+	}
+}
+
+// GetTopicRuns 返回讨论的所有运行及其角色快照，按开始时间排序
+func (uc *SeminarUsecase) GetTopicRuns(ctx context.Context, phone, topicUID string) ([]TopicRun, error) {
+	if _, err := uc.getOwnedTopic(ctx, phone, topicUID); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetTopicRuns(ctx, topicUID)
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strings"
+	"slices"
+	"sync"
 	"time"
 
 	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
 	"github.com/Fl0rencess720/Ayana/pkgs/utils"
 	"github.com/cloudwego/eino-ext/components/model/deepseek"
 	"github.com/cloudwego/eino/compose"
@@ -16,6 +18,9 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type SeminarRepo interface {
@@ -32,6 +37,15 @@ type SeminarRepo interface {
 	DeleteMCPServerFromMysql(ctx context.Context, phone, uid string) error
 	EnableMCPServerInMysql(ctx context.Context, phone, uid string) error
 	DisableMCPServerInMysql(ctx context.Context, phone, uid string) error
+	GetMCPServersNeedingRotation(ctx context.Context, keyring *secret.Keyring) ([]MCPServer, error)
+	UpdateMCPServerRequestHeader(ctx context.Context, id uint, requestHeader, requestHeaderMask string) error
+	CreateTopicRun(ctx context.Context, run *TopicRun) error
+	FinishTopicRun(ctx context.Context, runUID string, at time.Time) error
+	GetTopicRuns(ctx context.Context, topicUID string) ([]TopicRun, error)
+	// GetUnfinishedTopicRuns 返回没有结束时间的运行，phone 为空时不按用户过滤
+	GetUnfinishedTopicRuns(ctx context.Context, phone string) ([]RunningTopic, error)
+	UpdateTopicTools(ctx context.Context, topicUID string, mcpServers []string, roleTools map[string][]string) error
+	UpdateTopicDocuments(ctx context.Context, topicUID string, roleDocuments map[string][]string, moderatorShared bool) error
 }
 
 type SeminarUsecase struct {
@@ -40,18 +54,25 @@ type SeminarUsecase struct {
 	log   *log.Helper
 
 	roleClient roleV1.RoleManagerClient
+	usage      *UsageUsecase
+	keyring    *secret.Keyring
 	topicCache *TopicCache
 	roleCache  *RoleCache
+
+	// running 保存本实例正在运行的讨论
+	rmu     sync.RWMutex
+	running map[string]*RoleScheduler
 }
 
 type MCPServer struct {
-	ID            uint   `gorm:"primaryKey"`
-	UID           string `gorm:"unique;index"`
-	Name          string
-	URL           string
-	RequestHeader string
-	Status        int32
-	Phone         string `gorm:"index"`
+	ID                uint   `gorm:"primaryKey"`
+	UID               string `gorm:"unique;index"`
+	Name              string
+	URL               string
+	RequestHeader     string `gorm:"type:text"`
+	RequestHeaderMask string
+	Status            int32
+	Phone             string `gorm:"index"`
 }
 
 type RoleType uint8
@@ -63,18 +84,55 @@ const (
 )
 
 func NewSeminarUsecase(repo SeminarRepo, brepo BroadcastRepo, topicCache *TopicCache,
-	roleCache *RoleCache, roleClient roleV1.RoleManagerClient, logger log.Logger) *SeminarUsecase {
+	roleCache *RoleCache, roleClient roleV1.RoleManagerClient, usage *UsageUsecase, keyring *secret.Keyring, logger log.Logger) *SeminarUsecase {
 	s := &SeminarUsecase{repo: repo, brepo: brepo, topicCache: topicCache, roleCache: roleCache,
-		roleClient: roleClient, log: log.NewHelper(logger)}
+		roleClient: roleClient, usage: usage, keyring: keyring, log: log.NewHelper(logger), running: make(map[string]*RoleScheduler)}
+	go s.readCommands(context.Background())
 	go func() {
-		if err := s.brepo.ReadPauseSignalFromKafka(context.Background()); err != nil {
-			zap.L().Error("read pause signal from kafka failed", zap.Error(err))
+		if err := s.RotateMCPRequestHeaders(context.Background()); err != nil {
+			zap.L().Error("rotate mcp request headers failed", zap.Error(err))
 		}
 	}()
 	return s
 }
 
+var (
+	ErrTopicNotFound        = status.Error(codes.NotFound, "讨论不存在")
+	ErrDocumentNotFound     = status.Error(codes.NotFound, "文档不存在")
+	ErrMCPServerNotFound    = status.Error(codes.NotFound, "MCP 服务器不存在")
+	ErrMCPServerUnavailable = status.Error(codes.Unavailable, "无法连接 MCP 服务器")
+	ErrRoleNotInTopic       = status.Error(codes.InvalidArgument, "配置中的角色不在讨论中")
+)
+
+// getOwnedTopic 读取属于 phone 的讨论，讨论不存在或属于其他用户时都返回 ErrTopicNotFound
+func (uc *SeminarUsecase) getOwnedTopic(ctx context.Context, phone, topicUID string) (*Topic, error) {
+	topic, err := uc.repo.GetTopic(ctx, topicUID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTopicNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if phone == "" || topic.Phone != phone {
+		return nil, ErrTopicNotFound
+	}
+	return topic, nil
+}
+
 func (uc *SeminarUsecase) CreateTopic(ctx context.Context, phone string, documents []string, topic *Topic) error {
+	if err := uc.checkTopicDocuments(ctx, phone, topic, documents, topic.RoleDocuments); err != nil {
+		return err
+	}
+	if _, err := uc.roleClient.GetModeratorAndParticipantsByUIDs(ctx, &roleV1.GetModeratorAndParticipantsByUIDsRequest{Phone: phone, Moderator: topic.Moderator, Uids: topic.Participants}); err != nil {
+		return err
+	}
+	// 新建的讨论总是记录选择，没有选择服务器就不使用工具
+	if topic.MCPServers == nil {
+		topic.MCPServers = []string{}
+	}
+	if err := uc.checkTopicTools(ctx, phone, topic, topic.MCPServers, topic.RoleTools); err != nil {
+		return err
+	}
 	if err := uc.repo.CreateTopic(ctx, phone, documents, topic); err != nil {
 		return err
 	}
@@ -82,15 +140,94 @@ func (uc *SeminarUsecase) CreateTopic(ctx context.Context, phone string, documen
 	return nil
 }
 
-func (uc *SeminarUsecase) DeleteTopic(ctx context.Context, topicUID string) error {
+// SetTopicTools 修改讨论使用的 MCP 服务器和角色工具白名单，正在进行的讨论下次开始时生效
+func (uc *SeminarUsecase) SetTopicTools(ctx context.Context, phone, topicUID string, mcpServers []string, roleTools map[string][]string) error {
+	topic, err := uc.getOwnedTopic(ctx, phone, topicUID)
+	if err != nil {
+		return err
+	}
+	if mcpServers == nil {
+		mcpServers = []string{}
+	}
+	if err := uc.checkTopicTools(ctx, phone, topic, mcpServers, roleTools); err != nil {
+		return err
+	}
+	// 只写数据库，缓存中的讨论可能正在运行，每次运行开始时从数据库读取工具配置
+	return uc.repo.UpdateTopicTools(ctx, topicUID, mcpServers, roleTools)
+}
+
+// SetTopicDocuments 整体替换各角色的私有资料，正在进行的讨论下次开始时生效
+func (uc *SeminarUsecase) SetTopicDocuments(ctx context.Context, phone, topicUID string, roleDocuments map[string][]string, moderatorShared bool) error {
+	topic, err := uc.getOwnedTopic(ctx, phone, topicUID)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkTopicDocuments(ctx, phone, topic, nil, roleDocuments); err != nil {
+		return err
+	}
+	// 与工具配置相同，只写数据库，不修改缓存中可能正在运行的讨论
+	return uc.repo.UpdateTopicDocuments(ctx, topicUID, roleDocuments, moderatorShared)
+}
+
+// checkTopicDocuments 讨论引用的文档都必须属于用户，私有资料只能分给讨论中的角色
+func (uc *SeminarUsecase) checkTopicDocuments(ctx context.Context, phone string, topic *Topic, documents []string, roleDocuments map[string][]string) error {
+	referenced := slices.Clone(documents)
+	for roleUID, docs := range roleDocuments {
+		if roleUID != topic.Moderator && !slices.Contains(topic.Participants, roleUID) {
+			return ErrRoleNotInTopic
+		}
+		referenced = append(referenced, docs...)
+	}
+	if len(referenced) == 0 {
+		return nil
+	}
+	owned, err := globalRAGUsecase.repo.GetDocumentsFromMysql(ctx, phone)
+	if err != nil {
+		return err
+	}
+	ownedUIDs := make(map[string]struct{}, len(owned))
+	for _, d := range owned {
+		ownedUIDs[d.UID] = struct{}{}
+	}
+	for _, uid := range referenced {
+		if _, ok := ownedUIDs[uid]; !ok {
+			return ErrDocumentNotFound
+		}
+	}
+	return nil
+}
+
+// checkTopicTools 服务器必须属于用户，白名单只能配置讨论中的角色
+func (uc *SeminarUsecase) checkTopicTools(ctx context.Context, phone string, topic *Topic, mcpServers []string, roleTools map[string][]string) error {
+	if len(mcpServers) > 0 {
+		owned, err := uc.repo.GetMCPServersFromMysql(ctx, phone)
+		if err != nil {
+			return err
+		}
+		if len(selectMCPServers(owned, mcpServers)) != len(mcpServers) {
+			return ErrMCPServerNotFound
+		}
+	}
+	for roleUID := range roleTools {
+		if roleUID != topic.Moderator && !slices.Contains(topic.Participants, roleUID) {
+			return ErrRoleNotInTopic
+		}
+	}
+	return nil
+}
+
+func (uc *SeminarUsecase) DeleteTopic(ctx context.Context, phone, topicUID string) error {
+	if _, err := uc.getOwnedTopic(ctx, phone, topicUID); err != nil {
+		return err
+	}
 	if err := uc.repo.DeleteTopic(ctx, topicUID); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (uc *SeminarUsecase) GetTopic(ctx context.Context, topicUID string) (Topic, error) {
-	topic, err := uc.repo.GetTopic(ctx, topicUID)
+func (uc *SeminarUsecase) GetTopic(ctx context.Context, phone, topicUID string) (Topic, error) {
+	topic, err := uc.getOwnedTopic(ctx, phone, topicUID)
 	if err != nil {
 		return Topic{}, err
 	}
@@ -105,79 +242,96 @@ func (uc *SeminarUsecase) GetTopicsMetadata(ctx context.Context, phone string) (
 	return topics, nil
 }
 
-func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, topicUID string) error {
+// claimTopic 在分布式锁内登记讨论归属，锁只保护登记本身，运行期间的互斥由归属记录和心跳保证
+func (uc *SeminarUsecase) claimTopic(ctx context.Context, topicUID string) (string, <-chan struct{}, error) {
 	// 分布式锁的value
 	lockerUID := uuid.New().String()
 	if err := uc.repo.LockTopic(ctx, topicUID, lockerUID); err != nil {
-		zap.L().Error("lock topic failed", zap.Error(err))
+		return "", nil, err
 	}
 	defer uc.repo.UnlockTopic(topicUID, lockerUID)
+	return uc.brepo.ClaimTopic(ctx, topicUID)
+}
+
+// StartTopic phone 为讨论的所有者，caller 为发起运行的用户，用量记在 caller 名下
+func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, caller, topicUID string) error {
+	// 获取主题详情，工具配置以数据库为准，缓存中的可能已经过期。
+	// 先确认调用者拥有讨论，再做任何影响正在运行的讨论的操作
+	stored, err := uc.getOwnedTopic(ctx, phone, topicUID)
+	if err != nil {
+		return err
+	}
+	claimID, lost, err := uc.claimTopic(ctx, topicUID)
+	if err != nil {
+		return err
+	}
+	defer uc.brepo.ReleaseTopic(context.Background(), topicUID, claimID)
 
-	// 获取主题详情
 	topic, err := uc.topicCache.GetTopic(topicUID)
 	if err != nil {
 		fmt.Printf("err: %v\n", err)
 		return err
 	}
 	if topic == nil {
-		topic, err = uc.repo.GetTopic(context.Background(), topicUID)
-		if err != nil {
-			return err
-		}
+		topic = stored
 		topic.signalChan = make(chan StateSignal, 1)
 		uc.topicCache.SetTopic(topic)
 	}
 
-	// 添加暂停信号通道
-	if err := uc.brepo.AddTopicPauseChannel(ctx, topicUID, topic.signalChan); err != nil {
-		zap.L().Error("add topic pause channel failed", zap.Error(err))
+	if err := uc.usage.CheckQuota(ctx, caller); err != nil {
+		return err
 	}
-	defer uc.brepo.DeleteTopicPauseContext(ctx, topicUID)
 
-	// 检索相关文档段落
-	var docs strings.Builder
-	for _, document := range topic.Documents {
-		documents, err := globalRAGUsecase.repo.RetrieveDocuments(ctx, topic.Content, document.UID)
-		if err != nil {
-			zap.L().Error("retrieve documents failed", zap.Error(err))
-		}
-		for _, doc := range documents {
-			docs.WriteString(doc.Content)
-			docs.WriteString("\n\n")
-		}
+	// 清空上一次运行遗留的暂停信号
+	select {
+	case <-topic.signalChan:
+	default:
 	}
 
 	// 获取该主题的所有角色
-	rolesReply, err := uc.roleClient.GetModeratorAndParticipantsByUIDs(context.Background(), &roleV1.GetModeratorAndParticipantsByUIDsRequest{Phone: topic.Phone, Moderator: topic.Moderator, Uids: topic.Participants})
+	rolesReply, err := uc.roleClient.GetModeratorAndParticipantsByUIDs(ctx, &roleV1.GetModeratorAndParticipantsByUIDsRequest{Phone: topic.Phone, Moderator: topic.Moderator, Uids: topic.Participants})
 	if err != nil {
 		return err
 	}
 	// 加载主持人
 	moderator := &Role{
-		Uid:         rolesReply.Moderator.Uid,
-		RoleName:    rolesReply.Moderator.Name,
-		Description: rolesReply.Moderator.Description,
-		Avatar:      rolesReply.Moderator.Avatar,
-		ApiPath:     rolesReply.Moderator.ApiPath,
-		ApiKey:      rolesReply.Moderator.ApiKey,
-		ModelName:   rolesReply.Moderator.Model.Name,
-		RoleType:    MODERATOR,
+		Uid:           rolesReply.Moderator.Uid,
+		RoleName:      rolesReply.Moderator.Name,
+		Description:   rolesReply.Moderator.Description,
+		Avatar:        rolesReply.Moderator.Avatar,
+		ApiPath:       rolesReply.Moderator.ApiPath,
+		ApiKey:        rolesReply.Moderator.ApiKey,
+		ModelName:     rolesReply.Moderator.Model.Name,
+		Provider:      rolesReply.Moderator.Model.Provider,
+		CredentialUid: rolesReply.Moderator.CredentialId,
+		Version:       rolesReply.Moderator.Version,
+		System:        rolesReply.Moderator.System,
+		RoleType:      MODERATOR,
 	}
 	// 加载参与者
 	participants := []*Role{}
 	for _, r := range rolesReply.Participants {
 		participants = append(participants, &Role{
-			Uid:         r.Uid,
-			RoleName:    r.Name,
-			Description: r.Description,
-			Avatar:      r.Avatar,
-			ApiPath:     r.ApiPath,
-			ApiKey:      r.ApiKey,
-			ModelName:   r.Model.Name,
-			RoleType:    PARTICIPANT,
+			Uid:           r.Uid,
+			RoleName:      r.Name,
+			Description:   r.Description,
+			Avatar:        r.Avatar,
+			ApiPath:       r.ApiPath,
+			ApiKey:        r.ApiKey,
+			ModelName:     r.Model.Name,
+			Provider:      r.Model.Provider,
+			CredentialUid: r.CredentialId,
+			Version:       r.Version,
+			System:        r.System,
+			RoleType:      PARTICIPANT,
 		})
 	}
 
+	loadModelCapabilities(ctx, uc.roleClient, append([]*Role{moderator}, participants...))
+
+	// 按每个角色可见的范围检索相关文档段落，检索时嵌入查询的用量与上传文档一样计入调用者
+	docs := retrieveRoleDocs(uc.usage.WithEmbeddingMeter(ctx, caller), stored, append([]*Role{moderator}, participants...))
+
 	//  将加载的所有角色添加到角色缓存中
 	uc.roleCache.SetRoles(topicUID, append(participants, moderator))
 
@@ -186,6 +340,21 @@ func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, topicUID string
 	if err != nil {
 		return err
 	}
+	roleScheduler.usage = uc.usage
+	roleScheduler.keyring = uc.keyring
+	roleScheduler.roleClient = uc.roleClient
+	roleScheduler.caller = caller
+	roleScheduler.mcpServers, roleScheduler.roleTools = stored.MCPServers, stored.RoleTools
+	run, err := uc.startRun(ctx, topic, append([]*Role{moderator}, participants...))
+	if err != nil {
+		return err
+	}
+	roleScheduler.runUID = run.UID
+	defer uc.finishRun(run.UID)
+	if err := uc.addRunningTopic(topicUID, roleScheduler); err != nil {
+		return err
+	}
+	defer uc.removeRunningTopic(topicUID, roleScheduler)
 
 	previousMessages := []*schema.Message{{Role: schema.User, Content: "@研讨会管理员:研讨会的主题是---" + topic.Content + "。请主持人做好准备！"}}
 	for _, speech := range topic.Speeches {
@@ -200,12 +369,12 @@ func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, topicUID string
 	} else {
 		roleScheduler.NextRole("")
 	}
-	// 获取MCP服务器信息
+	// 获取讨论选择的MCP服务器，各角色可用的工具在绑定和调用时再按白名单过滤
 	mcpservers, err := uc.repo.GetMCPServersFromMysql(ctx, phone)
 	if err != nil {
 		zap.L().Error("get mcp servers from mysql failed", zap.Error(err))
 	}
-	mcpBaseTools, mcpToolsInfo, err := getHealthyMCPServers(ctx, mcpservers)
+	mcpBaseTools, mcpToolsInfo, err := getHealthyMCPServers(ctx, uc.keyring, selectMCPServers(mcpservers, roleScheduler.mcpServers))
 	if err != nil {
 		zap.L().Error("Error getting healthy MCP servers", zap.Error(err))
 	}
@@ -214,7 +383,7 @@ func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, topicUID string
 	tokenBuffer := NewTokenBuffer(10, 50*time.Millisecond)
 
 	batchSender := func(sendCtx context.Context, tokens []*TokenMessage) error {
-		return roleScheduler.brepo.SendTokensToKafkaBatch(sendCtx, roleScheduler.topic.UID, tokens)
+		return roleScheduler.brepo.SendTokensBatch(sendCtx, roleScheduler.topic.UID, tokens)
 	}
 
 	newCtx := context.Background()
@@ -225,12 +394,13 @@ func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, topicUID string
 	roleScheduler.msgs = previousMessages
 	roleScheduler.mcpTools = mcpBaseTools
 	roleScheduler.mcpToolsInfo = mcpToolsInfo
-	roleScheduler.docs = docs.String()
+	roleScheduler.docs = docs
 	roleScheduler.tokenChan = tokenChan
 	roleScheduler.TokenBuffer = tokenBuffer
 
 	done := make(chan struct{})
 	defer close(done)
+	go uc.stopOnOwnershipLost(topic, lost, done)
 
 	runner, err := uc.BuildGraph(ctx, roleScheduler, topic.signalChan)
 	if err != nil {
@@ -250,15 +420,21 @@ func (uc *SeminarUsecase) StartTopic(ctx context.Context, phone, topicUID string
 	return nil
 }
 
-func (uc *SeminarUsecase) StopTopic(ctx context.Context, topicID string) error {
-	topic, err := uc.topicCache.GetTopic(topicID)
-	if err != nil {
+func (uc *SeminarUsecase) StopTopic(ctx context.Context, phone, topicID string) error {
+	if _, err := uc.getOwnedTopic(ctx, phone, topicID); err != nil {
 		return err
 	}
-	if err := uc.brepo.SendPauseSignalToKafka(ctx, topic.UID); err != nil {
+	return uc.sendCommand(ctx, topicID, CommandPause, "")
+}
+
+func (uc *SeminarUsecase) InterjectTopic(ctx context.Context, phone, topicID, content string) error {
+	if content == "" {
+		return status.Error(codes.InvalidArgument, "插话内容不能为空")
+	}
+	if _, err := uc.getOwnedTopic(ctx, phone, topicID); err != nil {
 		return err
 	}
-	return nil
+	return uc.sendCommand(ctx, topicID, CommandInterject, content)
 }
 
 func (uc *SeminarUsecase) AddMCPServer(ctx context.Context, phone, name, url, requestHeader string) error {
@@ -266,7 +442,12 @@ func (uc *SeminarUsecase) AddMCPServer(ctx context.Context, phone, name, url, re
 	if err != nil {
 		return err
 	}
-	if err := uc.repo.AddMCPServerToMysql(ctx, &MCPServer{UID: uid, Name: name, URL: url, RequestHeader: requestHeader, Phone: phone}); err != nil {
+	encryptedHeader, err := uc.keyring.Encrypt(requestHeader)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.AddMCPServerToMysql(ctx, &MCPServer{UID: uid, Name: name, URL: url,
+		RequestHeader: encryptedHeader, RequestHeaderMask: secret.Mask(requestHeader), Phone: phone}); err != nil {
 		return err
 	}
 	return nil
@@ -280,6 +461,19 @@ func (uc *SeminarUsecase) GetMCPServers(ctx context.Context, phone string) ([]MC
 	return servers, nil
 }
 
+// GetMCPServerTools 连接用户的 MCP 服务器并列出它提供的工具
+func (uc *SeminarUsecase) GetMCPServerTools(ctx context.Context, phone, uid string) ([]*schema.ToolInfo, error) {
+	servers, err := uc.repo.GetMCPServersFromMysql(ctx, phone)
+	if err != nil {
+		return nil, err
+	}
+	matched := selectMCPServers(servers, []string{uid})
+	if len(matched) == 0 {
+		return nil, ErrMCPServerNotFound
+	}
+	return listMCPServerTools(ctx, uc.keyring, matched[0])
+}
+
 func (uc *SeminarUsecase) CheckMCPServerHealth(ctx context.Context, url string) (int32, error) {
 	health, err := checkMCPServerHealthByPing(MCPServer{URL: url})
 	if err != nil {
@@ -315,26 +509,48 @@ func (uc *SeminarUsecase) DisableMCPServer(ctx context.Context, phone string, ui
 	return nil
 }
 
-func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleScheduler, signalChan <-chan StateSignal) (compose.Runnable[[]*schema.Message, *schema.Message], error) {
-	// 为每个角色创建独立的模型实例
-	moderatorModel, err := deepseek.NewChatModel(ctx, &deepseek.ChatModelConfig{
-		APIKey: roleScheduler.moderator.ApiKey,
-		Model:  roleScheduler.moderator.ModelName,
-	})
+// RotateMCPRequestHeaders 把明文或旧主密钥包装的 MCP 请求头重新包装到当前主密钥下
+func (uc *SeminarUsecase) RotateMCPRequestHeaders(ctx context.Context) error {
+	servers, err := uc.repo.GetMCPServersNeedingRotation(ctx, uc.keyring)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	participantModel, err := deepseek.NewChatModel(ctx, &deepseek.ChatModelConfig{
-		APIKey: roleScheduler.current.ApiKey,
-		Model:  roleScheduler.current.ModelName,
-	})
-	if err != nil {
-		return nil, err
+	for _, server := range servers {
+		mask := server.RequestHeaderMask
+		if mask == "" {
+			plaintext, err := uc.keyring.Decrypt(server.RequestHeader)
+			if err != nil {
+				zap.L().Error("decrypt mcp request header failed", zap.String("uid", server.UID), zap.Error(err))
+				continue
+			}
+			mask = secret.Mask(plaintext)
+		}
+		rotated, err := uc.keyring.Rotate(server.RequestHeader)
+		if err != nil {
+			zap.L().Error("rotate mcp request header failed", zap.String("uid", server.UID), zap.Error(err))
+			continue
+		}
+		if err := uc.repo.UpdateMCPServerRequestHeader(ctx, server.ID, rotated, mask); err != nil {
+			zap.L().Error("update mcp request header failed", zap.String("uid", server.UID), zap.Error(err))
+		}
 	}
+	return nil
+}
 
-	moderatorModel.BindTools(roleScheduler.mcpToolsInfo)
-	participantModel.BindTools(roleScheduler.mcpToolsInfo)
+// maxRunSteps 限制讨论图的运行步数，每次发言消耗发言节点和转换节点两步
+const maxRunSteps = 100
+
+func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleScheduler, signalChan <-chan StateSignal) (compose.Runnable[[]*schema.Message, *schema.Message], error) {
+	// 为每个角色创建独立的模型实例，各自使用自己的模型、地址、密钥和输出上限
+	models := make(map[string]*deepseek.ChatModel, len(roleScheduler.participants)+1)
+	for _, role := range append([]*Role{roleScheduler.moderator}, roleScheduler.participants...) {
+		cm, err := newChatModel(ctx, roleScheduler.keyring, role)
+		if err != nil {
+			return nil, err
+		}
+		models[role.Uid] = cm
+	}
+	chatModel := &roleChatModel{models: models, rs: roleScheduler}
 
 	g := compose.NewGraph[[]*schema.Message, *schema.Message](
 		compose.WithGenLocalState(func(ctx context.Context) *RoleScheduler {
@@ -344,32 +560,38 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 	_ = g.AddPassthroughNode("decision")
 
 	// 添加主持人节点
-	_ = g.AddChatModelNode("moderator", moderatorModel,
+	_ = g.AddChatModelNode("moderator", chatModel,
 		compose.WithStatePreHandler(
 			func(ctx context.Context, input []*schema.Message, state *RoleScheduler) ([]*schema.Message, error) {
 				// 确保当前角色是主持人
 				if state.current.RoleType != MODERATOR {
 					state.current = state.moderator
 				}
+				state.msgs = append(state.msgs, state.takeInterjections()...)
+				state.turn = startLLMTurn(state.current)
 
-				messages, err := state.BuildMessages(append(state.msgs, input...), roleScheduler.docs)
+				messages, err := state.BuildMessages(append(state.msgs, input...), state.docs[state.current.Uid])
 				if err != nil {
 					return nil, err
 				}
+				state.turn.prompt = messages
 
 				return messages, nil
 			}),
 		compose.WithNodeName("moderator"))
 
 	// 添加参与者节点
-	_ = g.AddChatModelNode("participant", participantModel,
+	_ = g.AddChatModelNode("participant", chatModel,
 		compose.WithStatePreHandler(
 			func(ctx context.Context, input []*schema.Message, state *RoleScheduler) ([]*schema.Message, error) {
+				state.msgs = append(state.msgs, state.takeInterjections()...)
+				state.turn = startLLMTurn(state.current)
 
-				messages, err := state.BuildMessages(append(state.msgs, input...), roleScheduler.docs)
+				messages, err := state.BuildMessages(append(state.msgs, input...), state.docs[state.current.Uid])
 				if err != nil {
 					return nil, err
 				}
+				state.turn.prompt = messages
 
 				return messages, nil
 			}),
@@ -418,6 +640,8 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 				select {
 				case signal, ok := <-signalChan:
 					if ok && signal == Pause {
+						state.meterTurn(ctx, state.turn, message.Content)
+						state.turn = nil
 						return "", compose.InterruptAndRerun
 					}
 				default:
@@ -426,12 +650,15 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 
 				resp, err := input.Recv()
 				if errors.Is(err, io.EOF) {
+					state.turn.finish()
 					break
 				}
 				if err != nil {
 					fmt.Printf("err: %v\n", err)
+					state.reportCredential(state.current, err)
 					return "", err
 				}
+				state.turn.observe(resp)
 
 				state.TokenBuffer.Add(&TokenMessage{
 					Content: resp.Content,
@@ -439,7 +666,7 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 				})
 
 				// 处理 reasoning 内容
-				if reasoning, ok := deepseek.GetReasoningContent(resp); ok && reasoning != "" {
+				if reasoning, ok := reasoningContent(state.current, resp); ok {
 					message.Content += reasoning
 					token := &TokenMessage{
 						TopicUID:    state.topic.UID,
@@ -469,16 +696,22 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 				}
 			}
 
+			state.meterTurn(ctx, state.turn, message.Content)
+			state.turn = nil
+
 			speech := Speech{
-				TopicUID: roleScheduler.topic.UID,
-				RoleUID:  state.current.Uid,
-				Content:  message.Content,
-				RoleName: state.current.RoleName,
-				Time:     time.Now(),
+				TopicUID:    roleScheduler.topic.UID,
+				RoleUID:     state.current.Uid,
+				Content:     message.Content,
+				RoleName:    state.current.RoleName,
+				Time:        time.Now(),
+				RunUID:      roleScheduler.runUID,
+				RoleVersion: state.current.Version,
 			}
 			if err = uc.repo.SaveSpeech(ctx, &speech); err != nil {
 				return "", err
 			}
+			speechesTotal.WithLabelValues(RoleTypeLabel(state.current.RoleType)).Inc()
 			roleScheduler.topic.Speeches = append(roleScheduler.topic.Speeches, speech)
 			uc.topicCache.SetTopic(roleScheduler.topic)
 
@@ -519,6 +752,8 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 				select {
 				case signal, ok := <-signalChan:
 					if ok && signal == Pause {
+						state.meterTurn(ctx, state.turn, message.Content)
+						state.turn = nil
 
 						return "", compose.InterruptAndRerun
 					}
@@ -527,17 +762,20 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 				}
 				resp, err := input.Recv()
 				if errors.Is(err, io.EOF) {
+					state.turn.finish()
 					break
 				}
 				if err != nil {
+					state.reportCredential(state.current, err)
 					return "", err
 				}
+				state.turn.observe(resp)
 				state.TokenBuffer.Add(&TokenMessage{
 					Content: resp.Content,
 					RoleUID: state.current.Uid,
 				})
 				// 处理 reasoning 内容
-				if reasoning, ok := deepseek.GetReasoningContent(resp); ok && reasoning != "" {
+				if reasoning, ok := reasoningContent(state.current, resp); ok {
 					message.Content += reasoning
 					token := &TokenMessage{
 						TopicUID:    state.topic.UID,
@@ -567,16 +805,22 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 				}
 
 			}
+			state.meterTurn(ctx, state.turn, message.Content)
+			state.turn = nil
+
 			speech := Speech{
-				TopicUID: roleScheduler.topic.UID,
-				RoleUID:  state.current.Uid,
-				Content:  message.Content,
-				RoleName: state.current.RoleName,
-				Time:     time.Now(),
+				TopicUID:    roleScheduler.topic.UID,
+				RoleUID:     state.current.Uid,
+				Content:     message.Content,
+				RoleName:    state.current.RoleName,
+				Time:        time.Now(),
+				RunUID:      roleScheduler.runUID,
+				RoleVersion: state.current.Version,
 			}
 			if err = uc.repo.SaveSpeech(ctx, &speech); err != nil {
 				return "", err
 			}
+			speechesTotal.WithLabelValues(RoleTypeLabel(state.current.RoleType)).Inc()
 			roleScheduler.topic.Speeches = append(roleScheduler.topic.Speeches, speech)
 			uc.topicCache.SetTopic(roleScheduler.topic)
 			// 更新状态
@@ -605,7 +849,7 @@ func (uc *SeminarUsecase) BuildGraph(ctx context.Context, roleScheduler *RoleSch
 		},
 		map[string]bool{compose.END: true, "participantToModerator": true}))
 
-	runner, err := g.Compile(ctx, compose.WithMaxRunSteps(100))
+	runner, err := g.Compile(ctx, compose.WithMaxRunSteps(maxRunSteps))
 	if err != nil {
 		return nil, err
 	}
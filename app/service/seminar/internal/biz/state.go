@@ -37,10 +37,11 @@ func (s ModeratorState) getStateName() string {
 }
 
 func (s ModeratorState) nextRole(scheduler *RoleScheduler, msgContent string) (*Role, error) {
-	roleName, err := findNextRoleNameFromMessage(msgContent)
+	roleName, usage, err := findNextRoleNameFromMessage(msgContent)
 	if err != nil {
 		return nil, err
 	}
+	scheduler.meter(context.Background(), UsageSpeaker, scheduler.moderator, speakerModelName, usage)
 	role, ok := scheduler.roleMap[roleName]
 	if !ok {
 		return nil, errors.New("unknown role")
@@ -110,6 +111,9 @@ func (s ModeratorState) buildMessages(scheduler *RoleScheduler, msgs []*schema.M
 ## Participants
 - 参与者列表: {roles}
 
+## Documents
+- 主题相关资料: {docs}
+
 
 ## OutputFormat
 
@@ -153,6 +157,7 @@ func (s ModeratorState) buildMessages(scheduler *RoleScheduler, msgs []*schema.M
 		"roles":          scheduler.roleNames,
 		"characteristic": scheduler.current.Description,
 		"history_key":    msgs,
+		"docs":           docs,
 	}
 	messages, err = template.Format(context.Background(), variables)
 	if err != nil {
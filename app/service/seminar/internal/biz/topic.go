@@ -26,16 +26,24 @@ type TopicCache struct {
 
 type Topic struct {
 	gorm.Model
-	UID          string           `gorm:"index;column:uid;type:varchar(255)"`
-	Content      string           `gorm:"column:content;type:text"`
-	Moderator    string           `gorm:"column:moderator;type:varchar(255)"`
-	Participants []string         `gorm:"column:participants;type:json;serializer:json"`
-	Speeches     []Speech         `gorm:"foreignKey:TopicUID;references:UID;constraint:OnDelete:CASCADE;"`
-	Title        string           `gorm:"column:title;type:varchar(255)"`
-	TitleImage   string           `gorm:"column:title_image;type:varchar(255)"`
-	Phone        string           `gorm:"column:phone;type:varchar(255)"`
-	Documents    []Document       `gorm:"many2many:load_documents;foreignKey:UID;joinForeignKey:TopicUID;References:UID;joinReferences:DocumentUID"`
-	signalChan   chan StateSignal `gorm:"-;"`
+	UID          string     `gorm:"index;column:uid;type:varchar(255)"`
+	Content      string     `gorm:"column:content;type:text"`
+	Moderator    string     `gorm:"column:moderator;type:varchar(255)"`
+	Participants []string   `gorm:"column:participants;type:json;serializer:json"`
+	Speeches     []Speech   `gorm:"foreignKey:TopicUID;references:UID;constraint:OnDelete:CASCADE;"`
+	Title        string     `gorm:"column:title;type:varchar(255)"`
+	TitleImage   string     `gorm:"column:title_image;type:varchar(255)"`
+	Phone        string     `gorm:"column:phone;type:varchar(255)"`
+	Documents    []Document `gorm:"many2many:load_documents;foreignKey:UID;joinForeignKey:TopicUID;References:UID;joinReferences:DocumentUID"`
+	// RoleDocuments 角色 UID 到私有资料 UID 的映射，Documents 为所有角色共享的资料
+	RoleDocuments map[string][]string `gorm:"-"`
+	// ModeratorSharedDocs 主持人是否也能检索共享资料
+	ModeratorSharedDocs bool `gorm:"column:moderator_shared_docs"`
+	// MCPServers 讨论使用的 MCP 服务器，为 nil 时（旧数据）使用用户所有启用的服务器
+	MCPServers []string `gorm:"column:mcp_servers;type:json;serializer:json"`
+	// RoleTools 角色 UID 到允许使用的工具名的映射，未配置的角色可以使用所选服务器的全部工具
+	RoleTools  map[string][]string `gorm:"column:role_tools;type:json;serializer:json"`
+	signalChan chan StateSignal    `gorm:"-;"`
 }
 
 type Speech struct {
@@ -46,6 +54,9 @@ type Speech struct {
 	RoleName string    `gorm:"column:role_name;type:varchar(50)"`
 	Content  string    `gorm:"column:content;type:text"`
 	Time     time.Time `gorm:"column:time"`
+	// 发言所属的运行和角色版本，人设以 TopicRunRole 中的快照为准
+	RunUID      string `gorm:"index;column:run_uid;type:varchar(50)"`
+	RoleVersion int32  `gorm:"column:role_version"`
 }
 
 func (t *Topic) Scan(value interface{}) error {
@@ -0,0 +1,344 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
+	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/conf"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/schema"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UsageKind string
+
+const (
+	UsageModerator   UsageKind = "moderator"
+	UsageParticipant UsageKind = "participant"
+	UsageSpeaker     UsageKind = "speaker"
+	UsageEmbedding   UsageKind = "embedding"
+	UsagePreview     UsageKind = "preview"
+	// UsageGenerate 角色服务用平台密钥生成角色
+	UsageGenerate UsageKind = "generate"
+)
+
+var ErrQuotaExceeded = status.Error(codes.ResourceExhausted, "用量已超出配额")
+
+// Usage 一次模型调用的用量记录，模型未返回 usage 时按文本长度估算并标记 Estimated
+type Usage struct {
+	gorm.Model
+	Phone            string    `gorm:"index:idx_usage_phone_time;type:varchar(50)"`
+	TopicUID         string    `gorm:"index;type:varchar(255)"`
+	RoleUID          string    `gorm:"type:varchar(50)"`
+	Kind             UsageKind `gorm:"type:varchar(20)"`
+	ModelName        string    `gorm:"type:varchar(50)"`
+	PromptTokens     int64
+	CompletionTokens int64
+	Cost             float64
+	Estimated        bool
+	Time             time.Time `gorm:"index:idx_usage_phone_time"`
+}
+
+type UsageSummary struct {
+	PromptTokens     int64
+	CompletionTokens int64
+	Cost             float64
+}
+
+func (s UsageSummary) TotalTokens() int64 {
+	return s.PromptTokens + s.CompletionTokens
+}
+
+// ModelUsage 按模型和调用类型汇总的用量
+type ModelUsage struct {
+	ModelName string
+	Kind      UsageKind
+	UsageSummary
+}
+
+type UsageReport struct {
+	Daily             UsageSummary
+	Monthly           UsageSummary
+	Models            []ModelUsage
+	DailyTokenQuota   int64
+	MonthlyTokenQuota int64
+	DailyCostQuota    float64
+	MonthlyCostQuota  float64
+	Currency          string
+}
+
+type UsageRepo interface {
+	SaveUsage(ctx context.Context, usage *Usage) error
+	SumUsage(ctx context.Context, phone string, since time.Time) (UsageSummary, error)
+	GroupUsageByModel(ctx context.Context, phone string, since time.Time) ([]ModelUsage, error)
+}
+
+type UsageUsecase struct {
+	repo   UsageRepo
+	conf   *conf.Usage
+	prices map[string]*conf.Usage_Price
+	log    *log.Helper
+}
+
+func NewUsageUsecase(c *conf.Usage, repo UsageRepo, logger log.Logger) *UsageUsecase {
+	if c == nil {
+		c = &conf.Usage{}
+	}
+	prices := make(map[string]*conf.Usage_Price, len(c.Prices))
+	for _, p := range c.Prices {
+		prices[p.Model] = p
+	}
+	return &UsageUsecase{repo: repo, conf: c, prices: prices, log: log.NewHelper(logger)}
+}
+
+// Cost 按价格表计算费用，未配置价格的模型费用为 0
+func (uc *UsageUsecase) Cost(modelName string, promptTokens, completionTokens int64) float64 {
+	p, ok := uc.prices[modelName]
+	if !ok {
+		return 0
+	}
+	return (float64(promptTokens)*p.Prompt + float64(completionTokens)*p.Completion) / 1e6
+}
+
+func (uc *UsageUsecase) Record(ctx context.Context, usage *Usage) error {
+	if usage.Time.IsZero() {
+		usage.Time = time.Now()
+	}
+	usage.Cost = uc.Cost(usage.ModelName, usage.PromptTokens, usage.CompletionTokens)
+	return uc.repo.SaveUsage(ctx, usage)
+}
+
+// CheckQuota 检查用户当日和当月用量，超出任一配额时返回 ErrQuotaExceeded
+func (uc *UsageUsecase) CheckQuota(ctx context.Context, phone string) error {
+	if uc.conf.DailyTokenQuota <= 0 && uc.conf.DailyCostQuota <= 0 &&
+		uc.conf.MonthlyTokenQuota <= 0 && uc.conf.MonthlyCostQuota <= 0 {
+		return nil
+	}
+	day, month := usagePeriods(time.Now())
+	daily, err := uc.repo.SumUsage(ctx, phone, day)
+	if err != nil {
+		return err
+	}
+	if exceeded(daily, uc.conf.DailyTokenQuota, uc.conf.DailyCostQuota) {
+		return ErrQuotaExceeded
+	}
+	monthly, err := uc.repo.SumUsage(ctx, phone, month)
+	if err != nil {
+		return err
+	}
+	if exceeded(monthly, uc.conf.MonthlyTokenQuota, uc.conf.MonthlyCostQuota) {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+func (uc *UsageUsecase) GetUsage(ctx context.Context, phone string) (*UsageReport, error) {
+	day, month := usagePeriods(time.Now())
+	daily, err := uc.repo.SumUsage(ctx, phone, day)
+	if err != nil {
+		return nil, err
+	}
+	monthly, err := uc.repo.SumUsage(ctx, phone, month)
+	if err != nil {
+		return nil, err
+	}
+	models, err := uc.repo.GroupUsageByModel(ctx, phone, month)
+	if err != nil {
+		return nil, err
+	}
+	return &UsageReport{
+		Daily:             daily,
+		Monthly:           monthly,
+		Models:            models,
+		DailyTokenQuota:   uc.conf.DailyTokenQuota,
+		MonthlyTokenQuota: uc.conf.MonthlyTokenQuota,
+		DailyCostQuota:    uc.conf.DailyCostQuota,
+		MonthlyCostQuota:  uc.conf.MonthlyCostQuota,
+		Currency:          uc.conf.Currency,
+	}, nil
+}
+
+// WithEmbeddingMeter 在 ctx 上挂载回调，记录该 ctx 下所有 embedding 调用的用量
+func (uc *UsageUsecase) WithEmbeddingMeter(ctx context.Context, phone string) context.Context {
+	handler := callbacks.NewHandlerBuilder().OnEndFn(
+		func(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+			out := embedding.ConvCallbackOutput(output)
+			if out == nil || out.TokenUsage == nil {
+				return ctx
+			}
+			var modelName string
+			if out.Config != nil {
+				modelName = out.Config.Model
+			}
+			if err := uc.Record(ctx, &Usage{
+				Phone:            phone,
+				Kind:             UsageEmbedding,
+				ModelName:        modelName,
+				PromptTokens:     int64(out.TokenUsage.PromptTokens),
+				CompletionTokens: int64(out.TokenUsage.CompletionTokens),
+			}); err != nil {
+				zap.L().Error("record embedding usage failed", zap.Error(err))
+			}
+			return ctx
+		}).Build()
+	return callbacks.InitCallbacks(ctx, &callbacks.RunInfo{}, handler)
+}
+
+func exceeded(s UsageSummary, tokenQuota int64, costQuota float64) bool {
+	return (tokenQuota > 0 && s.TotalTokens() >= tokenQuota) || (costQuota > 0 && s.Cost >= costQuota)
+}
+
+func usagePeriods(now time.Time) (day, month time.Time) {
+	y, m, d := now.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), time.Date(y, m, 1, 0, 0, 0, 0, now.Location())
+}
+
+// estimateTokens 粗略估算文本的 token 数：中日韩字符约 0.6 个 token，其余字符约 0.3 个
+func estimateTokens(s string) int64 {
+	var n float64
+	for _, r := range s {
+		if r >= 0x2E80 {
+			n += 0.6
+		} else {
+			n += 0.3
+		}
+	}
+	return int64(math.Ceil(n))
+}
+
+func estimateMessagesTokens(msgs []*schema.Message) int64 {
+	var n int64
+	for _, msg := range msgs {
+		n += estimateTokens(msg.Content)
+	}
+	return n
+}
+
+// usage 返回本次调用的用量，模型未返回 usage 时按输入输出文本估算
+func (t *llmTurn) usage(output string) *Usage {
+	if t.reported.PromptTokens > 0 || t.reported.CompletionTokens > 0 {
+		return &Usage{
+			PromptTokens:     int64(t.reported.PromptTokens),
+			CompletionTokens: int64(t.reported.CompletionTokens),
+		}
+	}
+	return &Usage{
+		PromptTokens:     estimateMessagesTokens(t.prompt),
+		CompletionTokens: estimateTokens(output),
+		Estimated:        true,
+	}
+}
+
+// meterTurn 记录一次发言调用的用量
+func (rs *RoleScheduler) meterTurn(ctx context.Context, turn *llmTurn, output string) {
+	if turn == nil {
+		return
+	}
+	usage := turn.usage(output)
+	llmOutputTokens.WithLabelValues(turn.provider, turn.model).Add(float64(usage.CompletionTokens))
+	kind := UsageParticipant
+	if turn.role.RoleType == MODERATOR {
+		kind = UsageModerator
+	}
+	rs.meter(ctx, kind, turn.role, turn.model, usage)
+	rs.reportCredential(turn.role, nil)
+}
+
+// meter 记录一次模型调用的用量，并在超出配额时暂停讨论
+func (rs *RoleScheduler) meter(ctx context.Context, kind UsageKind, role *Role, modelName string, usage *Usage) {
+	if rs.usage == nil {
+		return
+	}
+	usage.Phone = rs.caller
+	usage.TopicUID = rs.topic.UID
+	usage.Kind = kind
+	usage.ModelName = modelName
+	if role != nil {
+		usage.RoleUID = role.Uid
+	}
+	if err := rs.usage.Record(ctx, usage); err != nil {
+		zap.L().Error("record usage failed", zap.String("topic", rs.topic.UID), zap.Error(err))
+		return
+	}
+	if err := rs.usage.CheckQuota(ctx, rs.caller); err == nil {
+		return
+	} else if err != ErrQuotaExceeded {
+		zap.L().Error("check quota failed", zap.String("topic", rs.topic.UID), zap.Error(err))
+		return
+	}
+	zap.L().Info("quota exceeded, pausing topic", zap.String("topic", rs.topic.UID), zap.String("phone", rs.caller))
+	select {
+	case rs.topic.signalChan <- Pause:
+	default:
+	}
+	if err := rs.brepo.SendToken(ctx, rs.topic.UID, &TokenMessage{
+		TopicUID:    rs.topic.UID,
+		ContentType: "error",
+		Content:     "用量已超出配额，讨论已暂停",
+	}); err != nil {
+		zap.L().Error("send quota notice failed", zap.Error(err))
+	}
+}
+
+// 估算讨论费用时使用的经验值
+const (
+	estimatedSpeechTokens        = 600
+	estimatedSystemPromptTokens  = 1500
+	estimatedSpeakerPromptTokens = 1200
+	estimatedSpeakerOutputTokens = 5
+)
+
+type CostEstimate struct {
+	Speeches         int
+	PromptTokens     int64
+	CompletionTokens int64
+	Cost             float64
+	Currency         string
+}
+
+// EstimateTopicCost 按主持人和参与者交替发言估算讨论后续的用量，
+// speeches 不大于 0 或超过讨论的最大发言次数时按最大发言次数估算
+func (uc *SeminarUsecase) EstimateTopicCost(ctx context.Context, phone, topicUID string, speeches int) (*CostEstimate, error) {
+	topic, err := uc.getOwnedTopic(ctx, phone, topicUID)
+	if err != nil {
+		return nil, err
+	}
+	rolesReply, err := uc.roleClient.GetModeratorAndParticipantsByUIDs(ctx, &roleV1.GetModeratorAndParticipantsByUIDsRequest{Phone: topic.Phone, Moderator: topic.Moderator, Uids: topic.Participants})
+	if err != nil {
+		return nil, err
+	}
+	if len(rolesReply.Participants) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "讨论没有参与者")
+	}
+	maxSpeeches := (maxRunSteps - 1) / 2
+	if speeches <= 0 || speeches > maxSpeeches {
+		speeches = maxSpeeches
+	}
+
+	estimate := &CostEstimate{Speeches: speeches, Currency: uc.usage.conf.Currency}
+	add := func(modelName string, prompt, completion int64) {
+		estimate.PromptTokens += prompt
+		estimate.CompletionTokens += completion
+		estimate.Cost += uc.usage.Cost(modelName, prompt, completion)
+	}
+	history := estimateTokens(topic.Content) + int64(len(topic.Speeches))*estimatedSpeechTokens
+	for i := 0; i < speeches; i++ {
+		modelName := rolesReply.Moderator.GetModel().GetName()
+		if i%2 == 1 {
+			modelName = rolesReply.Participants[(i/2)%len(rolesReply.Participants)].GetModel().GetName()
+		}
+		add(modelName, estimatedSystemPromptTokens+history, estimatedSpeechTokens)
+		if i%2 == 0 {
+			add(speakerModelName, estimatedSpeakerPromptTokens+estimatedSpeechTokens, estimatedSpeakerOutputTokens)
+		}
+		history += estimatedSpeechTokens
+	}
+	return estimate, nil
+}
@@ -13,13 +13,16 @@ func buildMessageContent(speech Speech) string {
 	return fmt.Sprintf("%s:%s", speech.RoleName, speech.Content)
 }
 
-func findNextRoleNameFromMessage(msg string) (string, error) {
+const speakerModelName = "deepseek-chat"
+
+// findNextRoleNameFromMessage 从主持人发言中识别下一位发言者，同时返回本次调用的用量
+func findNextRoleNameFromMessage(msg string) (string, *Usage, error) {
 	cm, err := deepseek.NewChatModel(context.Background(), &deepseek.ChatModelConfig{
 		APIKey: viper.GetString("DEEPSEEK_API_KEY"),
-		Model:  "deepseek-chat",
+		Model:  speakerModelName,
 	})
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	output, err := cm.Generate(context.Background(), []*schema.Message{{
 		Role: schema.System,
@@ -114,7 +117,14 @@ func findNextRoleNameFromMessage(msg string) (string, error) {
 	}, {Role: schema.User, Content: msg}},
 	)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+	usage := &Usage{CompletionTokens: estimateTokens(output.Content), PromptTokens: estimateTokens(msg), Estimated: true}
+	if output.ResponseMeta != nil && output.ResponseMeta.Usage != nil {
+		usage = &Usage{
+			PromptTokens:     int64(output.ResponseMeta.Usage.PromptTokens),
+			CompletionTokens: int64(output.ResponseMeta.Usage.CompletionTokens),
+		}
 	}
-	return output.Content, nil
+	return output.Content, usage, nil
 }
@@ -6,12 +6,45 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/broadcast"
+	"github.com/Fl0rencess720/Ayana/pkgs/kafkatopic"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/segmentio/kafka-go"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	topicOwnerTTL       = 30 * time.Second
+	topicOwnerHeartbeat = 10 * time.Second
+	commandAckTimeout   = 5 * time.Second
+)
+
+// 只有归属者本身才能续期或删除归属记录，启动讨论的锁也只由持有者删除
+var (
+	renewOwnerScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+	deleteIfOwnerScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// topicClaim 当前实例对一个讨论的一次登记，cancel 停止该次登记的心跳
+type topicClaim struct {
+	id     string
+	cancel context.CancelFunc
+}
+
 type broadcastRepo struct {
 	data *Data
 	log  *log.Helper
@@ -24,89 +57,256 @@ func NewBroadcastRepo(data *Data, logger log.Logger) biz.BroadcastRepo {
 	}
 }
 
-func (r *broadcastRepo) AddTopicPauseChannel(ctx context.Context, topicUID string, pauseChan chan biz.StateSignal) error {
+// ClaimTopic 在 Redis 中登记讨论由当前实例运行，并通过心跳续期。
+// 先在本地占位，同一实例上重复的登记直接拒绝，不会替换正在运行的那一次
+func (r *broadcastRepo) ClaimTopic(ctx context.Context, topicUID string) (string, <-chan struct{}, error) {
+	claim := &topicClaim{id: uuid.NewString()}
+	r.data.mu.Lock()
+	if _, ok := r.data.ownedTopics[topicUID]; ok {
+		r.data.mu.Unlock()
+		return "", nil, biz.ErrTopicRunning
+	}
+	r.data.ownedTopics[topicUID] = claim
+	r.data.mu.Unlock()
+
+	if err := r.acquireOwner(ctx, topicUID); err != nil {
+		r.data.mu.Lock()
+		delete(r.data.ownedTopics, topicUID)
+		r.data.mu.Unlock()
+		return "", nil, err
+	}
+
+	hbCtx, cancel := context.WithCancel(context.Background())
 	r.data.mu.Lock()
-	r.data.topicPauseContextMap[topicUID] = pauseChan
+	claim.cancel = cancel
 	r.data.mu.Unlock()
-	return nil
+
+	lost := make(chan struct{})
+	go r.heartbeat(hbCtx, topicUID, lost)
+	return claim.id, lost, nil
+}
+
+// acquireOwner 写入归属记录。记录已属于当前实例说明上一次运行未能删除它，本地没有占位时可以接管
+func (r *broadcastRepo) acquireOwner(ctx context.Context, topicUID string) error {
+	ok, err := r.data.redisClient.SetNX(ctx, topicOwnerKey(topicUID), r.data.instanceID, topicOwnerTTL).Result()
+	if err != nil || ok {
+		return err
+	}
+	owner, err := r.data.redisClient.Get(ctx, topicOwnerKey(topicUID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	if owner != r.data.instanceID {
+		return status.Errorf(codes.AlreadyExists, "讨论正在实例 %s 上运行", owner)
+	}
+	return renewOwnerScript.Run(ctx, r.data.redisClient, []string{topicOwnerKey(topicUID)},
+		r.data.instanceID, topicOwnerTTL.Milliseconds()).Err()
+}
+
+// heartbeat 续期归属记录。记录已属于其他实例，或者连续续期失败超过 TTL 时，
+// 认为当前实例已失去归属并关闭 lost
+func (r *broadcastRepo) heartbeat(ctx context.Context, topicUID string, lost chan<- struct{}) {
+	ticker := time.NewTicker(topicOwnerHeartbeat)
+	defer ticker.Stop()
+	renewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		n, err := renewOwnerScript.Run(ctx, r.data.redisClient, []string{topicOwnerKey(topicUID)},
+			r.data.instanceID, topicOwnerTTL.Milliseconds()).Int()
+		switch {
+		case errors.Is(err, context.Canceled):
+			return
+		case err != nil:
+			zap.L().Error("renew topic owner failed", zap.String("topic", topicUID), zap.Error(err))
+			if time.Since(renewed) < topicOwnerTTL {
+				continue
+			}
+		case n == 1:
+			renewed = time.Now()
+			continue
+		}
+		zap.L().Warn("topic ownership lost", zap.String("topic", topicUID), zap.String("instance", r.data.instanceID))
+		close(lost)
+		return
+	}
 }
 
-func (r *broadcastRepo) DeleteTopicPauseContext(ctx context.Context, topicUID string) error {
+// ReleaseTopic 只释放 claimID 对应的那次登记，登记已被释放时什么也不做
+func (r *broadcastRepo) ReleaseTopic(ctx context.Context, topicUID, claimID string) error {
 	r.data.mu.Lock()
-	delete(r.data.topicPauseContextMap, topicUID)
+	claim, ok := r.data.ownedTopics[topicUID]
+	if !ok || claim.id != claimID {
+		r.data.mu.Unlock()
+		return nil
+	}
+	delete(r.data.ownedTopics, topicUID)
 	r.data.mu.Unlock()
-	return nil
+	if claim.cancel != nil {
+		claim.cancel()
+	}
+	return deleteIfOwnerScript.Run(ctx, r.data.redisClient, []string{topicOwnerKey(topicUID)}, r.data.instanceID).Err()
 }
 
-func (r *broadcastRepo) SendPauseSignalToKafka(ctx context.Context, topic string) error {
-	writer := r.data.kafkaClient.pauseSignalWriter
-	if writer == nil {
-		return errors.New("kafka writer is nil")
+func (r *broadcastRepo) GetTopicOwner(ctx context.Context, topicUID string) (string, error) {
+	owner, err := r.data.redisClient.Get(ctx, topicOwnerKey(topicUID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
 	}
-	return writer.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(topic),
-		Value: []byte(topic),
-	})
+	return owner, err
 }
 
-func (r *broadcastRepo) ReadPauseSignalFromKafka(ctx context.Context) error {
-	reader := r.data.kafkaClient.pauseSignalReader
-	if reader == nil {
-		return errors.New("kafka reader is nil")
+// SendCommand 发布命令并等待目标实例确认，超时返回错误
+func (r *broadcastRepo) SendCommand(ctx context.Context, cmd *biz.TopicCommand) (*biz.CommandAck, error) {
+	data, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, err
 	}
+	ackChan := make(chan *biz.CommandAck, 1)
+	r.data.mu.Lock()
+	r.data.pendingAcks[cmd.ID] = ackChan
+	r.data.mu.Unlock()
+	defer func() {
+		r.data.mu.Lock()
+		delete(r.data.pendingAcks, cmd.ID)
+		r.data.mu.Unlock()
+	}()
+
+	if err := r.data.broker.Publish(ctx, kafkatopic.COMMAND_TOPIC, broadcast.Message{Key: cmd.TopicUID, Value: data}); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(commandAckTimeout)
+	defer timer.Stop()
+	select {
+	case ack := <-ackChan:
+		return ack, nil
+	case <-timer.C:
+		return nil, status.Errorf(codes.DeadlineExceeded, "等待实例 %s 确认命令超时", cmd.Target)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ReadCommands 处理发给当前实例的命令并回复确认，同时把其他实例的确认分发给等待中的 SendCommand
+func (r *broadcastRepo) ReadCommands(ctx context.Context, handler biz.CommandHandler) error {
+	ackSub, err := r.data.broker.Subscribe(ctx, kafkatopic.COMMAND_ACK_TOPIC, "")
+	if err != nil {
+		return err
+	}
+	defer ackSub.Close()
+	cmdSub, err := r.data.broker.Subscribe(ctx, kafkatopic.COMMAND_TOPIC, "")
+	if err != nil {
+		return err
+	}
+	defer cmdSub.Close()
+
+	errChan := make(chan error, 2)
+	go func() {
+		errChan <- r.readAcks(ctx, ackSub)
+	}()
+	go func() {
+		errChan <- r.readCommands(ctx, cmdSub, handler)
+	}()
+	return <-errChan
+}
+
+func (r *broadcastRepo) readAcks(ctx context.Context, sub broadcast.Subscription) error {
 	for {
-		msg, err := reader.ReadMessage(ctx)
+		msg, err := sub.Next(ctx)
 		if err != nil {
-			switch {
-			case errors.Is(err, context.Canceled):
-				return nil
-			case errors.Is(err, io.EOF):
-				return fmt.Errorf("kafka connection closed: %w", err)
+			return subscriptionError(err)
+		}
+		var ack biz.CommandAck
+		if err := json.Unmarshal(msg.Value, &ack); err != nil {
+			zap.L().Error("failed to unmarshal command ack", zap.Error(err))
+			continue
+		}
+		r.data.mu.Lock()
+		ackChan, ok := r.data.pendingAcks[ack.ID]
+		r.data.mu.Unlock()
+		if ok {
+			select {
+			case ackChan <- &ack:
 			default:
-				return fmt.Errorf("kafka read error: %w", err)
 			}
 		}
+	}
+}
 
-		if c, ok := r.data.topicPauseContextMap[string(msg.Key)]; ok {
-			c <- biz.Pause
+func (r *broadcastRepo) readCommands(ctx context.Context, sub broadcast.Subscription, handler biz.CommandHandler) error {
+	for {
+		msg, err := sub.Next(ctx)
+		if err != nil {
+			return subscriptionError(err)
+		}
+		var cmd biz.TopicCommand
+		if err := json.Unmarshal(msg.Value, &cmd); err != nil {
+			zap.L().Error("failed to unmarshal topic command", zap.Error(err))
+			continue
+		}
+		if cmd.Target != r.data.instanceID {
+			continue
+		}
+		ack := biz.CommandAck{ID: cmd.ID}
+		if err := handler(ctx, &cmd); err != nil {
+			ack.Error = err.Error()
+			if s, ok := status.FromError(err); ok {
+				ack.Error = s.Message()
+			}
+		}
+		data, err := json.Marshal(ack)
+		if err != nil {
+			return err
+		}
+		if err := r.data.broker.Publish(ctx, kafkatopic.COMMAND_ACK_TOPIC, broadcast.Message{Key: cmd.ID, Value: data}); err != nil {
+			zap.L().Error("failed to publish command ack", zap.Error(err))
 		}
 	}
 }
 
-func (r *broadcastRepo) SendTokenToKafka(ctx context.Context, topic string, message *biz.TokenMessage) error {
-	writer := r.data.kafkaClient.kafkaWriter
-	if writer == nil {
-		return errors.New("kafka writer is nil")
+func subscriptionError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return nil
+	case errors.Is(err, io.EOF), errors.Is(err, broadcast.ErrClosed):
+		return fmt.Errorf("broadcast connection closed: %w", err)
+	default:
+		return fmt.Errorf("broadcast read error: %w", err)
 	}
+}
+
+func topicOwnerKey(topicUID string) string {
+	return fmt.Sprintf("AyanaTopicOwner:%s", topicUID)
+}
+
+func (r *broadcastRepo) SendToken(ctx context.Context, topic string, message *biz.TokenMessage) error {
 	data, err := json.Marshal(message)
 	if err != nil {
 		return err
 	}
-	if err := writer.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(topic),
+	return r.data.broker.Publish(ctx, kafkatopic.TOPIC, broadcast.Message{
+		Key:   topic,
 		Value: data,
-	}); err != nil {
-		return err
-	}
-	return nil
+	})
 }
 
-func (r *broadcastRepo) SendTokensToKafkaBatch(ctx context.Context, topicUID string, tokens []*biz.TokenMessage) error {
-
-	writer := r.data.kafkaClient.kafkaWriter
-
-	kafkaMessages := make([]kafka.Message, 0, len(tokens))
+func (r *broadcastRepo) SendTokensBatch(ctx context.Context, topicUID string, tokens []*biz.TokenMessage) error {
+	messages := make([]broadcast.Message, 0, len(tokens))
 	for _, token := range tokens {
 		data, err := json.Marshal(token)
 		if err != nil {
 			return err
 		}
 
-		kafkaMessages = append(kafkaMessages, kafka.Message{
-			Key:   []byte(topicUID),
+		messages = append(messages, broadcast.Message{
+			Key:   topicUID,
 			Value: data,
 		})
 	}
 
-	return writer.WriteMessages(ctx, kafkaMessages...)
+	return r.data.broker.Publish(ctx, kafkatopic.TOPIC, messages...)
 }
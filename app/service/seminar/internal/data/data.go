@@ -8,7 +8,10 @@ import (
 	roleV1 "github.com/Fl0rencess720/Ayana/api/gateway/role/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/biz"
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/conf"
-	"github.com/Fl0rencess720/Ayana/pkgs/kafkatopic"
+	"github.com/Fl0rencess720/Ayana/pkgs/broadcast"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
 	embedding "github.com/cloudwego/eino-ext/components/embedding/ark"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -20,7 +23,6 @@ import (
 	"github.com/milvus-io/milvus/client/v2/index"
 	"github.com/milvus-io/milvus/client/v2/milvusclient"
 	"github.com/redis/go-redis/v9"
-	"github.com/segmentio/kafka-go"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	grpcx "google.golang.org/grpc"
@@ -29,14 +31,8 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewSeminarRepo, NewRAGRepo, NewMysql, NewRedis,
-	NewEmbedder, NewIndexer, NewRetriever, NewRoleServiceClient, NewBroadcastRepo, NewMilvus, NewKafkaClient)
-
-type kafkaClient struct {
-	kafkaWriter       *kafka.Writer
-	pauseSignalReader *kafka.Reader
-	pauseSignalWriter *kafka.Writer
-}
+var ProviderSet = wire.NewSet(NewData, NewKeyring, NewSeminarRepo, NewRAGRepo, NewUsageRepo, NewMysql, NewRedis,
+	NewEmbedder, NewIndexer, NewRetriever, NewRoleServiceClient, NewBroadcastRepo, NewMilvus, NewBroker)
 
 type HybridRetriever struct {
 	Client    *milvusclient.Client
@@ -53,7 +49,7 @@ type HybridIndexer struct {
 type Data struct {
 	mysqlClient  *gorm.DB
 	redisClient  *redis.Client
-	kafkaClient  *kafkaClient
+	broker       broadcast.Broker
 	milvusClient *milvusclient.Client
 	embedder     *embedding.Embedder
 
@@ -62,39 +58,34 @@ type Data struct {
 
 	roleClient roleV1.RoleManagerClient
 
-	mu                   sync.Mutex
-	topicPauseContextMap map[string]chan biz.StateSignal
+	// instanceID 标识当前 seminar 实例，用于讨论归属登记和命令路由
+	instanceID  string
+	mu          sync.Mutex
+	ownedTopics map[string]*topicClaim
+	pendingAcks map[string]chan *biz.CommandAck
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, mysqlClient *gorm.DB, redisClient *redis.Client, milvusClient *milvusclient.Client, kafkaClient *kafkaClient, roleClient roleV1.RoleManagerClient,
+func NewData(c *conf.Data, logger log.Logger, mysqlClient *gorm.DB, redisClient *redis.Client, milvusClient *milvusclient.Client, broker broadcast.Broker, roleClient roleV1.RoleManagerClient,
 	embedder *embedding.Embedder, indexer *HybridIndexer, retriever *HybridRetriever) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := broker.Close(); err != nil {
+			zap.L().Error("failed to close broadcast broker", zap.Error(err))
+		}
 	}
-	topicPauseContextMap := make(map[string]chan biz.StateSignal)
-	return &Data{mysqlClient: mysqlClient, redisClient: redisClient, milvusClient: milvusClient, kafkaClient: kafkaClient, roleClient: roleClient, embedder: embedder, indexer: indexer, retriever: retriever, topicPauseContextMap: topicPauseContextMap}, cleanup, nil
+	return &Data{mysqlClient: mysqlClient, redisClient: redisClient, milvusClient: milvusClient, broker: broker, roleClient: roleClient, embedder: embedder, indexer: indexer, retriever: retriever,
+		instanceID: utils.NewInstanceID(), ownedTopics: make(map[string]*topicClaim), pendingAcks: make(map[string]chan *biz.CommandAck)}, cleanup, nil
 }
 
-func NewKafkaClient(c *conf.Data) *kafkaClient {
-	writer := kafka.NewWriter(kafka.WriterConfig{
-		Brokers: []string{c.Kafka.Addr},
-		Topic:   kafkatopic.TOPIC,
-		Async:   true,
-	})
-	pauseSignalReader := kafka.NewReader(kafka.ReaderConfig{
-		Brokers: []string{c.Kafka.Addr},
-		Topic:   kafkatopic.PAUSE_SIGNAL_TOPIC,
-	})
-	pauseSignalWriter := kafka.NewWriter(kafka.WriterConfig{
-		Brokers: []string{c.Kafka.Addr},
-		Topic:   kafkatopic.PAUSE_SIGNAL_TOPIC,
-		Async:   true,
-	})
-	return &kafkaClient{
-		kafkaWriter:       writer,
-		pauseSignalReader: pauseSignalReader,
-		pauseSignalWriter: pauseSignalWriter,
+func NewBroker(c *conf.Data, redisClient *redis.Client) broadcast.Broker {
+	switch c.Broadcast.GetDriver() {
+	case broadcast.DriverMemory:
+		return broadcast.NewMemoryBroker()
+	case broadcast.DriverRedis:
+		return broadcast.NewRedisBroker(redisClient)
+	default:
+		return broadcast.NewKafkaBroker([]string{c.Kafka.Addr})
 	}
 }
 
@@ -133,7 +124,7 @@ func NewMysql(c *conf.Data) *gorm.DB {
 	if err != nil {
 		panic("failed to connect mysql")
 	}
-	if err := db.AutoMigrate(&biz.Topic{}, &biz.Speech{}, &biz.Document{}, &biz.LoadDocument{}, &biz.MCPServer{}); err != nil {
+	if err := db.AutoMigrate(&biz.Topic{}, &biz.Speech{}, &biz.Document{}, &biz.LoadDocument{}, &biz.RoleDocument{}, &biz.MCPServer{}, &biz.Usage{}, &biz.TopicRun{}, &biz.TopicRunRole{}); err != nil {
 		panic("failed to migrate mysql")
 	}
 
@@ -218,6 +209,7 @@ func NewRoleServiceClient(sr *conf.Service, rr registry.Discovery) roleV1.RoleMa
 		grpc.WithDiscovery(rr),
 		grpc.WithMiddleware(
 			tracing.Client(),
+			identity.Client(),
 			recovery.Recovery(),
 		),
 		grpc.WithTimeout(2*time.Second),
@@ -229,3 +221,8 @@ func NewRoleServiceClient(sr *conf.Service, rr registry.Discovery) roleV1.RoleMa
 	c := roleV1.NewRoleManagerClient(conn)
 	return c
 }
+
+// NewKeyring 加载角色 API Key 和 MCP 请求头加密使用的主密钥
+func NewKeyring(c *conf.Secret) (*secret.Keyring, error) {
+	return secret.NewKeyring(c.CurrentKey, c.MasterKeys)
+}
@@ -59,7 +59,7 @@ func (r *ragRepo) GetDocumentsFromMysql(ctx context.Context, phone string) ([]bi
 	return documents, nil
 }
 
-func (r *ragRepo) RetrieveDocuments(ctx context.Context, uid, query string) ([]*schema.Document, error) {
+func (r *ragRepo) RetrieveDocuments(ctx context.Context, query, uid string) ([]*schema.Document, error) {
 	filter := `uid == "` + uid + `"`
 	docs, err := r.data.retriever.Retrieve(ctx, query, filter)
 	if err != nil {
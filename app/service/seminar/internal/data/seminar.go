@@ -3,12 +3,17 @@ package data
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/secret"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
 
+// topicLockTTL 启动讨论的锁只保护归属登记，远短于讨论本身的运行时间
+const topicLockTTL = 30 * time.Second
+
 type seminarRepo struct {
 	data *Data
 	log  *log.Helper
@@ -36,6 +41,17 @@ func (r *seminarRepo) CreateTopic(ctx context.Context, phone string, documents [
 				return err
 			}
 		}
+		for roleUID, documents := range topic.RoleDocuments {
+			for _, document := range documents {
+				if err := tx.Create(&biz.RoleDocument{
+					TopicUID:    topic.UID,
+					RoleUID:     roleUID,
+					DocumentUID: document,
+				}).Error; err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -49,10 +65,12 @@ func (r *seminarRepo) CreateTopic(ctx context.Context, phone string, documents [
 }
 
 func (r *seminarRepo) DeleteTopic(ctx context.Context, topicUID string) error {
-	if err := r.data.mysqlClient.Model(&biz.Topic{}).Where("uid = ?", topicUID).Unscoped().Delete(&biz.Topic{}).Error; err != nil {
-		return err
-	}
-	return nil
+	return r.data.mysqlClient.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&biz.Topic{}).Where("uid = ?", topicUID).Unscoped().Delete(&biz.Topic{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("topic_uid = ?", topicUID).Delete(&biz.RoleDocument{}).Error
+	})
 }
 
 func (r *seminarRepo) GetTopic(ctx context.Context, uid string) (*biz.Topic, error) {
@@ -60,6 +78,16 @@ func (r *seminarRepo) GetTopic(ctx context.Context, uid string) (*biz.Topic, err
 	if err := r.data.mysqlClient.Model(topic).Preload("Speeches").Preload("Documents").Where("uid = ?", uid).First(&topic).Error; err != nil {
 		return nil, err
 	}
+	var roleDocuments []biz.RoleDocument
+	if err := r.data.mysqlClient.Where("topic_uid = ?", uid).Find(&roleDocuments).Error; err != nil {
+		return nil, err
+	}
+	if len(roleDocuments) > 0 {
+		topic.RoleDocuments = make(map[string][]string)
+	}
+	for _, rd := range roleDocuments {
+		topic.RoleDocuments[rd.RoleUID] = append(topic.RoleDocuments[rd.RoleUID], rd.DocumentUID)
+	}
 
 	return topic, nil
 }
@@ -85,25 +113,35 @@ func (r *seminarRepo) SaveSpeechToRedis(ctx context.Context, speech *biz.Speech)
 	return nil
 }
 
+// LockTopic 锁已被其他请求持有时返回 ErrTopicRunning，锁带有过期时间，持有者崩溃后不会一直占用
 func (r *seminarRepo) LockTopic(ctx context.Context, topicUID string, lockerUID string) error {
-	if err := r.data.redisClient.SetNX(ctx, topicUID, lockerUID, 0).Err(); err != nil {
+	ok, err := r.data.redisClient.SetNX(ctx, topicLockKey(topicUID), lockerUID, topicLockTTL).Result()
+	if err != nil {
 		return err
 	}
+	if !ok {
+		return biz.ErrTopicRunning
+	}
 	return nil
 }
 
+// UnlockTopic 只删除 lockerUID 自己持有的锁
 func (r *seminarRepo) UnlockTopic(topicUID string, lockerUID string) error {
 	ctx := context.Background()
-	res, err := r.data.redisClient.Del(ctx, topicUID).Result()
+	res, err := deleteIfOwnerScript.Run(ctx, r.data.redisClient, []string{topicLockKey(topicUID)}, lockerUID).Int()
 	if err != nil {
 		return fmt.Errorf("unlock failed: %v", err)
 	}
 	if res != 1 {
-		return fmt.Errorf("unlock failed: key does not exist")
+		return fmt.Errorf("unlock failed: lock is not held by %s", lockerUID)
 	}
 	return nil
 }
 
+func topicLockKey(topicUID string) string {
+	return fmt.Sprintf("AyanaTopicLock:%s", topicUID)
+}
+
 func (r *seminarRepo) AddMCPServerToMysql(ctx context.Context, server *biz.MCPServer) error {
 	if err := r.data.mysqlClient.Create(server).Error; err != nil {
 		return err
@@ -132,9 +170,87 @@ func (r *seminarRepo) EnableMCPServerInMysql(ctx context.Context, phone, uid str
 	}
 	return nil
 }
+
+// GetMCPServersNeedingRotation 找出明文存储或不是由当前主密钥包装的请求头
+func (r *seminarRepo) GetMCPServersNeedingRotation(ctx context.Context, keyring *secret.Keyring) ([]biz.MCPServer, error) {
+	var servers []biz.MCPServer
+	if err := r.data.mysqlClient.Where("request_header <> ''").Find(&servers).Error; err != nil {
+		return nil, err
+	}
+	result := []biz.MCPServer{}
+	for _, server := range servers {
+		if keyring.NeedsRotation(server.RequestHeader) {
+			result = append(result, server)
+		}
+	}
+	return result, nil
+}
+
+func (r *seminarRepo) UpdateMCPServerRequestHeader(ctx context.Context, id uint, requestHeader, requestHeaderMask string) error {
+	return r.data.mysqlClient.Model(&biz.MCPServer{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"request_header": requestHeader, "request_header_mask": requestHeaderMask}).Error
+}
+
 func (r *seminarRepo) DisableMCPServerInMysql(ctx context.Context, phone, uid string) error {
 	if err := r.data.mysqlClient.Model(&biz.MCPServer{}).Where("phone = ? and uid = ?", phone, uid).Update("status", 0).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+func (r *seminarRepo) CreateTopicRun(ctx context.Context, run *biz.TopicRun) error {
+	return r.data.mysqlClient.Create(run).Error
+}
+
+func (r *seminarRepo) FinishTopicRun(ctx context.Context, runUID string, at time.Time) error {
+	return r.data.mysqlClient.Model(&biz.TopicRun{}).Where("uid = ?", runUID).Update("ended_at", at).Error
+}
+
+func (r *seminarRepo) GetTopicRuns(ctx context.Context, topicUID string) ([]biz.TopicRun, error) {
+	var runs []biz.TopicRun
+	if err := r.data.mysqlClient.Preload("Roles").Where("topic_uid = ?", topicUID).Order("started_at").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func (r *seminarRepo) GetUnfinishedTopicRuns(ctx context.Context, phone string) ([]biz.RunningTopic, error) {
+	var topics []biz.RunningTopic
+	db := r.data.mysqlClient.Table("topic_runs AS r").
+		Select("r.topic_uid, r.uid AS run_uid, r.phone, t.content, r.started_at").
+		Joins("JOIN topics AS t ON t.uid = r.topic_uid AND t.deleted_at IS NULL").
+		Where("r.ended_at IS NULL AND r.deleted_at IS NULL")
+	if phone != "" {
+		db = db.Where("r.phone = ?", phone)
+	}
+	if err := db.Order("r.started_at DESC").Scan(&topics).Error; err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+// UpdateTopicDocuments 删除讨论原有的私有资料记录后重新写入
+func (r *seminarRepo) UpdateTopicDocuments(ctx context.Context, topicUID string, roleDocuments map[string][]string, moderatorShared bool) error {
+	return r.data.mysqlClient.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("topic_uid = ?", topicUID).Delete(&biz.RoleDocument{}).Error; err != nil {
+			return err
+		}
+		for roleUID, documents := range roleDocuments {
+			for _, document := range documents {
+				if err := tx.Create(&biz.RoleDocument{
+					TopicUID:    topicUID,
+					RoleUID:     roleUID,
+					DocumentUID: document,
+				}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Model(&biz.Topic{}).Where("uid = ?", topicUID).Update("moderator_shared_docs", moderatorShared).Error
+	})
+}
+
+func (r *seminarRepo) UpdateTopicTools(ctx context.Context, topicUID string, mcpServers []string, roleTools map[string][]string) error {
+	return r.data.mysqlClient.Model(&biz.Topic{}).Where("uid = ?", topicUID).
+		Select("mcp_servers", "role_tools").Updates(&biz.Topic{MCPServers: mcpServers, RoleTools: roleTools}).Error
+}
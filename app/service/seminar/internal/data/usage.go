@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type usageRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUsageRepo(data *Data, logger log.Logger) biz.UsageRepo {
+	return &usageRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *usageRepo) SaveUsage(ctx context.Context, usage *biz.Usage) error {
+	return r.data.mysqlClient.WithContext(ctx).Create(usage).Error
+}
+
+func (r *usageRepo) SumUsage(ctx context.Context, phone string, since time.Time) (biz.UsageSummary, error) {
+	var summary biz.UsageSummary
+	if err := r.data.mysqlClient.WithContext(ctx).Model(&biz.Usage{}).
+		Select("COALESCE(SUM(prompt_tokens), 0) AS prompt_tokens, COALESCE(SUM(completion_tokens), 0) AS completion_tokens, COALESCE(SUM(cost), 0) AS cost").
+		Where("phone = ? AND time >= ?", phone, since).
+		Scan(&summary).Error; err != nil {
+		return biz.UsageSummary{}, err
+	}
+	return summary, nil
+}
+
+func (r *usageRepo) GroupUsageByModel(ctx context.Context, phone string, since time.Time) ([]biz.ModelUsage, error) {
+	var rows []struct {
+		ModelName        string
+		Kind             biz.UsageKind
+		PromptTokens     int64
+		CompletionTokens int64
+		Cost             float64
+	}
+	if err := r.data.mysqlClient.WithContext(ctx).Model(&biz.Usage{}).
+		Select("model_name, kind, SUM(prompt_tokens) AS prompt_tokens, SUM(completion_tokens) AS completion_tokens, SUM(cost) AS cost").
+		Where("phone = ? AND time >= ?", phone, since).
+		Group("model_name, kind").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	usages := make([]biz.ModelUsage, 0, len(rows))
+	for _, row := range rows {
+		usages = append(usages, biz.ModelUsage{
+			ModelName: row.ModelName,
+			Kind:      row.Kind,
+			UsageSummary: biz.UsageSummary{
+				PromptTokens:     row.PromptTokens,
+				CompletionTokens: row.CompletionTokens,
+				Cost:             row.Cost,
+			},
+		})
+	}
+	return usages, nil
+}
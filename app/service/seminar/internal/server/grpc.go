@@ -4,6 +4,8 @@ import (
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/conf"
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/service"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+	"github.com/Fl0rencess720/Ayana/pkgs/metrics"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
@@ -18,6 +20,8 @@ func NewGRPCServer(c *conf.Server, seminar *service.SeminarService, logger log.L
 		grpc.Middleware(
 			recovery.Recovery(),
 			tracing.Server(),
+			metrics.Server(),
+			identity.Server(),
 			ratelimit.Server(),
 		),
 	}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/conf"
+	"github.com/Fl0rencess720/Ayana/pkgs/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// NewHTTPServer new an HTTP server that only serves /metrics.
+func NewHTTPServer(c *conf.Server, logger log.Logger) *http.Server {
+	var opts = []http.ServerOption{}
+	if c.Http.Network != "" {
+		opts = append(opts, http.Network(c.Http.Network))
+	}
+	if c.Http.Addr != "" {
+		opts = append(opts, http.Address(c.Http.Addr))
+	}
+	if c.Http.Timeout != nil {
+		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
+	}
+	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", metrics.Handler())
+	return srv
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
+)
+
+// 管理接口的权限由网关校验，这里不再区分调用者
+
+func (s *SeminarService) AdminGetUsage(ctx context.Context, req *v1.AdminGetUsageRequest) (*v1.GetUsageReply, error) {
+	report, err := s.usage.GetUsage(ctx, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+	return toUsageReply(report), nil
+}
+
+func (s *SeminarService) AdminGetRunningTopics(ctx context.Context, req *v1.AdminGetRunningTopicsRequest) (*v1.AdminGetRunningTopicsReply, error) {
+	topics, err := s.uc.GetRunningTopics(ctx, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.AdminGetRunningTopicsReply{Topics: make([]*v1.RunningTopic, 0, len(topics))}
+	for _, t := range topics {
+		reply.Topics = append(reply.Topics, &v1.RunningTopic{
+			TopicId:   t.TopicUID,
+			RunUid:    t.RunUID,
+			Phone:     t.Phone,
+			Content:   t.Content,
+			StartedAt: t.StartedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) AdminStopTopic(ctx context.Context, req *v1.AdminStopTopicRequest) (*v1.AdminStopTopicReply, error) {
+	if err := s.uc.ForceStopTopic(ctx, req.TopicId); err != nil {
+		return nil, err
+	}
+	return &v1.AdminStopTopicReply{Message: "success"}, nil
+}
@@ -8,17 +8,20 @@ import (
 
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/seminar/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/seminar/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
 )
 
 type SeminarService struct {
 	v1.UnimplementedSeminarServer
 
-	uc  *biz.SeminarUsecase
-	ruc *biz.RAGUsecase
+	uc    *biz.SeminarUsecase
+	ruc   *biz.RAGUsecase
+	usage *biz.UsageUsecase
 }
 
-func NewSeminarService(uc *biz.SeminarUsecase, ruc *biz.RAGUsecase) *SeminarService {
-	return &SeminarService{uc: uc, ruc: ruc}
+func NewSeminarService(uc *biz.SeminarUsecase, ruc *biz.RAGUsecase, usage *biz.UsageUsecase) *SeminarService {
+	return &SeminarService{uc: uc, ruc: ruc, usage: usage}
 }
 
 func (s *SeminarService) CreateTopic(ctx context.Context, req *v1.CreateTopicRequest) (*v1.CreateTopicReply, error) {
@@ -26,32 +29,58 @@ func (s *SeminarService) CreateTopic(ctx context.Context, req *v1.CreateTopicReq
 	if err != nil {
 		return nil, err
 	}
-	s.uc.CreateTopic(ctx, req.Phone, req.Documents, topic)
+	topic.MCPServers = req.McpServers
+	topic.RoleTools = fromRoleTools(req.RoleTools)
+	topic.RoleDocuments = fromRoleDocuments(req.RoleDocuments)
+	topic.ModeratorSharedDocs = req.ModeratorSharedDocuments
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.CreateTopic(ctx, owner, req.Documents, topic); err != nil {
+		return nil, err
+	}
 	return &v1.CreateTopicReply{Uid: topic.UID}, nil
 }
 func (s *SeminarService) DeleteTopic(ctx context.Context, req *v1.DeleteTopicRequest) (*v1.DeleteTopicReply, error) {
-	if err := s.uc.DeleteTopic(ctx, req.Uid); err != nil {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteTopic(ctx, owner, req.Uid); err != nil {
 		return nil, err
 	}
 	return &v1.DeleteTopicReply{Message: "success"}, nil
 }
 func (s *SeminarService) GetTopic(ctx context.Context, req *v1.GetTopicRequest) (*v1.GetTopicReply, error) {
-	topic, err := s.uc.GetTopic(ctx, req.Uid)
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := s.uc.GetTopic(ctx, owner, req.Uid)
 	if err != nil {
 		return nil, err
 	}
 	reply := &v1.GetTopicReply{Topic: &v1.Topic{
-		Uid:          topic.UID,
-		Content:      topic.Content,
-		Participants: topic.Participants,
-		Title:        topic.Title,
-		TitleImage:   topic.TitleImage,
+		Uid:                      topic.UID,
+		Content:                  topic.Content,
+		Participants:             topic.Participants,
+		Title:                    topic.Title,
+		TitleImage:               topic.TitleImage,
+		McpServers:               topic.MCPServers,
+		RoleTools:                toRoleTools(topic.RoleTools),
+		ModeratorSharedDocuments: topic.ModeratorSharedDocs,
 	}}
+	for roleUID, documents := range topic.RoleDocuments {
+		reply.Topic.RoleDocuments = append(reply.Topic.RoleDocuments, &v1.RoleDocuments{RoleUid: roleUID, Documents: documents})
+	}
 	for _, speech := range topic.Speeches {
 		reply.Topic.Speeches = append(reply.Topic.Speeches, &v1.Speech{
-			Uid:     speech.UID,
-			RoleUid: speech.RoleUID,
-			Content: speech.Content,
+			Uid:         speech.UID,
+			RoleUid:     speech.RoleUID,
+			Content:     speech.Content,
+			RoleVersion: speech.RoleVersion,
+			RunUid:      speech.RunUID,
 		})
 	}
 	for _, document := range topic.Documents {
@@ -64,7 +93,11 @@ func (s *SeminarService) GetTopic(ctx context.Context, req *v1.GetTopicRequest)
 	return reply, nil
 }
 func (s *SeminarService) GetTopicsMetadata(ctx context.Context, req *v1.GetTopicsMetadataRequest) (*v1.GetTopicsMetadataReply, error) {
-	topics, err := s.uc.GetTopicsMetadata(ctx, req.Phone)
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := s.uc.GetTopicsMetadata(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +117,8 @@ func (s *SeminarService) GetTopicsMetadata(ctx context.Context, req *v1.GetTopic
 			Moderator:    topic.Moderator,
 			Content:      topic.Content,
 			Documents:    documents,
+			Title:        topic.Title,
+			TitleImage:   topic.TitleImage,
 		})
 	}
 	return reply, nil
@@ -94,7 +129,15 @@ func (s *SeminarService) StartTopic(ctx context.Context, req *v1.StartTopicReque
 			log.Errorf("panic: %v", r)
 		}
 	}()
-	if err := s.uc.StartTopic(ctx, req.Phone, req.TopicId); err != nil {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.StartTopic(ctx, owner, caller, req.TopicId); err != nil {
 		return nil, err
 	}
 	return &v1.StartTopicReply{
@@ -103,12 +146,27 @@ func (s *SeminarService) StartTopic(ctx context.Context, req *v1.StartTopicReque
 }
 
 func (s *SeminarService) StopTopic(ctx context.Context, req *v1.StopTopicRequest) (*v1.StopTopicReply, error) {
-	if err := s.uc.StopTopic(ctx, req.TopicId); err != nil {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.StopTopic(ctx, owner, req.TopicId); err != nil {
 		return nil, err
 	}
 	return &v1.StopTopicReply{Message: "success"}, nil
 }
 
+func (s *SeminarService) InterjectTopic(ctx context.Context, req *v1.InterjectTopicRequest) (*v1.InterjectTopicReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.InterjectTopic(ctx, owner, req.TopicId, req.Content); err != nil {
+		return nil, err
+	}
+	return &v1.InterjectTopicReply{Message: "success"}, nil
+}
+
 func (s *SeminarService) UploadDocument(stream v1.Seminar_UploadDocumentServer) error {
 	defer func() {
 		if r := recover(); r != nil {
@@ -125,7 +183,11 @@ func (s *SeminarService) UploadDocument(stream v1.Seminar_UploadDocumentServer)
 }
 
 func (s *SeminarService) GetDocuments(ctx context.Context, req *v1.GetDocumentsRequest) (*v1.GetDocumentsReply, error) {
-	documents, err := s.ruc.GetDocuments(ctx, req.Phone)
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := s.ruc.GetDocuments(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -141,14 +203,22 @@ func (s *SeminarService) GetDocuments(ctx context.Context, req *v1.GetDocumentsR
 }
 
 func (s *SeminarService) AddMCPServer(ctx context.Context, req *v1.AddMCPServerReqeust) (*v1.AddMCPServerReply, error) {
-	if err := s.uc.AddMCPServer(ctx, req.Phone, req.Name, req.Url, req.RequestHeader); err != nil {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.AddMCPServer(ctx, owner, req.Name, req.Url, req.RequestHeader); err != nil {
 		return nil, err
 	}
 	return &v1.AddMCPServerReply{Message: "success"}, nil
 }
 
 func (s *SeminarService) GetMCPServers(ctx context.Context, req *v1.GetMCPServersRequest) (*v1.GetMCPServersReply, error) {
-	servers, err := s.uc.GetMCPServers(ctx, req.Phone)
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := s.uc.GetMCPServers(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +226,7 @@ func (s *SeminarService) GetMCPServers(ctx context.Context, req *v1.GetMCPServer
 	for _, server := range servers {
 		reply.Servers = append(reply.Servers, &v1.MCPServer{
 			Name:          server.Name,
-			RequestHeader: server.RequestHeader,
+			RequestHeader: server.RequestHeaderMask,
 			Url:           server.URL,
 			Uid:           server.UID,
 			Status:        server.Status,
@@ -165,6 +235,73 @@ func (s *SeminarService) GetMCPServers(ctx context.Context, req *v1.GetMCPServer
 	return reply, nil
 }
 
+func (s *SeminarService) GetMCPServerTools(ctx context.Context, req *v1.GetMCPServerToolsRequest) (*v1.GetMCPServerToolsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	tools, err := s.uc.GetMCPServerTools(ctx, owner, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetMCPServerToolsReply{}
+	for _, t := range tools {
+		reply.Tools = append(reply.Tools, &v1.MCPTool{Name: t.Name, Description: t.Desc})
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) SetTopicTools(ctx context.Context, req *v1.SetTopicToolsRequest) (*v1.SetTopicToolsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.SetTopicTools(ctx, owner, req.TopicId, req.McpServers, fromRoleTools(req.RoleTools)); err != nil {
+		return nil, err
+	}
+	return &v1.SetTopicToolsReply{Message: "success"}, nil
+}
+
+func (s *SeminarService) SetTopicDocuments(ctx context.Context, req *v1.SetTopicDocumentsRequest) (*v1.SetTopicDocumentsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.SetTopicDocuments(ctx, owner, req.TopicId, fromRoleDocuments(req.RoleDocuments), req.ModeratorSharedDocuments); err != nil {
+		return nil, err
+	}
+	return &v1.SetTopicDocumentsReply{Message: "success"}, nil
+}
+
+func fromRoleTools(roleTools []*v1.RoleTools) map[string][]string {
+	if len(roleTools) == 0 {
+		return nil
+	}
+	result := make(map[string][]string, len(roleTools))
+	for _, rt := range roleTools {
+		result[rt.RoleUid] = append(result[rt.RoleUid], rt.Tools...)
+	}
+	return result
+}
+
+func fromRoleDocuments(roleDocuments []*v1.RoleDocuments) map[string][]string {
+	result := make(map[string][]string, len(roleDocuments))
+	for _, rd := range roleDocuments {
+		if len(rd.Documents) > 0 {
+			result[rd.RoleUid] = append(result[rd.RoleUid], rd.Documents...)
+		}
+	}
+	return result
+}
+
+func toRoleTools(roleTools map[string][]string) []*v1.RoleTools {
+	result := make([]*v1.RoleTools, 0, len(roleTools))
+	for roleUID, tools := range roleTools {
+		result = append(result, &v1.RoleTools{RoleUid: roleUID, Tools: tools})
+	}
+	return result
+}
+
 func (s *SeminarService) CheckMCPServerHealth(ctx context.Context, req *v1.CheckMCPServerHealthReqeust) (*v1.CheckMCPServerHealthReply, error) {
 	health, err := s.uc.CheckMCPServerHealth(ctx, req.Url)
 	if err != nil {
@@ -175,7 +312,11 @@ func (s *SeminarService) CheckMCPServerHealth(ctx context.Context, req *v1.Check
 }
 
 func (s *SeminarService) DeleteMCPServer(ctx context.Context, req *v1.DeleteMCPServerRequest) (*v1.DeleteMCPServerReply, error) {
-	err := s.uc.DeleteMCPServer(ctx, req.Phone, req.Uid)
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uc.DeleteMCPServer(ctx, owner, req.Uid)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +325,11 @@ func (s *SeminarService) DeleteMCPServer(ctx context.Context, req *v1.DeleteMCPS
 }
 
 func (s *SeminarService) EnableMCPServer(ctx context.Context, req *v1.EnableMCPServerRequest) (*v1.EnableMCPServerReply, error) {
-	status, err := s.uc.EnableMCPServer(ctx, req.Phone, req.Uid, req.Url)
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.uc.EnableMCPServer(ctx, owner, req.Uid, req.Url)
 	if err != nil {
 		return nil, err
 	}
@@ -193,9 +338,179 @@ func (s *SeminarService) EnableMCPServer(ctx context.Context, req *v1.EnableMCPS
 }
 
 func (s *SeminarService) DisableMCPServer(ctx context.Context, req *v1.DisableMCPServerRequest) (*v1.DisableMCPServerReply, error) {
-	err := s.uc.DisableMCPServer(ctx, req.Phone, req.Uid)
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uc.DisableMCPServer(ctx, owner, req.Uid)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.DisableMCPServerReply{Message: "success"}, nil
 }
+
+func (s *SeminarService) GetUsage(ctx context.Context, req *v1.GetUsageRequest) (*v1.GetUsageReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.usage.GetUsage(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return toUsageReply(report), nil
+}
+
+// CheckQuota 供内部服务使用，调用方在 phone 中传入用量归属的用户
+func (s *SeminarService) CheckQuota(ctx context.Context, req *v1.CheckQuotaRequest) (*v1.CheckQuotaReply, error) {
+	if req.Phone == "" {
+		return nil, identity.ErrUnauthenticated
+	}
+	if err := s.usage.CheckQuota(ctx, req.Phone); err != nil {
+		return nil, err
+	}
+	return &v1.CheckQuotaReply{}, nil
+}
+
+func (s *SeminarService) RecordUsage(ctx context.Context, req *v1.RecordUsageRequest) (*v1.RecordUsageReply, error) {
+	if req.Phone == "" {
+		return nil, identity.ErrUnauthenticated
+	}
+	if err := s.usage.Record(ctx, &biz.Usage{
+		Phone:            req.Phone,
+		Kind:             biz.UsageKind(req.Kind),
+		ModelName:        req.Model,
+		PromptTokens:     req.PromptTokens,
+		CompletionTokens: req.CompletionTokens,
+		Estimated:        req.Estimated,
+	}); err != nil {
+		return nil, err
+	}
+	return &v1.RecordUsageReply{}, nil
+}
+
+func toUsageReply(report *biz.UsageReport) *v1.GetUsageReply {
+	reply := &v1.GetUsageReply{
+		Daily:             toUsageSummary(report.Daily),
+		Monthly:           toUsageSummary(report.Monthly),
+		DailyTokenQuota:   report.DailyTokenQuota,
+		MonthlyTokenQuota: report.MonthlyTokenQuota,
+		DailyCostQuota:    report.DailyCostQuota,
+		MonthlyCostQuota:  report.MonthlyCostQuota,
+		Currency:          report.Currency,
+	}
+	for _, m := range report.Models {
+		reply.Models = append(reply.Models, &v1.ModelUsage{
+			Model: m.ModelName,
+			Kind:  string(m.Kind),
+			Usage: toUsageSummary(m.UsageSummary),
+		})
+	}
+	return reply
+}
+
+func (s *SeminarService) EstimateTopicCost(ctx context.Context, req *v1.EstimateTopicCostRequest) (*v1.EstimateTopicCostReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := s.uc.EstimateTopicCost(ctx, owner, req.TopicId, int(req.Speeches))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EstimateTopicCostReply{
+		Speeches:         int32(estimate.Speeches),
+		PromptTokens:     estimate.PromptTokens,
+		CompletionTokens: estimate.CompletionTokens,
+		Cost:             estimate.Cost,
+		Currency:         estimate.Currency,
+	}, nil
+}
+
+func toUsageSummary(s biz.UsageSummary) *v1.UsageSummary {
+	return &v1.UsageSummary{
+		PromptTokens:     s.PromptTokens,
+		CompletionTokens: s.CompletionTokens,
+		Cost:             s.Cost,
+	}
+}
+
+func (s *SeminarService) TestCredential(ctx context.Context, req *v1.TestCredentialRequest) (*v1.TestCredentialReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.uc.TestCredential(ctx, owner, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TestCredentialReply{
+		Ok:        result.OK,
+		Message:   result.Message,
+		LatencyMs: result.Latency.Milliseconds(),
+	}, nil
+}
+
+func (s *SeminarService) GetTopicRuns(ctx context.Context, req *v1.GetTopicRunsRequest) (*v1.GetTopicRunsReply, error) {
+	owner, err := identity.Owner(ctx, workspace.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	runs, err := s.uc.GetTopicRuns(ctx, owner, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetTopicRunsReply{}
+	for _, run := range runs {
+		r := &v1.TopicRun{Uid: run.UID, StartedAt: run.StartedAt.Unix()}
+		if run.EndedAt != nil {
+			r.EndedAt = run.EndedAt.Unix()
+		}
+		for _, role := range run.Roles {
+			r.Roles = append(r.Roles, &v1.RunRole{
+				RoleUid:     role.RoleUID,
+				RoleVersion: role.RoleVersion,
+				RoleType:    biz.RoleTypeLabel(role.RoleType),
+				Name:        role.RoleName,
+				Description: role.Description,
+				Avatar:      role.Avatar,
+				Model:       role.ModelName,
+			})
+		}
+		reply.Runs = append(reply.Runs, r)
+	}
+	return reply, nil
+}
+
+func (s *SeminarService) PreviewRole(req *v1.PreviewRoleRequest, stream v1.Seminar_PreviewRoleServer) error {
+	ctx := identity.ServerContext(stream.Context())
+	owner, err := identity.Owner(ctx, workspace.RoleEditor)
+	if err != nil {
+		return err
+	}
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return err
+	}
+	preview := biz.PreviewRequest{
+		Phone:    owner,
+		Caller:   caller,
+		RoleUID:  req.RoleUid,
+		Prompt:   req.Prompt,
+		Mode:     req.Mode,
+		UseTools: req.UseTools,
+	}
+	if req.Draft != nil {
+		preview.Draft = &biz.Role{
+			RoleName:      req.Draft.Name,
+			Description:   req.Draft.Description,
+			Provider:      req.Draft.Provider,
+			ModelName:     req.Draft.Model,
+			CredentialUid: req.Draft.CredentialId,
+			ApiKey:        req.Draft.ApiKey,
+		}
+	}
+	return s.uc.PreviewRole(ctx, preview, func(contentType, content string) error {
+		return stream.Send(&v1.PreviewRoleReply{ContentType: contentType, Content: content})
+	})
+}
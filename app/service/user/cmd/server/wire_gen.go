@@ -31,11 +31,23 @@ func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Regist
 		return nil, nil, err
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger)
-	userService := service.NewUserService(userUsecase)
+	sessionRepo := data.NewSessionRepo(dataData, logger)
+	personalAccessTokenRepo := data.NewPersonalAccessTokenRepo(dataData, logger)
+	smsSender, err := data.NewSMSSender(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase := biz.NewUserUsecase(confServer, userRepo, sessionRepo, personalAccessTokenRepo, smsSender, logger)
+	workspaceRepo := data.NewWorkspaceRepo(dataData, logger)
+	workspaceUsecase := biz.NewWorkspaceUsecase(workspaceRepo, logger)
+	adminRepo := data.NewAdminRepo(dataData, logger)
+	adminUsecase := biz.NewAdminUsecase(confServer, adminRepo, sessionRepo, logger)
+	userService := service.NewUserService(userUsecase, workspaceUsecase, adminUsecase)
 	grpcServer := server.NewGRPCServer(confServer, userService, logger)
+	httpServer := server.NewHTTPServer(confServer, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	app := newApp(logger, grpcServer, httpServer, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
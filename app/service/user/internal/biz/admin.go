@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAdminPageSize = 20
+	maxAdminPageSize     = 100
+	maxDisableReason     = 200
+	// 审计日志中请求参数和错误信息的最大长度
+	maxAuditFieldLength = 4096
+)
+
+var (
+	ErrAccountDisabled   = status.Error(codes.PermissionDenied, "账号已被停用，请联系管理员")
+	ErrUserNotFound      = status.Error(codes.NotFound, "用户不存在")
+	ErrDisableSelf       = status.Error(codes.FailedPrecondition, "不能停用自己的账号")
+	ErrDisableAdmin      = status.Error(codes.FailedPrecondition, "不能停用管理员账号")
+	ErrDisableReasonLong = status.Error(codes.InvalidArgument, "停用原因不能超过 200 个字符")
+	ErrAuditLogInvalid   = status.Error(codes.InvalidArgument, "审计日志缺少操作者或操作名称")
+)
+
+// UserSummary 管理后台展示的用户信息
+type UserSummary struct {
+	Phone          string
+	Name           string
+	Avatar         string
+	Admin          bool
+	Disabled       bool
+	DisabledReason string
+	CreatedAt      time.Time
+	DisabledAt     *time.Time
+}
+
+type UserFilter struct {
+	Keyword      string
+	DisabledOnly bool
+	Page         int
+	PageSize     int
+}
+
+// AuditLog 一次管理操作的记录，由网关在管理接口返回后写入
+type AuditLog struct {
+	UID       string
+	Actor     string
+	Operation string
+	Target    string
+	Request   string
+	Success   bool
+	Error     string
+	IP        string
+	CreatedAt time.Time
+}
+
+type AuditLogFilter struct {
+	Actor     string
+	Operation string
+	Target    string
+	Page      int
+	PageSize  int
+}
+
+type AdminRepo interface {
+	SearchUsers(ctx context.Context, filter UserFilter) ([]UserSummary, int64, error)
+	// SetUserDisabled 用户不存在时返回 gorm.ErrRecordNotFound
+	SetUserDisabled(ctx context.Context, phone string, disabled bool, reason string, at time.Time) error
+	// MarkUserDisabled 维护网关鉴权时检查的停用标记
+	MarkUserDisabled(ctx context.Context, phone string, disabled bool) error
+	CreateAuditLog(ctx context.Context, log AuditLog) error
+	ListAuditLogs(ctx context.Context, filter AuditLogFilter) ([]AuditLog, int64, error)
+}
+
+type AdminUsecase struct {
+	repo     AdminRepo
+	sessions SessionRepo
+	admins   map[string]struct{}
+	log      *log.Helper
+}
+
+func NewAdminUsecase(c *conf.Server, repo AdminRepo, sessions SessionRepo, logger log.Logger) *AdminUsecase {
+	return &AdminUsecase{repo: repo, sessions: sessions, admins: adminSet(c), log: log.NewHelper(logger)}
+}
+
+// IsAdmin 以用户服务当前的管理员名单为准，不依赖令牌中的声明
+func (uc *AdminUsecase) IsAdmin(phone string) bool {
+	_, ok := uc.admins[phone]
+	return ok
+}
+
+func (uc *AdminUsecase) GetUsers(ctx context.Context, filter UserFilter) ([]UserSummary, int64, error) {
+	filter.Keyword = strings.TrimSpace(filter.Keyword)
+	filter.Page, filter.PageSize = pagination(filter.Page, filter.PageSize)
+	users, total, err := uc.repo.SearchUsers(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range users {
+		_, users[i].Admin = uc.admins[users[i].Phone]
+	}
+	return users, total, nil
+}
+
+// SetUserStatus 停用账号时注销其所有会话，已签发的访问令牌和个人访问令牌由网关根据停用标记拒绝
+func (uc *AdminUsecase) SetUserStatus(ctx context.Context, actor, phone string, disabled bool, reason string) error {
+	if disabled {
+		if phone == actor {
+			return ErrDisableSelf
+		}
+		if _, ok := uc.admins[phone]; ok {
+			return ErrDisableAdmin
+		}
+		if utf8.RuneCountInString(reason) > maxDisableReason {
+			return ErrDisableReasonLong
+		}
+	} else {
+		reason = ""
+	}
+	err := uc.repo.SetUserDisabled(ctx, phone, disabled, reason, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.MarkUserDisabled(ctx, phone, disabled); err != nil {
+		return err
+	}
+	if disabled {
+		if err := uc.sessions.DeleteSessions(ctx, phone, ""); err != nil {
+			uc.log.Errorf("revoke sessions of disabled user %s failed: %v", phone, err)
+		}
+	}
+	return nil
+}
+
+func (uc *AdminUsecase) RecordAuditLog(ctx context.Context, entry AuditLog) error {
+	if entry.Actor == "" || entry.Operation == "" {
+		return ErrAuditLogInvalid
+	}
+	entry.UID = uuid.NewString()
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	entry.Request = truncate(entry.Request, maxAuditFieldLength)
+	entry.Error = truncate(entry.Error, maxAuditFieldLength)
+	return uc.repo.CreateAuditLog(ctx, entry)
+}
+
+func (uc *AdminUsecase) GetAuditLogs(ctx context.Context, filter AuditLogFilter) ([]AuditLog, int64, error) {
+	filter.Page, filter.PageSize = pagination(filter.Page, filter.PageSize)
+	return uc.repo.ListAuditLogs(ctx, filter)
+}
+
+func adminSet(c *conf.Server) map[string]struct{} {
+	admins := make(map[string]struct{}, len(c.GetAdminPhones()))
+	for _, phone := range c.GetAdminPhones() {
+		admins[phone] = struct{}{}
+	}
+	return admins
+}
+
+func pagination(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAdminPageSize
+	}
+	return page, min(pageSize, maxAdminPageSize)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	// 避免截断在多字节字符中间
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
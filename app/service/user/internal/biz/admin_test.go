@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type fakeAdminRepo struct {
+	AdminRepo
+	users  map[string]bool
+	marked map[string]bool
+	logs   []AuditLog
+}
+
+func (r *fakeAdminRepo) SetUserDisabled(ctx context.Context, phone string, disabled bool, reason string, at time.Time) error {
+	if _, ok := r.users[phone]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	r.users[phone] = disabled
+	return nil
+}
+
+func (r *fakeAdminRepo) MarkUserDisabled(ctx context.Context, phone string, disabled bool) error {
+	r.marked[phone] = disabled
+	return nil
+}
+
+func (r *fakeAdminRepo) CreateAuditLog(ctx context.Context, log AuditLog) error {
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+type fakeSessionRepo struct {
+	SessionRepo
+	revoked []string
+}
+
+func (r *fakeSessionRepo) DeleteSessions(ctx context.Context, phone, exceptSID string) error {
+	r.revoked = append(r.revoked, phone)
+	return nil
+}
+
+func newTestAdminUsecase() (*AdminUsecase, *fakeAdminRepo, *fakeSessionRepo) {
+	repo := &fakeAdminRepo{users: map[string]bool{"13800000000": false, "13900000000": false}, marked: map[string]bool{}}
+	sessions := &fakeSessionRepo{}
+	uc := NewAdminUsecase(&conf.Server{AdminPhones: []string{"13800000000"}}, repo, sessions, log.DefaultLogger)
+	return uc, repo, sessions
+}
+
+func TestIsAdmin(t *testing.T) {
+	uc, _, _ := newTestAdminUsecase()
+	cases := []struct {
+		phone string
+		want  bool
+	}{
+		{phone: "13800000000", want: true},
+		{phone: "13900000000", want: false},
+		{phone: "", want: false},
+	}
+	for _, c := range cases {
+		if got := uc.IsAdmin(c.phone); got != c.want {
+			t.Fatalf("IsAdmin(%q) = %v, want %v", c.phone, got, c.want)
+		}
+	}
+}
+
+func TestSetUserStatus(t *testing.T) {
+	cases := []struct {
+		name        string
+		phone       string
+		disabled    bool
+		reason      string
+		wantErr     error
+		wantRevoked bool
+	}{
+		{name: "admin cannot disable itself", phone: "13700000000", disabled: true, wantErr: ErrDisableSelf},
+		{name: "admin account cannot be disabled", phone: "13800000000", disabled: true, wantErr: ErrDisableAdmin},
+		{name: "reason too long", phone: "13900000000", disabled: true, reason: strings.Repeat("停", maxDisableReason+1), wantErr: ErrDisableReasonLong},
+		{name: "unknown user", phone: "13600000000", disabled: true, wantErr: ErrUserNotFound},
+		{name: "disable revokes sessions", phone: "13900000000", disabled: true, reason: "spam", wantRevoked: true},
+		{name: "enable keeps sessions", phone: "13900000000", disabled: false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			uc, repo, sessions := newTestAdminUsecase()
+			err := uc.SetUserStatus(context.Background(), "13700000000", c.phone, c.disabled, c.reason)
+			if err != c.wantErr {
+				t.Fatalf("got %v, want %v", err, c.wantErr)
+			}
+			if c.wantErr != nil {
+				if len(repo.marked) != 0 || len(sessions.revoked) != 0 {
+					t.Fatalf("rejected change was applied: marked %v, revoked %v", repo.marked, sessions.revoked)
+				}
+				return
+			}
+			if disabled, ok := repo.marked[c.phone]; !ok || disabled != c.disabled {
+				t.Fatalf("disabled mark = %v (set %v), want %v", disabled, ok, c.disabled)
+			}
+			if revoked := len(sessions.revoked) > 0; revoked != c.wantRevoked {
+				t.Fatalf("sessions revoked = %v, want %v", revoked, c.wantRevoked)
+			}
+		})
+	}
+}
+
+func TestRecordAuditLog(t *testing.T) {
+	cases := []struct {
+		name    string
+		entry   AuditLog
+		wantErr error
+	}{
+		{name: "missing actor", entry: AuditLog{Operation: "/Ayana.v1.User/AdminGetUsers"}, wantErr: ErrAuditLogInvalid},
+		{name: "missing operation", entry: AuditLog{Actor: "13800000000"}, wantErr: ErrAuditLogInvalid},
+		{name: "read-only call", entry: AuditLog{Actor: "13800000000", Operation: "/Ayana.v1.User/AdminGetUsers", Success: true}},
+		{name: "long fields are truncated", entry: AuditLog{Actor: "13800000000", Operation: "/Ayana.v1.User/AdminSetUserStatus",
+			Request: strings.Repeat("请", maxAuditFieldLength), Error: strings.Repeat("e", maxAuditFieldLength+1)}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			uc, repo, _ := newTestAdminUsecase()
+			err := uc.RecordAuditLog(context.Background(), c.entry)
+			if err != c.wantErr {
+				t.Fatalf("got %v, want %v", err, c.wantErr)
+			}
+			if c.wantErr != nil {
+				if len(repo.logs) != 0 {
+					t.Fatal("invalid audit log was saved")
+				}
+				return
+			}
+			if len(repo.logs) != 1 {
+				t.Fatalf("got %d saved logs, want 1", len(repo.logs))
+			}
+			saved := repo.logs[0]
+			if saved.UID == "" || saved.CreatedAt.IsZero() {
+				t.Fatalf("uid or time not set: %+v", saved)
+			}
+			if saved.Success != c.entry.Success || saved.Operation != c.entry.Operation {
+				t.Fatalf("unexpected saved log: %+v", saved)
+			}
+			if len(saved.Request) > maxAuditFieldLength || len(saved.Error) > maxAuditFieldLength {
+				t.Fatalf("fields not truncated: request %d bytes, error %d bytes", len(saved.Request), len(saved.Error))
+			}
+			if !strings.HasPrefix(c.entry.Request, saved.Request) || !utf8.ValidString(saved.Request) {
+				t.Fatal("request truncated in the middle of a character")
+			}
+		})
+	}
+}
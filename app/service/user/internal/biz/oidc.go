@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ssoAccountPrefix 没有手机号的外部身份使用生成的账号标识代替手机号，这类账号只能通过单点登录
+const ssoAccountPrefix = "sso_"
+
+// maxPhoneLength 与 users 表中 phone 字段的长度一致
+const maxPhoneLength = 20
+
+var ErrIdentityInvalid = status.Error(codes.InvalidArgument, "外部身份缺少提供方或用户标识")
+
+// ExternalIdentity 第三方登录的外部身份，Provider 和 Subject 唯一确定一个身份
+type ExternalIdentity struct {
+	Provider string
+	Subject  string
+	Email    string
+	Name     string
+	// Phone 身份提供方声明已验证的手机号，可能为空
+	Phone string
+	// TrustPhone 提供方由平台配置为可信时才按手机号关联账号，否则任何能在提供方填写手机号的人都能接管该账号
+	TrustPhone bool
+}
+
+// OIDCLogin 外部身份已关联用户时直接登录，否则关联或创建用户，返回令牌以及是否新建了用户
+func (uc *UserUsecase) OIDCLogin(ctx context.Context, identity ExternalIdentity, device, ip string) (string, string, bool, error) {
+	if identity.Provider == "" || identity.Subject == "" {
+		return "", "", false, ErrIdentityInvalid
+	}
+	identity.Phone = normalizePhone(identity.Phone)
+
+	created := false
+	phone, err := uc.repo.GetIdentityPhone(ctx, identity.Provider, identity.Subject)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		phone, created, err = uc.linkIdentity(ctx, identity)
+		if err != nil {
+			// 同一身份并发首次登录时，唯一索引冲突的一方读取另一方的关联结果
+			if linked, lerr := uc.repo.GetIdentityPhone(ctx, identity.Provider, identity.Subject); lerr == nil {
+				phone, created, err = linked, false, nil
+			}
+		}
+	}
+	if err != nil {
+		return "", "", false, err
+	}
+	accessToken, refreshToken, err := uc.startSession(ctx, phone, device, ip)
+	if err != nil {
+		return "", "", false, err
+	}
+	return accessToken, refreshToken, created, nil
+}
+
+// linkIdentity 可信的提供方给出已验证的手机号时关联到该手机号的账号，账号不存在则用该手机号注册；
+// 其他情况创建一个只能通过单点登录的账号
+func (uc *UserUsecase) linkIdentity(ctx context.Context, identity ExternalIdentity) (string, bool, error) {
+	phone := identity.Phone
+	if !identity.TrustPhone {
+		phone = ""
+	}
+	if phone != "" {
+		exist, err := uc.repo.ExistUser(ctx, phone)
+		if err != nil {
+			return "", false, err
+		}
+		if exist {
+			if err := uc.repo.LinkIdentity(ctx, phone, identity); err != nil {
+				return "", false, err
+			}
+			uc.log.Infof("linked %s identity %s to user %s", identity.Provider, identity.Subject, phone)
+			return phone, false, nil
+		}
+	} else {
+		phone = ssoAccountPrefix + strings.ReplaceAll(uuid.NewString(), "-", "")[:maxPhoneLength-len(ssoAccountPrefix)]
+	}
+	if err := uc.repo.CreateUserWithIdentity(ctx, phone, Profile{Name: identity.Name}, identity); err != nil {
+		return "", false, err
+	}
+	uc.log.Infof("provisioned user %s for %s identity %s", phone, identity.Provider, identity.Subject)
+	return phone, true, nil
+}
+
+// normalizePhone 把 E.164 格式的国内手机号转换成注册时使用的格式，无法保存的手机号视为没有提供
+func normalizePhone(phone string) string {
+	phone = strings.NewReplacer(" ", "", "-", "").Replace(phone)
+	phone = strings.TrimPrefix(phone, "+86")
+	if len(phone) > maxPhoneLength || strings.HasPrefix(phone, ssoAccountPrefix) {
+		return ""
+	}
+	return phone
+}
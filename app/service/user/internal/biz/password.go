@@ -0,0 +1,220 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// 连续登录失败达到次数后锁定账号一段时间
+	maxLoginFailures = 5
+	loginLockout     = 15 * time.Minute
+
+	resetCodeLength      = 6
+	resetCodeTTL         = 10 * time.Minute
+	resetCodeCooldown    = time.Minute
+	maxResetCodeAttempts = 5
+
+	minPasswordLength = 8
+	// bcrypt 只使用前 72 字节
+	maxPasswordLength = 72
+)
+
+var (
+	ErrInvalidCredentials = status.Error(codes.Unauthenticated, "手机号或密码错误")
+	ErrAccountLocked      = status.Error(codes.PermissionDenied, "登录失败次数过多，账号已临时锁定，请稍后再试或重置密码")
+	ErrPasswordInvalid    = status.Error(codes.InvalidArgument, fmt.Sprintf("密码长度需要在 %d 到 %d 位之间", minPasswordLength, maxPasswordLength))
+	ErrResetCodeInvalid   = status.Error(codes.InvalidArgument, "验证码错误或已过期")
+	ErrResetCodeTooOften  = status.Error(codes.ResourceExhausted, "验证码发送过于频繁，请稍后再试")
+)
+
+// Credential 用户的密码哈希和登录失败状态
+type Credential struct {
+	PasswordHash   string
+	FailedAttempts int32
+	LockedUntil    *time.Time
+}
+
+func (c Credential) locked(now time.Time) bool {
+	return c.LockedUntil != nil && now.Before(*c.LockedUntil)
+}
+
+// ResetCode 保存的是验证码的哈希，不保存明文
+type ResetCode struct {
+	Hash     string
+	Attempts int64
+}
+
+// SMSSender 短信通道，本地开发时把短信内容打印到日志
+type SMSSender interface {
+	Send(ctx context.Context, phone, message string) error
+}
+
+// verifyPassword 校验密码并维护失败次数，连续失败过多时锁定账号
+func (uc *UserUsecase) verifyPassword(ctx context.Context, phone, password string) error {
+	cred, err := uc.repo.GetCredential(ctx, phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrInvalidCredentials
+	}
+	if err != nil {
+		return err
+	}
+	if cred.locked(time.Now()) {
+		return ErrAccountLocked
+	}
+	if !utils.CompareBcryptHash(cred.PasswordHash, password) {
+		attempts, err := uc.repo.RecordLoginFailure(ctx, phone)
+		if err != nil {
+			uc.log.Errorf("record login failure for %s failed: %v", phone, err)
+			return ErrInvalidCredentials
+		}
+		if attempts >= maxLoginFailures {
+			if err := uc.repo.LockUser(ctx, phone, time.Now().Add(loginLockout)); err != nil {
+				uc.log.Errorf("lock user %s failed: %v", phone, err)
+			}
+			return ErrAccountLocked
+		}
+		return ErrInvalidCredentials
+	}
+	if cred.FailedAttempts > 0 || cred.LockedUntil != nil {
+		if err := uc.repo.ResetLoginFailures(ctx, phone); err != nil {
+			uc.log.Errorf("reset login failures for %s failed: %v", phone, err)
+		}
+	}
+	return nil
+}
+
+// ChangePassword 修改密码后注销当前会话以外的所有会话
+func (uc *UserUsecase) ChangePassword(ctx context.Context, phone, currentSID, oldPassword, newPassword string) error {
+	if err := checkPassword(newPassword); err != nil {
+		return err
+	}
+	if err := uc.verifyPassword(ctx, phone, oldPassword); err != nil {
+		return err
+	}
+	if err := uc.setPassword(ctx, phone, newPassword); err != nil {
+		return err
+	}
+	if err := uc.sessions.DeleteSessions(ctx, phone, currentSID); err != nil {
+		uc.log.Errorf("revoke sessions of %s failed: %v", phone, err)
+	}
+	return nil
+}
+
+// SendResetCode 生成验证码并通过短信发送。手机号未注册时不发送但同样返回成功，避免被用来探测手机号
+func (uc *UserUsecase) SendResetCode(ctx context.Context, phone string) error {
+	if phone == "" {
+		return status.Error(codes.InvalidArgument, "手机号不能为空")
+	}
+	ok, err := uc.repo.AcquireResetCodeCooldown(ctx, phone, resetCodeCooldown)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrResetCodeTooOften
+	}
+	exist, err := uc.repo.ExistUser(ctx, phone)
+	if err != nil {
+		return err
+	}
+	// 单点登录创建的账号没有真实手机号
+	if !exist || strings.HasPrefix(phone, ssoAccountPrefix) {
+		return nil
+	}
+	code, err := genResetCode()
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.SaveResetCode(ctx, phone, hashResetCode(phone, code), resetCodeTTL); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("【Ayana】您的验证码是 %s，%d 分钟内有效，请勿泄露给他人。", code, int(resetCodeTTL.Minutes()))
+	if err := uc.sms.Send(ctx, phone, message); err != nil {
+		uc.log.Errorf("send reset code to %s failed: %v", phone, err)
+		return status.Error(codes.Unavailable, "验证码发送失败，请稍后再试")
+	}
+	return nil
+}
+
+// ResetPassword 校验验证码后重置密码，验证码输错次数过多时作废
+func (uc *UserUsecase) ResetPassword(ctx context.Context, phone, code, newPassword string) error {
+	if err := checkPassword(newPassword); err != nil {
+		return err
+	}
+	saved, err := uc.repo.GetResetCode(ctx, phone)
+	if err != nil {
+		return ErrResetCodeInvalid
+	}
+	// 先原子地计数再比较，并发的请求不能绕过次数限制
+	attempts, err := uc.repo.IncrResetCodeAttempts(ctx, phone)
+	if err != nil {
+		return err
+	}
+	if attempts > maxResetCodeAttempts {
+		uc.deleteResetCode(ctx, phone)
+		return ErrResetCodeInvalid
+	}
+	if subtle.ConstantTimeCompare([]byte(saved.Hash), []byte(hashResetCode(phone, code))) != 1 {
+		if attempts == maxResetCodeAttempts {
+			uc.deleteResetCode(ctx, phone)
+		}
+		return ErrResetCodeInvalid
+	}
+	uc.deleteResetCode(ctx, phone)
+	if err := uc.setPassword(ctx, phone, newPassword); err != nil {
+		return err
+	}
+	// 重置密码说明旧密码可能已泄露，注销所有会话
+	if err := uc.sessions.DeleteSessions(ctx, phone, ""); err != nil {
+		uc.log.Errorf("revoke sessions of %s failed: %v", phone, err)
+	}
+	return nil
+}
+
+func (uc *UserUsecase) deleteResetCode(ctx context.Context, phone string) {
+	if err := uc.repo.DeleteResetCode(ctx, phone); err != nil {
+		uc.log.Errorf("delete reset code for %s failed: %v", phone, err)
+	}
+}
+
+// setPassword 保存新密码，同时清除登录失败次数和锁定
+func (uc *UserUsecase) setPassword(ctx context.Context, phone, password string) error {
+	hash, err := utils.GenBcryptHash(password)
+	if err != nil {
+		return err
+	}
+	return uc.repo.UpdatePassword(ctx, phone, hash)
+}
+
+func checkPassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return ErrPasswordInvalid
+	}
+	return nil
+}
+
+func genResetCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", resetCodeLength, n.Int64()), nil
+}
+
+func hashResetCode(phone, code string) string {
+	sum := sha256.Sum256([]byte(phone + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
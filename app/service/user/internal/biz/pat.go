@@ -0,0 +1,173 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultTokenTTLDays = 30
+	maxTokenTTLDays     = 365
+	maxTokensPerUser    = 50
+	maxTokenNameLength  = 64
+	// 列表中展示令牌开头的几位，方便用户辨认
+	tokenDisplayLength = len(jwtc.PersonalAccessTokenPrefix) + 6
+	// 校验结果在 Redis 中缓存的时间，撤销令牌时会主动删除缓存
+	tokenCacheTTL = 5 * time.Minute
+)
+
+var (
+	ErrTokenNameInvalid   = status.Error(codes.InvalidArgument, fmt.Sprintf("令牌名称不能为空，且不能超过 %d 个字符", maxTokenNameLength))
+	ErrTokenScopeInvalid  = status.Error(codes.InvalidArgument, "令牌权限范围无效")
+	ErrTokenExpiryInvalid = status.Error(codes.InvalidArgument, fmt.Sprintf("令牌有效期需要在 1 到 %d 天之间", maxTokenTTLDays))
+	ErrTooManyTokens      = status.Error(codes.ResourceExhausted, fmt.Sprintf("最多只能创建 %d 个有效的令牌", maxTokensPerUser))
+	ErrTokenNotFound      = status.Error(codes.NotFound, "令牌不存在")
+	ErrTokenInvalid       = status.Error(codes.Unauthenticated, "个人访问令牌无效或已过期")
+)
+
+// PersonalAccessToken 供脚本和 CI 调用接口的长期令牌，只保存哈希
+type PersonalAccessToken struct {
+	UID        string
+	Phone      string
+	Name       string
+	Scopes     []string
+	Prefix     string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+}
+
+type PersonalAccessTokenRepo interface {
+	CreateToken(ctx context.Context, token PersonalAccessToken, hash string) error
+	// CountTokens 统计用户未过期的令牌
+	CountTokens(ctx context.Context, phone string, now time.Time) (int64, error)
+	ListTokens(ctx context.Context, phone string) ([]PersonalAccessToken, error)
+	// GetTokenByHash 令牌不存在或已撤销时返回 gorm.ErrRecordNotFound
+	GetTokenByHash(ctx context.Context, hash string) (PersonalAccessToken, error)
+	// RevokeToken 返回被撤销令牌的哈希，用于删除缓存
+	RevokeToken(ctx context.Context, phone, uid string) (string, error)
+	TouchToken(ctx context.Context, uid string, usedAt time.Time) error
+	CacheToken(ctx context.Context, hash string, token jwtc.PersonalAccessToken, ttl time.Duration) error
+	DeleteTokenCache(ctx context.Context, hash string) error
+}
+
+// CreatePersonalAccessToken 返回令牌元数据和完整令牌，完整令牌之后无法再次查看
+func (uc *UserUsecase) CreatePersonalAccessToken(ctx context.Context, phone, name string, scopes []string, days int) (PersonalAccessToken, string, error) {
+	if name == "" || utf8.RuneCountInString(name) > maxTokenNameLength {
+		return PersonalAccessToken{}, "", ErrTokenNameInvalid
+	}
+	if len(scopes) == 0 {
+		return PersonalAccessToken{}, "", ErrTokenScopeInvalid
+	}
+	for _, scope := range scopes {
+		if !jwtc.ValidScope(scope) {
+			return PersonalAccessToken{}, "", ErrTokenScopeInvalid
+		}
+	}
+	if days == 0 {
+		days = defaultTokenTTLDays
+	}
+	if days < 0 || days > maxTokenTTLDays {
+		return PersonalAccessToken{}, "", ErrTokenExpiryInvalid
+	}
+	now := time.Now()
+	count, err := uc.tokens.CountTokens(ctx, phone, now)
+	if err != nil {
+		return PersonalAccessToken{}, "", err
+	}
+	if count >= maxTokensPerUser {
+		return PersonalAccessToken{}, "", ErrTooManyTokens
+	}
+
+	secret, err := genPersonalAccessToken()
+	if err != nil {
+		return PersonalAccessToken{}, "", err
+	}
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+	token := PersonalAccessToken{
+		UID:       uuid.NewString(),
+		Phone:     phone,
+		Name:      name,
+		Scopes:    scopes,
+		Prefix:    secret[:tokenDisplayLength],
+		CreatedAt: now,
+		ExpiresAt: now.AddDate(0, 0, days),
+	}
+	if err := uc.tokens.CreateToken(ctx, token, jwtc.HashPersonalAccessToken(secret)); err != nil {
+		return PersonalAccessToken{}, "", err
+	}
+	return token, secret, nil
+}
+
+func (uc *UserUsecase) ListPersonalAccessTokens(ctx context.Context, phone string) ([]PersonalAccessToken, error) {
+	return uc.tokens.ListTokens(ctx, phone)
+}
+
+// RevokePersonalAccessToken 撤销后立即删除网关使用的缓存
+func (uc *UserUsecase) RevokePersonalAccessToken(ctx context.Context, phone, uid string) error {
+	hash, err := uc.tokens.RevokeToken(ctx, phone, uid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrTokenNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := uc.tokens.DeleteTokenCache(ctx, hash); err != nil {
+		uc.log.Errorf("delete cache of token %s failed: %v", uid, err)
+	}
+	return nil
+}
+
+// VerifyPersonalAccessToken 网关缓存未命中时调用，校验通过后写入缓存并记录使用时间
+func (uc *UserUsecase) VerifyPersonalAccessToken(ctx context.Context, secret string) (jwtc.PersonalAccessToken, error) {
+	if !jwtc.IsPersonalAccessToken(secret) {
+		return jwtc.PersonalAccessToken{}, ErrTokenInvalid
+	}
+	hash := jwtc.HashPersonalAccessToken(secret)
+	token, err := uc.tokens.GetTokenByHash(ctx, hash)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return jwtc.PersonalAccessToken{}, ErrTokenInvalid
+	}
+	if err != nil {
+		return jwtc.PersonalAccessToken{}, err
+	}
+	now := time.Now()
+	if !now.Before(token.ExpiresAt) {
+		return jwtc.PersonalAccessToken{}, ErrTokenInvalid
+	}
+	disabled, err := uc.repo.IsUserDisabled(ctx, token.Phone)
+	if err != nil {
+		return jwtc.PersonalAccessToken{}, err
+	}
+	if disabled {
+		return jwtc.PersonalAccessToken{}, ErrTokenInvalid
+	}
+	if err := uc.tokens.TouchToken(ctx, token.UID, now); err != nil {
+		uc.log.Errorf("update last used time of token %s failed: %v", token.UID, err)
+	}
+	verified := jwtc.PersonalAccessToken{UID: token.UID, Phone: token.Phone, Scopes: token.Scopes, ExpiresAt: token.ExpiresAt}
+	if err := uc.tokens.CacheToken(ctx, hash, verified, min(tokenCacheTTL, token.ExpiresAt.Sub(now))); err != nil {
+		uc.log.Errorf("cache token %s failed: %v", token.UID, err)
+	}
+	return verified, nil
+}
+
+func genPersonalAccessToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return jwtc.PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrSessionInvalid  = status.Error(codes.Unauthenticated, "登录已失效，请重新登录")
+	ErrSessionNotFound = status.Error(codes.NotFound, "会话不存在")
+)
+
+// Session 一次登录对应一个会话，RefreshID 是当前唯一有效的刷新令牌 ID
+type Session struct {
+	ID         string
+	Phone      string
+	Device     string
+	IP         string
+	RefreshID  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// SessionRepo 会话保存在 Redis 中，过期时间与刷新令牌一致
+type SessionRepo interface {
+	CreateSession(ctx context.Context, session Session, ttl time.Duration) error
+	// GetSession 会话不存在时返回 ErrSessionNotFound
+	GetSession(ctx context.Context, sid string) (Session, error)
+	// RotateSession 只有当前刷新令牌 ID 等于 oldRefreshID 时才替换，返回是否替换成功
+	RotateSession(ctx context.Context, sid, oldRefreshID, newRefreshID string, usedAt time.Time, ttl time.Duration) (bool, error)
+	DeleteSession(ctx context.Context, phone, sid string) error
+	ListSessions(ctx context.Context, phone string) ([]Session, error)
+	// DeleteSessions 注销用户的所有会话，exceptSID 不为空时保留该会话
+	DeleteSessions(ctx context.Context, phone, exceptSID string) error
+}
+
+// startSession 创建会话并签发第一对令牌
+func (uc *UserUsecase) startSession(ctx context.Context, phone, device, ip string) (string, string, error) {
+	if err := uc.checkDisabled(ctx, phone); err != nil {
+		return "", "", err
+	}
+	now := time.Now()
+	session := Session{
+		ID:         uuid.NewString(),
+		Phone:      phone,
+		Device:     device,
+		IP:         ip,
+		RefreshID:  uuid.NewString(),
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	if err := uc.sessions.CreateSession(ctx, session, jwtc.RefreshTokenTTL); err != nil {
+		return "", "", err
+	}
+	return jwtc.GenToken(phone, session.ID, session.RefreshID, uc.isAdmin(phone))
+}
+
+// RefreshToken 轮换刷新令牌。旧的刷新令牌被再次使用时说明令牌可能已泄露，注销整个会话
+func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	claims, err := jwtc.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return "", "", ErrSessionInvalid
+	}
+	session, err := uc.sessions.GetSession(ctx, claims.SessionID)
+	if errors.Is(err, ErrSessionNotFound) {
+		return "", "", ErrSessionInvalid
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if session.Phone != claims.Phone {
+		return "", "", ErrSessionInvalid
+	}
+	if err := uc.checkDisabled(ctx, session.Phone); err != nil {
+		return "", "", err
+	}
+	newRefreshID := uuid.NewString()
+	rotated := false
+	if session.RefreshID == claims.ID {
+		if rotated, err = uc.sessions.RotateSession(ctx, session.ID, claims.ID, newRefreshID, time.Now(), jwtc.RefreshTokenTTL); err != nil {
+			return "", "", err
+		}
+	}
+	if !rotated {
+		uc.log.Warnf("refresh token reused, revoking session %s of %s", session.ID, session.Phone)
+		if err := uc.sessions.DeleteSession(ctx, session.Phone, session.ID); err != nil {
+			uc.log.Errorf("revoke session %s failed: %v", session.ID, err)
+		}
+		return "", "", ErrSessionInvalid
+	}
+	return jwtc.GenToken(session.Phone, session.ID, newRefreshID, uc.isAdmin(session.Phone))
+}
+
+// Logout 注销指定会话，sid 为空时注销当前会话
+func (uc *UserUsecase) Logout(ctx context.Context, phone, currentSID, sid string) error {
+	if sid == "" {
+		sid = currentSID
+	}
+	session, err := uc.sessions.GetSession(ctx, sid)
+	if err != nil {
+		return err
+	}
+	if session.Phone != phone {
+		return ErrSessionNotFound
+	}
+	return uc.sessions.DeleteSession(ctx, phone, sid)
+}
+
+func (uc *UserUsecase) LogoutAllDevices(ctx context.Context, phone string) error {
+	return uc.sessions.DeleteSessions(ctx, phone, "")
+}
+
+func (uc *UserUsecase) ListSessions(ctx context.Context, phone string) ([]Session, error) {
+	return uc.sessions.ListSessions(ctx, phone)
+}
@@ -3,10 +3,15 @@ package biz
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
-	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/conf"
 	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Profile struct {
@@ -20,22 +25,64 @@ type UserRepo interface {
 	CreatUser(ctx context.Context, phone string, password string) error
 	SetProfile(ctx context.Context, phone string, profile Profile) error
 	GetProfile(ctx context.Context, phone string) (Profile, error)
-	VerifyPassword(ctx context.Context, phone string, password string) error
 	ProfileToRedis(ctx context.Context, phone string, profile Profile) error
+	GetCredential(ctx context.Context, phone string) (Credential, error)
+	UpdatePassword(ctx context.Context, phone, hash string) error
+	RecordLoginFailure(ctx context.Context, phone string) (int32, error)
+	LockUser(ctx context.Context, phone string, until time.Time) error
+	ResetLoginFailures(ctx context.Context, phone string) error
+	SaveResetCode(ctx context.Context, phone, codeHash string, ttl time.Duration) error
+	GetResetCode(ctx context.Context, phone string) (ResetCode, error)
+	IncrResetCodeAttempts(ctx context.Context, phone string) (int64, error)
+	DeleteResetCode(ctx context.Context, phone string) error
+	AcquireResetCodeCooldown(ctx context.Context, phone string, cooldown time.Duration) (bool, error)
+	// GetIdentityPhone 返回外部身份关联的用户，未关联时返回 gorm.ErrRecordNotFound
+	GetIdentityPhone(ctx context.Context, provider, subject string) (string, error)
+	LinkIdentity(ctx context.Context, phone string, identity ExternalIdentity) error
+	// CreateUserWithIdentity 在同一个事务中创建没有密码的用户并关联外部身份
+	CreateUserWithIdentity(ctx context.Context, phone string, profile Profile, identity ExternalIdentity) error
+	IsUserDisabled(ctx context.Context, phone string) (bool, error)
 }
 
 // UserUsecase is a User usecase.
 type UserUsecase struct {
-	repo UserRepo
-	log  *log.Helper
+	repo     UserRepo
+	sessions SessionRepo
+	tokens   PersonalAccessTokenRepo
+	sms      SMSSender
+	admins   map[string]struct{}
+	log      *log.Helper
 }
 
 // NewUserUsecase new a User usecase.
-func NewUserUsecase(repo UserRepo, logger log.Logger) *UserUsecase {
-	return &UserUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewUserUsecase(c *conf.Server, repo UserRepo, sessions SessionRepo, tokens PersonalAccessTokenRepo, sms SMSSender, logger log.Logger) *UserUsecase {
+	return &UserUsecase{repo: repo, sessions: sessions, tokens: tokens, sms: sms, admins: adminSet(c), log: log.NewHelper(logger)}
+}
+
+func (uc *UserUsecase) isAdmin(phone string) bool {
+	_, ok := uc.admins[phone]
+	return ok
+}
+
+// checkDisabled 停用的账号不能登录、刷新令牌或使用个人访问令牌
+func (uc *UserUsecase) checkDisabled(ctx context.Context, phone string) error {
+	disabled, err := uc.repo.IsUserDisabled(ctx, phone)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		return ErrAccountDisabled
+	}
+	return nil
 }
 
 func (uc *UserUsecase) Register(ctx context.Context, phone, password string) error {
+	if err := checkPassword(password); err != nil {
+		return err
+	}
+	if strings.HasPrefix(phone, ssoAccountPrefix) || workspace.IsOwnerKey(phone) {
+		return status.Error(codes.InvalidArgument, "手机号格式不正确")
+	}
 	exist, err := uc.repo.ExistUser(ctx, phone)
 	if err != nil {
 		return err
@@ -53,26 +100,11 @@ func (uc *UserUsecase) Register(ctx context.Context, phone, password string) err
 	return nil
 }
 
-func (uc *UserUsecase) Login(ctx context.Context, phone, password string) (string, string, error) {
-	exist, err := uc.repo.ExistUser(ctx, phone)
-	if err != nil {
-		return "", "", err
-	}
-	if !exist {
-		return "", "", errors.New("phone not exists")
-	}
-	hash, err := utils.GenBcryptHash(password)
-	if err != nil {
-		return "", "", err
-	}
-	if err := uc.repo.VerifyPassword(ctx, phone, hash); err != nil {
-		return "", "", err
-	}
-	accessToken, refreshToken, err := jwtc.GenToken(phone)
-	if err != nil {
+func (uc *UserUsecase) Login(ctx context.Context, phone, password, device, ip string) (string, string, error) {
+	if err := uc.verifyPassword(ctx, phone, password); err != nil {
 		return "", "", err
 	}
-	return accessToken, refreshToken, err
+	return uc.startSession(ctx, phone, device, ip)
 }
 
 func (uc *UserUsecase) SetProfile(ctx context.Context, phone string, profile Profile) error {
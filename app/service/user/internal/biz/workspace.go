@@ -0,0 +1,303 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	inviteTokenPrefix = "ayana_inv_"
+	// 列表中展示邀请令牌开头的几位，方便辨认
+	inviteDisplayLength = len(inviteTokenPrefix) + 6
+
+	defaultInviteTTL        = 72 * time.Hour
+	maxInviteTTL            = 30 * 24 * time.Hour
+	maxWorkspacesPerUser    = 20
+	maxWorkspaceNameLength  = 64
+	workspaceMemberCacheTTL = 5 * time.Minute
+)
+
+var (
+	ErrWorkspaceNameInvalid = status.Error(codes.InvalidArgument, fmt.Sprintf("工作区名称不能为空，且不能超过 %d 个字符", maxWorkspaceNameLength))
+	ErrTooManyWorkspaces    = status.Error(codes.ResourceExhausted, fmt.Sprintf("最多只能创建 %d 个工作区", maxWorkspacesPerUser))
+	ErrWorkspaceNotFound    = status.Error(codes.NotFound, "工作区不存在或不是该工作区成员")
+	ErrMemberNotFound       = status.Error(codes.NotFound, "成员不存在")
+	ErrMemberRoleInvalid    = status.Error(codes.InvalidArgument, "成员角色只能是 owner、editor 或 viewer")
+	ErrLastOwner            = status.Error(codes.FailedPrecondition, "工作区至少需要保留一位所有者")
+	ErrInviteRoleInvalid    = status.Error(codes.InvalidArgument, "邀请的角色只能是 editor 或 viewer")
+	ErrInviteExpiryInvalid  = status.Error(codes.InvalidArgument, "邀请有效期需要在 1 小时到 30 天之间")
+	ErrInviteNotFound       = status.Error(codes.NotFound, "邀请不存在")
+	ErrInviteInvalid        = status.Error(codes.InvalidArgument, "邀请链接无效、已过期或已达到使用次数")
+)
+
+type Workspace struct {
+	UID       string
+	Name      string
+	Role      string
+	CreatedAt time.Time
+}
+
+type WorkspaceMember struct {
+	Phone    string
+	Name     string
+	Role     string
+	JoinedAt time.Time
+}
+
+// WorkspaceInvite 邀请链接中的令牌只保存哈希，MaxUses 为 0 表示不限次数
+type WorkspaceInvite struct {
+	UID          string
+	WorkspaceUID string
+	Role         string
+	Prefix       string
+	CreatedBy    string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	MaxUses      int32
+	Uses         int32
+}
+
+type WorkspaceRepo interface {
+	// CreateWorkspace 在同一个事务中创建工作区并把创建者加为所有者
+	CreateWorkspace(ctx context.Context, ws Workspace, ownerPhone string) error
+	CountOwnedWorkspaces(ctx context.Context, phone string) (int64, error)
+	// ListWorkspaces 返回用户加入的工作区，Role 为用户在其中的角色
+	ListWorkspaces(ctx context.Context, phone string) ([]Workspace, error)
+	// GetMemberRole 不是成员时返回 gorm.ErrRecordNotFound
+	GetMemberRole(ctx context.Context, uid, phone string) (string, error)
+	ListMembers(ctx context.Context, uid string) ([]WorkspaceMember, error)
+	// SetMemberRole 和 RemoveMember 在同一个事务中锁住所有者记录再修改，
+	// 成员是最后一位所有者时返回 ErrLastOwner，并发降级多位所有者时也至少保留一位
+	SetMemberRole(ctx context.Context, uid, phone, role string) error
+	RemoveMember(ctx context.Context, uid, phone string) error
+	CreateInvite(ctx context.Context, invite WorkspaceInvite, hash string) error
+	// ListInvites 只返回未过期且未用完的邀请
+	ListInvites(ctx context.Context, uid string, now time.Time) ([]WorkspaceInvite, error)
+	RevokeInvite(ctx context.Context, uid, inviteUID string) error
+	// AcceptInvite 在事务中占用一次邀请并加入成员，已经是成员时保留原角色。
+	// 邀请不存在、过期或用完时返回 gorm.ErrRecordNotFound
+	AcceptInvite(ctx context.Context, hash, phone string, now time.Time) (Workspace, error)
+	CacheMemberRole(ctx context.Context, uid, phone, role string, ttl time.Duration) error
+	DeleteMemberCache(ctx context.Context, uid, phone string) error
+}
+
+type WorkspaceUsecase struct {
+	repo WorkspaceRepo
+	log  *log.Helper
+}
+
+func NewWorkspaceUsecase(repo WorkspaceRepo, logger log.Logger) *WorkspaceUsecase {
+	return &WorkspaceUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func (uc *WorkspaceUsecase) CreateWorkspace(ctx context.Context, phone, name string) (Workspace, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxWorkspaceNameLength {
+		return Workspace{}, ErrWorkspaceNameInvalid
+	}
+	count, err := uc.repo.CountOwnedWorkspaces(ctx, phone)
+	if err != nil {
+		return Workspace{}, err
+	}
+	if count >= maxWorkspacesPerUser {
+		return Workspace{}, ErrTooManyWorkspaces
+	}
+	ws := Workspace{UID: uuid.NewString(), Name: name, Role: workspace.RoleOwner, CreatedAt: time.Now()}
+	if err := uc.repo.CreateWorkspace(ctx, ws, phone); err != nil {
+		return Workspace{}, err
+	}
+	return ws, nil
+}
+
+func (uc *WorkspaceUsecase) GetWorkspaces(ctx context.Context, phone string) ([]Workspace, error) {
+	return uc.repo.ListWorkspaces(ctx, phone)
+}
+
+func (uc *WorkspaceUsecase) GetMembers(ctx context.Context, phone, uid string) ([]WorkspaceMember, error) {
+	if _, err := uc.requireRole(ctx, uid, phone, workspace.RoleViewer); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListMembers(ctx, uid)
+}
+
+// SetMemberRole 只有所有者可以修改角色，降级最后一位所有者会被拒绝
+func (uc *WorkspaceUsecase) SetMemberRole(ctx context.Context, phone, uid, memberPhone, role string) error {
+	if !workspace.ValidRole(role) {
+		return ErrMemberRoleInvalid
+	}
+	if _, err := uc.requireRole(ctx, uid, phone, workspace.RoleOwner); err != nil {
+		return err
+	}
+	current, err := uc.memberRole(ctx, uid, memberPhone)
+	if err != nil {
+		return err
+	}
+	if current == role {
+		return nil
+	}
+	if err := uc.repo.SetMemberRole(ctx, uid, memberPhone, role); err != nil {
+		return err
+	}
+	uc.deleteMemberCache(ctx, uid, memberPhone)
+	return nil
+}
+
+// RemoveMember 所有者可以移除任何成员，其他成员只能退出工作区
+func (uc *WorkspaceUsecase) RemoveMember(ctx context.Context, phone, uid, memberPhone string) error {
+	if memberPhone == "" {
+		memberPhone = phone
+	}
+	if memberPhone != phone {
+		if _, err := uc.requireRole(ctx, uid, phone, workspace.RoleOwner); err != nil {
+			return err
+		}
+	}
+	if _, err := uc.memberRole(ctx, uid, memberPhone); err != nil {
+		return err
+	}
+	if err := uc.repo.RemoveMember(ctx, uid, memberPhone); err != nil {
+		return err
+	}
+	uc.deleteMemberCache(ctx, uid, memberPhone)
+	return nil
+}
+
+// CreateInvite 返回邀请和完整令牌，完整令牌之后无法再次查看
+func (uc *WorkspaceUsecase) CreateInvite(ctx context.Context, phone, uid, role string, ttl time.Duration, maxUses int32) (WorkspaceInvite, string, error) {
+	if role != workspace.RoleEditor && role != workspace.RoleViewer {
+		return WorkspaceInvite{}, "", ErrInviteRoleInvalid
+	}
+	if ttl == 0 {
+		ttl = defaultInviteTTL
+	}
+	if ttl < time.Hour || ttl > maxInviteTTL || maxUses < 0 {
+		return WorkspaceInvite{}, "", ErrInviteExpiryInvalid
+	}
+	if _, err := uc.requireRole(ctx, uid, phone, workspace.RoleOwner); err != nil {
+		return WorkspaceInvite{}, "", err
+	}
+	token, err := genInviteToken()
+	if err != nil {
+		return WorkspaceInvite{}, "", err
+	}
+	now := time.Now()
+	invite := WorkspaceInvite{
+		UID:          uuid.NewString(),
+		WorkspaceUID: uid,
+		Role:         role,
+		Prefix:       token[:inviteDisplayLength],
+		CreatedBy:    phone,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(ttl),
+		MaxUses:      maxUses,
+	}
+	if err := uc.repo.CreateInvite(ctx, invite, hashInviteToken(token)); err != nil {
+		return WorkspaceInvite{}, "", err
+	}
+	return invite, token, nil
+}
+
+func (uc *WorkspaceUsecase) GetInvites(ctx context.Context, phone, uid string) ([]WorkspaceInvite, error) {
+	if _, err := uc.requireRole(ctx, uid, phone, workspace.RoleOwner); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListInvites(ctx, uid, time.Now())
+}
+
+func (uc *WorkspaceUsecase) RevokeInvite(ctx context.Context, phone, uid, inviteUID string) error {
+	if _, err := uc.requireRole(ctx, uid, phone, workspace.RoleOwner); err != nil {
+		return err
+	}
+	err := uc.repo.RevokeInvite(ctx, uid, inviteUID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrInviteNotFound
+	}
+	return err
+}
+
+func (uc *WorkspaceUsecase) AcceptInvite(ctx context.Context, phone, token string) (Workspace, error) {
+	if !strings.HasPrefix(token, inviteTokenPrefix) {
+		return Workspace{}, ErrInviteInvalid
+	}
+	ws, err := uc.repo.AcceptInvite(ctx, hashInviteToken(token), phone, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Workspace{}, ErrInviteInvalid
+	}
+	if err != nil {
+		return Workspace{}, err
+	}
+	uc.deleteMemberCache(ctx, ws.UID, phone)
+	return ws, nil
+}
+
+// GetRole 网关缓存未命中时调用，不是成员时返回 workspace.ErrForbidden
+func (uc *WorkspaceUsecase) GetRole(ctx context.Context, uid, phone string) (string, error) {
+	role, err := uc.repo.GetMemberRole(ctx, uid, phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", workspace.ErrForbidden
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := uc.repo.CacheMemberRole(ctx, uid, phone, role, workspaceMemberCacheTTL); err != nil {
+		uc.log.Errorf("cache role of %s in workspace %s failed: %v", phone, uid, err)
+	}
+	return role, nil
+}
+
+// requireRole 检查调用者在工作区中的角色，不是成员时统一返回 ErrWorkspaceNotFound，避免探测工作区是否存在
+func (uc *WorkspaceUsecase) requireRole(ctx context.Context, uid, phone, need string) (string, error) {
+	role, err := uc.repo.GetMemberRole(ctx, uid, phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	if !workspace.Allows(role, need) {
+		return "", workspace.ErrForbidden
+	}
+	return role, nil
+}
+
+func (uc *WorkspaceUsecase) memberRole(ctx context.Context, uid, phone string) (string, error) {
+	role, err := uc.repo.GetMemberRole(ctx, uid, phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", ErrMemberNotFound
+	}
+	return role, err
+}
+
+func (uc *WorkspaceUsecase) deleteMemberCache(ctx context.Context, uid, phone string) {
+	if err := uc.repo.DeleteMemberCache(ctx, uid, phone); err != nil {
+		uc.log.Errorf("delete role cache of %s in workspace %s failed: %v", phone, uid, err)
+	}
+}
+
+func genInviteToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return inviteTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashInviteToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
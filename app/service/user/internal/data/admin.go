@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// likeEscaper 转义搜索关键字中的通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+type adminRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminRepo(data *Data, logger log.Logger) biz.AdminRepo {
+	return &adminRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *adminRepo) SearchUsers(ctx context.Context, filter biz.UserFilter) ([]biz.UserSummary, int64, error) {
+	db := r.data.mysqlClient.Model(&User{})
+	if filter.Keyword != "" {
+		like := "%" + likeEscaper.Replace(filter.Keyword) + "%"
+		db = db.Where("phone LIKE ? OR name LIKE ?", like, like)
+	}
+	if filter.DisabledOnly {
+		db = db.Where("disabled = ?", true)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var users []User
+	if err := db.Order("id DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	result := make([]biz.UserSummary, 0, len(users))
+	for _, u := range users {
+		result = append(result, biz.UserSummary{
+			Phone:          u.Phone,
+			Name:           u.Name,
+			Avatar:         u.Avatar,
+			Disabled:       u.Disabled,
+			DisabledReason: u.DisabledReason,
+			CreatedAt:      u.CreatedAt,
+			DisabledAt:     u.DisabledAt,
+		})
+	}
+	return result, total, nil
+}
+
+func (r *adminRepo) SetUserDisabled(ctx context.Context, phone string, disabled bool, reason string, at time.Time) error {
+	updates := map[string]any{
+		"disabled":        disabled,
+		"disabled_reason": reason,
+		"disabled_at":     nil,
+	}
+	if disabled {
+		updates["disabled_at"] = at
+	}
+	result := r.data.mysqlClient.Model(&User{}).Where("phone = ?", phone).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 状态没有变化时 RowsAffected 也为 0，需要确认用户是否存在
+		var count int64
+		if err := r.data.mysqlClient.Model(&User{}).Where("phone = ?", phone).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+	return nil
+}
+
+func (r *adminRepo) MarkUserDisabled(ctx context.Context, phone string, disabled bool) error {
+	if disabled {
+		return r.data.redisClient.Set(ctx, jwtc.DisabledUserKey(phone), 1, 0).Err()
+	}
+	return r.data.redisClient.Del(ctx, jwtc.DisabledUserKey(phone)).Err()
+}
+
+func (r *adminRepo) CreateAuditLog(ctx context.Context, entry biz.AuditLog) error {
+	return r.data.mysqlClient.Create(&AuditLog{
+		Model:     gorm.Model{CreatedAt: entry.CreatedAt},
+		UID:       entry.UID,
+		Actor:     entry.Actor,
+		Operation: entry.Operation,
+		Target:    entry.Target,
+		Request:   entry.Request,
+		Success:   entry.Success,
+		Error:     entry.Error,
+		IP:        entry.IP,
+	}).Error
+}
+
+func (r *adminRepo) ListAuditLogs(ctx context.Context, filter biz.AuditLogFilter) ([]biz.AuditLog, int64, error) {
+	db := r.data.mysqlClient.Model(&AuditLog{})
+	if filter.Actor != "" {
+		db = db.Where("actor = ?", filter.Actor)
+	}
+	if filter.Operation != "" {
+		db = db.Where("operation = ?", filter.Operation)
+	}
+	if filter.Target != "" {
+		db = db.Where("target = ?", filter.Target)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var logs []AuditLog
+	if err := db.Order("id DESC").Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+	result := make([]biz.AuditLog, 0, len(logs))
+	for _, l := range logs {
+		result = append(result, biz.AuditLog{
+			UID:       l.UID,
+			Actor:     l.Actor,
+			Operation: l.Operation,
+			Target:    l.Target,
+			Request:   l.Request,
+			Success:   l.Success,
+			Error:     l.Error,
+			IP:        l.IP,
+			CreatedAt: l.CreatedAt,
+		})
+	}
+	return result, total, nil
+}
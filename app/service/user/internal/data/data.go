@@ -11,7 +11,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewMysql, NewRedis)
+var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewSessionRepo, NewPersonalAccessTokenRepo, NewWorkspaceRepo, NewAdminRepo, NewMysql, NewRedis, NewSMSSender)
 
 // Data .
 type Data struct {
@@ -34,7 +34,7 @@ func NewMysql(c *conf.Data) *gorm.DB {
 	if err != nil {
 		panic("failed to connect mysql")
 	}
-	db.AutoMigrate(User{})
+	db.AutoMigrate(User{}, ExternalIdentity{}, PersonalAccessToken{}, Workspace{}, WorkspaceMember{}, WorkspaceInvite{}, AuditLog{})
 	return db
 }
 
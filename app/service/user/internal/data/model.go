@@ -1,6 +1,10 @@
 package data
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type User struct {
 	gorm.Model
@@ -8,4 +12,75 @@ type User struct {
 	Password string
 	Name     string
 	Avatar   string
+	// 连续登录失败次数和锁定截止时间，登录成功或重置密码后清零
+	FailedAttempts int32
+	LockedUntil    *time.Time
+	// 被管理员停用的账号不能登录，启用时清空
+	Disabled       bool
+	DisabledReason string `gorm:"type:varchar(255)"`
+	DisabledAt     *time.Time
+}
+
+// ExternalIdentity 第三方登录的外部身份，同一提供方的 subject 只能关联一个用户
+type ExternalIdentity struct {
+	gorm.Model
+	Provider string `gorm:"type:varchar(64);uniqueIndex:idx_provider_subject"`
+	Subject  string `gorm:"type:varchar(255);uniqueIndex:idx_provider_subject"`
+	Phone    string `gorm:"type:varchar(20);index"`
+	Email    string
+}
+
+// PersonalAccessToken 个人访问令牌，撤销时软删除
+type PersonalAccessToken struct {
+	gorm.Model
+	UID        string   `gorm:"type:varchar(36);uniqueIndex"`
+	Phone      string   `gorm:"type:varchar(20);index"`
+	Name       string   `gorm:"type:varchar(64)"`
+	Scopes     []string `gorm:"type:json;serializer:json"`
+	TokenHash  string   `gorm:"type:char(64);uniqueIndex"`
+	Prefix     string   `gorm:"type:varchar(32)"`
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+}
+
+type Workspace struct {
+	gorm.Model
+	UID        string `gorm:"type:varchar(36);uniqueIndex"`
+	Name       string `gorm:"type:varchar(64)"`
+	OwnerPhone string `gorm:"type:varchar(20);index"`
+}
+
+// WorkspaceMember 同一个用户在一个工作区中只有一条成员记录
+type WorkspaceMember struct {
+	gorm.Model
+	WorkspaceUID string `gorm:"type:varchar(36);uniqueIndex:idx_workspace_phone"`
+	Phone        string `gorm:"type:varchar(20);uniqueIndex:idx_workspace_phone;index"`
+	Role         string `gorm:"type:varchar(16)"`
+}
+
+// WorkspaceInvite 工作区邀请，撤销时软删除
+type WorkspaceInvite struct {
+	gorm.Model
+	UID          string `gorm:"type:varchar(36);uniqueIndex"`
+	WorkspaceUID string `gorm:"type:varchar(36);index"`
+	TokenHash    string `gorm:"type:char(64);uniqueIndex"`
+	Prefix       string `gorm:"type:varchar(32)"`
+	Role         string `gorm:"type:varchar(16)"`
+	CreatedBy    string `gorm:"type:varchar(20)"`
+	ExpiresAt    time.Time
+	MaxUses      int32
+	Uses         int32
+}
+
+// AuditLog 管理操作的审计日志，只追加不修改
+type AuditLog struct {
+	gorm.Model
+	UID       string `gorm:"type:varchar(36);uniqueIndex"`
+	Actor     string `gorm:"type:varchar(20);index"`
+	Operation string `gorm:"type:varchar(128);index"`
+	Target    string `gorm:"type:varchar(64);index"`
+	Request   string `gorm:"type:text"`
+	Success   bool
+	Error     string `gorm:"type:text"`
+	IP        string `gorm:"type:varchar(64)"`
 }
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type personalAccessTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPersonalAccessTokenRepo(data *Data, logger log.Logger) biz.PersonalAccessTokenRepo {
+	return &personalAccessTokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *personalAccessTokenRepo) CreateToken(ctx context.Context, token biz.PersonalAccessToken, hash string) error {
+	return r.data.mysqlClient.Create(&PersonalAccessToken{
+		UID:       token.UID,
+		Phone:     token.Phone,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		TokenHash: hash,
+		Prefix:    token.Prefix,
+		ExpiresAt: token.ExpiresAt,
+	}).Error
+}
+
+func (r *personalAccessTokenRepo) CountTokens(ctx context.Context, phone string, now time.Time) (int64, error) {
+	var count int64
+	err := r.data.mysqlClient.Model(&PersonalAccessToken{}).Where("phone = ? AND expires_at > ?", phone, now).Count(&count).Error
+	return count, err
+}
+
+func (r *personalAccessTokenRepo) ListTokens(ctx context.Context, phone string) ([]biz.PersonalAccessToken, error) {
+	var tokens []PersonalAccessToken
+	if err := r.data.mysqlClient.Where("phone = ?", phone).Order("created_at DESC").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	result := make([]biz.PersonalAccessToken, 0, len(tokens))
+	for _, t := range tokens {
+		result = append(result, toBizToken(t))
+	}
+	return result, nil
+}
+
+func (r *personalAccessTokenRepo) GetTokenByHash(ctx context.Context, hash string) (biz.PersonalAccessToken, error) {
+	var token PersonalAccessToken
+	if err := r.data.mysqlClient.Where("token_hash = ?", hash).First(&token).Error; err != nil {
+		return biz.PersonalAccessToken{}, err
+	}
+	return toBizToken(token), nil
+}
+
+func (r *personalAccessTokenRepo) RevokeToken(ctx context.Context, phone, uid string) (string, error) {
+	var token PersonalAccessToken
+	if err := r.data.mysqlClient.Where("uid = ? AND phone = ?", uid, phone).First(&token).Error; err != nil {
+		return "", err
+	}
+	result := r.data.mysqlClient.Delete(&token)
+	if result.Error != nil {
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", gorm.ErrRecordNotFound
+	}
+	return token.TokenHash, nil
+}
+
+func (r *personalAccessTokenRepo) TouchToken(ctx context.Context, uid string, usedAt time.Time) error {
+	return r.data.mysqlClient.Model(&PersonalAccessToken{}).Where("uid = ?", uid).Update("last_used_at", usedAt).Error
+}
+
+func (r *personalAccessTokenRepo) CacheToken(ctx context.Context, hash string, token jwtc.PersonalAccessToken, ttl time.Duration) error {
+	serialized, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return r.data.redisClient.Set(ctx, jwtc.PersonalAccessTokenKey(hash), serialized, ttl).Err()
+}
+
+func (r *personalAccessTokenRepo) DeleteTokenCache(ctx context.Context, hash string) error {
+	return r.data.redisClient.Del(ctx, jwtc.PersonalAccessTokenKey(hash)).Err()
+}
+
+func toBizToken(t PersonalAccessToken) biz.PersonalAccessToken {
+	return biz.PersonalAccessToken{
+		UID:        t.UID,
+		Phone:      t.Phone,
+		Name:       t.Name,
+		Scopes:     t.Scopes,
+		Prefix:     t.Prefix,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+	}
+}
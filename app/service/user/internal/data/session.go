@@ -0,0 +1,150 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// rotateSessionScript 比较并替换刷新令牌 ID，保证同一个刷新令牌只能成功使用一次
+var rotateSessionScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'refresh_id') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'refresh_id', ARGV[2], 'last_used_at', ARGV[3])
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+redis.call('EXPIRE', KEYS[2], ARGV[4])
+return 1
+`)
+
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	return &sessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// userSessionsKey 用户所有会话 ID 的集合
+func userSessionsKey(phone string) string {
+	return "sessions:" + phone
+}
+
+func (r *sessionRepo) CreateSession(ctx context.Context, session biz.Session, ttl time.Duration) error {
+	key := jwtc.SessionKey(session.ID)
+	_, err := r.data.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"phone", session.Phone,
+			"device", session.Device,
+			"ip", session.IP,
+			"refresh_id", session.RefreshID,
+			"created_at", session.CreatedAt.Unix(),
+			"last_used_at", session.LastUsedAt.Unix(),
+		)
+		pipe.Expire(ctx, key, ttl)
+		pipe.SAdd(ctx, userSessionsKey(session.Phone), session.ID)
+		pipe.Expire(ctx, userSessionsKey(session.Phone), ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *sessionRepo) GetSession(ctx context.Context, sid string) (biz.Session, error) {
+	if sid == "" {
+		return biz.Session{}, biz.ErrSessionNotFound
+	}
+	values, err := r.data.redisClient.HGetAll(ctx, jwtc.SessionKey(sid)).Result()
+	if err != nil {
+		return biz.Session{}, err
+	}
+	if len(values) == 0 {
+		return biz.Session{}, biz.ErrSessionNotFound
+	}
+	return toSession(sid, values), nil
+}
+
+func (r *sessionRepo) RotateSession(ctx context.Context, sid, oldRefreshID, newRefreshID string, usedAt time.Time, ttl time.Duration) (bool, error) {
+	session, err := r.GetSession(ctx, sid)
+	if err != nil {
+		return false, err
+	}
+	keys := []string{jwtc.SessionKey(sid), userSessionsKey(session.Phone)}
+	n, err := rotateSessionScript.Run(ctx, r.data.redisClient, keys, oldRefreshID, newRefreshID, usedAt.Unix(), int64(ttl.Seconds())).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (r *sessionRepo) DeleteSession(ctx context.Context, phone, sid string) error {
+	_, err := r.data.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, jwtc.SessionKey(sid))
+		pipe.SRem(ctx, userSessionsKey(phone), sid)
+		return nil
+	})
+	return err
+}
+
+// ListSessions 按最近使用时间倒序返回，顺便清理集合中已过期的会话
+func (r *sessionRepo) ListSessions(ctx context.Context, phone string) ([]biz.Session, error) {
+	sids, err := r.data.redisClient.SMembers(ctx, userSessionsKey(phone)).Result()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]biz.Session, 0, len(sids))
+	for _, sid := range sids {
+		session, err := r.GetSession(ctx, sid)
+		if err == biz.ErrSessionNotFound {
+			r.data.redisClient.SRem(ctx, userSessionsKey(phone), sid)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+func (r *sessionRepo) DeleteSessions(ctx context.Context, phone, exceptSID string) error {
+	sids, err := r.data.redisClient.SMembers(ctx, userSessionsKey(phone)).Result()
+	if err != nil {
+		return err
+	}
+	for _, sid := range sids {
+		if sid == exceptSID {
+			continue
+		}
+		if err := r.DeleteSession(ctx, phone, sid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toSession(sid string, values map[string]string) biz.Session {
+	createdAt, _ := strconv.ParseInt(values["created_at"], 10, 64)
+	lastUsedAt, _ := strconv.ParseInt(values["last_used_at"], 10, 64)
+	return biz.Session{
+		ID:         sid,
+		Phone:      values["phone"],
+		Device:     values["device"],
+		IP:         values["ip"],
+		RefreshID:  values["refresh_id"],
+		CreatedAt:  time.Unix(createdAt, 0),
+		LastUsedAt: time.Unix(lastUsedAt, 0),
+	}
+}
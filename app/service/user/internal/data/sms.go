@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const SMSDriverLog = "log"
+
+// NewSMSSender 按配置选择短信通道，目前只有把短信打印到日志的实现
+func NewSMSSender(c *conf.Data, logger log.Logger) (biz.SMSSender, error) {
+	switch c.GetSms().GetDriver() {
+	case "", SMSDriverLog:
+		return &logSMSSender{log: log.NewHelper(logger)}, nil
+	default:
+		return nil, fmt.Errorf("unknown sms driver %q", c.GetSms().GetDriver())
+	}
+}
+
+// logSMSSender 本地开发使用，不真正发送短信
+type logSMSSender struct {
+	log *log.Helper
+}
+
+func (s *logSMSSender) Send(ctx context.Context, phone, message string) error {
+	s.log.Infof("sms to %s: %s", phone, message)
+	return nil
+}
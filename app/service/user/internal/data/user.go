@@ -3,10 +3,12 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
 
@@ -63,14 +65,6 @@ func (r *userRepo) GetProfile(ctx context.Context, phone string) (biz.Profile, e
 	}, nil
 }
 
-func (r *userRepo) VerifyPassword(ctx context.Context, phone string, password string) error {
-	var existUser User
-	if err := r.data.mysqlClient.Where("phone = ?", phone).First(&existUser).Error; err != nil {
-		return err
-	}
-	return nil
-}
-
 func (r *userRepo) ProfileToRedis(ctx context.Context, phone string, profile biz.Profile) error {
 	serialized, _ := json.Marshal(profile)
 	if err := r.data.redisClient.Set(ctx, "profile:"+phone, serialized, 3*24*time.Hour).Err(); err != nil {
@@ -78,3 +72,133 @@ func (r *userRepo) ProfileToRedis(ctx context.Context, phone string, profile biz
 	}
 	return nil
 }
+
+func (r *userRepo) GetCredential(ctx context.Context, phone string) (biz.Credential, error) {
+	var existUser User
+	if err := r.data.mysqlClient.Where("phone = ?", phone).First(&existUser).Error; err != nil {
+		return biz.Credential{}, err
+	}
+	return biz.Credential{
+		PasswordHash:   existUser.Password,
+		FailedAttempts: existUser.FailedAttempts,
+		LockedUntil:    existUser.LockedUntil,
+	}, nil
+}
+
+func (r *userRepo) UpdatePassword(ctx context.Context, phone, hash string) error {
+	return r.data.mysqlClient.Model(&User{}).Where("phone = ?", phone).Updates(map[string]any{
+		"password":        hash,
+		"failed_attempts": 0,
+		"locked_until":    nil,
+	}).Error
+}
+
+// RecordLoginFailure 原子地增加失败次数并返回增加后的值
+func (r *userRepo) RecordLoginFailure(ctx context.Context, phone string) (int32, error) {
+	var attempts int32
+	err := r.data.mysqlClient.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&User{}).Where("phone = ?", phone).
+			Update("failed_attempts", gorm.Expr("failed_attempts + 1")).Error; err != nil {
+			return err
+		}
+		return tx.Model(&User{}).Where("phone = ?", phone).Select("failed_attempts").Scan(&attempts).Error
+	})
+	return attempts, err
+}
+
+func (r *userRepo) LockUser(ctx context.Context, phone string, until time.Time) error {
+	return r.data.mysqlClient.Model(&User{}).Where("phone = ?", phone).Updates(map[string]any{
+		"failed_attempts": 0,
+		"locked_until":    until,
+	}).Error
+}
+
+func (r *userRepo) ResetLoginFailures(ctx context.Context, phone string) error {
+	return r.data.mysqlClient.Model(&User{}).Where("phone = ?", phone).Updates(map[string]any{
+		"failed_attempts": 0,
+		"locked_until":    nil,
+	}).Error
+}
+
+func resetCodeKey(phone string) string {
+	return "reset_code:" + phone
+}
+
+func (r *userRepo) SaveResetCode(ctx context.Context, phone, codeHash string, ttl time.Duration) error {
+	key := resetCodeKey(phone)
+	_, err := r.data.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "hash", codeHash, "attempts", 0)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *userRepo) GetResetCode(ctx context.Context, phone string) (biz.ResetCode, error) {
+	values, err := r.data.redisClient.HGetAll(ctx, resetCodeKey(phone)).Result()
+	if err != nil {
+		return biz.ResetCode{}, err
+	}
+	if values["hash"] == "" {
+		return biz.ResetCode{}, redis.Nil
+	}
+	attempts, _ := strconv.ParseInt(values["attempts"], 10, 64)
+	return biz.ResetCode{Hash: values["hash"], Attempts: attempts}, nil
+}
+
+func (r *userRepo) IncrResetCodeAttempts(ctx context.Context, phone string) (int64, error) {
+	return r.data.redisClient.HIncrBy(ctx, resetCodeKey(phone), "attempts", 1).Result()
+}
+
+func (r *userRepo) DeleteResetCode(ctx context.Context, phone string) error {
+	return r.data.redisClient.Del(ctx, resetCodeKey(phone)).Err()
+}
+
+// AcquireResetCodeCooldown 同一手机号在冷却时间内只能发送一次验证码
+func (r *userRepo) AcquireResetCodeCooldown(ctx context.Context, phone string, cooldown time.Duration) (bool, error) {
+	return r.data.redisClient.SetNX(ctx, "reset_code_sent:"+phone, 1, cooldown).Result()
+}
+
+func (r *userRepo) GetIdentityPhone(ctx context.Context, provider, subject string) (string, error) {
+	var identity ExternalIdentity
+	if err := r.data.mysqlClient.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error; err != nil {
+		return "", err
+	}
+	return identity.Phone, nil
+}
+
+func (r *userRepo) LinkIdentity(ctx context.Context, phone string, identity biz.ExternalIdentity) error {
+	return r.data.mysqlClient.Create(&ExternalIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Phone:    phone,
+		Email:    identity.Email,
+	}).Error
+}
+
+func (r *userRepo) CreateUserWithIdentity(ctx context.Context, phone string, profile biz.Profile, identity biz.ExternalIdentity) error {
+	return r.data.mysqlClient.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&User{Phone: phone, Name: profile.Name, Avatar: profile.Avatar}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&ExternalIdentity{
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Phone:    phone,
+			Email:    identity.Email,
+		}).Error
+	})
+}
+
+func (r *userRepo) IsUserDisabled(ctx context.Context, phone string) (bool, error) {
+	var user User
+	err := r.data.mysqlClient.Select("disabled").Where("phone = ?", phone).First(&user).Error
+	if err == gorm.ErrRecordNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return user.Disabled, nil
+}
@@ -0,0 +1,229 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type workspaceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWorkspaceRepo(data *Data, logger log.Logger) biz.WorkspaceRepo {
+	return &workspaceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *workspaceRepo) CreateWorkspace(ctx context.Context, ws biz.Workspace, ownerPhone string) error {
+	return r.data.mysqlClient.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&Workspace{UID: ws.UID, Name: ws.Name, OwnerPhone: ownerPhone}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&WorkspaceMember{WorkspaceUID: ws.UID, Phone: ownerPhone, Role: workspace.RoleOwner}).Error
+	})
+}
+
+func (r *workspaceRepo) CountOwnedWorkspaces(ctx context.Context, phone string) (int64, error) {
+	var count int64
+	err := r.data.mysqlClient.Model(&Workspace{}).Where("owner_phone = ?", phone).Count(&count).Error
+	return count, err
+}
+
+func (r *workspaceRepo) ListWorkspaces(ctx context.Context, phone string) ([]biz.Workspace, error) {
+	var rows []struct {
+		UID       string
+		Name      string
+		Role      string
+		CreatedAt time.Time
+	}
+	err := r.data.mysqlClient.Table("workspace_members AS m").
+		Select("w.uid, w.name, m.role, w.created_at").
+		Joins("JOIN workspaces AS w ON w.uid = m.workspace_uid AND w.deleted_at IS NULL").
+		Where("m.phone = ? AND m.deleted_at IS NULL", phone).
+		Order("w.created_at").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	workspaces := make([]biz.Workspace, 0, len(rows))
+	for _, row := range rows {
+		workspaces = append(workspaces, biz.Workspace{UID: row.UID, Name: row.Name, Role: row.Role, CreatedAt: row.CreatedAt})
+	}
+	return workspaces, nil
+}
+
+func (r *workspaceRepo) GetMemberRole(ctx context.Context, uid, phone string) (string, error) {
+	var member WorkspaceMember
+	if err := r.data.mysqlClient.Where("workspace_uid = ? AND phone = ?", uid, phone).First(&member).Error; err != nil {
+		return "", err
+	}
+	return member.Role, nil
+}
+
+func (r *workspaceRepo) ListMembers(ctx context.Context, uid string) ([]biz.WorkspaceMember, error) {
+	var rows []struct {
+		Phone     string
+		Name      string
+		Role      string
+		CreatedAt time.Time
+	}
+	err := r.data.mysqlClient.Table("workspace_members AS m").
+		Select("m.phone, u.name, m.role, m.created_at").
+		Joins("LEFT JOIN users AS u ON u.phone = m.phone AND u.deleted_at IS NULL").
+		Where("m.workspace_uid = ? AND m.deleted_at IS NULL", uid).
+		Order("m.created_at").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	members := make([]biz.WorkspaceMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, biz.WorkspaceMember{Phone: row.Phone, Name: row.Name, Role: row.Role, JoinedAt: row.CreatedAt})
+	}
+	return members, nil
+}
+
+// keepAnotherOwner 锁住工作区的所有者记录，phone 是最后一位所有者时返回 biz.ErrLastOwner。
+// 并发的降级或移除在锁上排队，后执行的一方能看到前一方提交后的所有者数量
+func keepAnotherOwner(tx *gorm.DB, uid, phone string) error {
+	var owners []WorkspaceMember
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("workspace_uid = ? AND role = ?", uid, workspace.RoleOwner).Find(&owners).Error; err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		if owner.Phone == phone && len(owners) <= 1 {
+			return biz.ErrLastOwner
+		}
+	}
+	return nil
+}
+
+func (r *workspaceRepo) SetMemberRole(ctx context.Context, uid, phone, role string) error {
+	return r.data.mysqlClient.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if role != workspace.RoleOwner {
+			if err := keepAnotherOwner(tx, uid, phone); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&WorkspaceMember{}).Where("workspace_uid = ? AND phone = ?", uid, phone).Update("role", role).Error
+	})
+}
+
+// RemoveMember 直接删除记录，之后还能通过邀请重新加入
+func (r *workspaceRepo) RemoveMember(ctx context.Context, uid, phone string) error {
+	return r.data.mysqlClient.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := keepAnotherOwner(tx, uid, phone); err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("workspace_uid = ? AND phone = ?", uid, phone).Delete(&WorkspaceMember{}).Error
+	})
+}
+
+func (r *workspaceRepo) CreateInvite(ctx context.Context, invite biz.WorkspaceInvite, hash string) error {
+	return r.data.mysqlClient.Create(&WorkspaceInvite{
+		UID:          invite.UID,
+		WorkspaceUID: invite.WorkspaceUID,
+		TokenHash:    hash,
+		Prefix:       invite.Prefix,
+		Role:         invite.Role,
+		CreatedBy:    invite.CreatedBy,
+		ExpiresAt:    invite.ExpiresAt,
+		MaxUses:      invite.MaxUses,
+	}).Error
+}
+
+func (r *workspaceRepo) ListInvites(ctx context.Context, uid string, now time.Time) ([]biz.WorkspaceInvite, error) {
+	var invites []WorkspaceInvite
+	err := r.data.mysqlClient.Where("workspace_uid = ? AND expires_at > ? AND (max_uses = 0 OR uses < max_uses)", uid, now).
+		Order("created_at DESC").Find(&invites).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make([]biz.WorkspaceInvite, 0, len(invites))
+	for _, invite := range invites {
+		result = append(result, toBizInvite(invite))
+	}
+	return result, nil
+}
+
+func (r *workspaceRepo) RevokeInvite(ctx context.Context, uid, inviteUID string) error {
+	result := r.data.mysqlClient.Where("workspace_uid = ? AND uid = ?", uid, inviteUID).Delete(&WorkspaceInvite{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *workspaceRepo) AcceptInvite(ctx context.Context, hash, phone string, now time.Time) (biz.Workspace, error) {
+	var ws biz.Workspace
+	err := r.data.mysqlClient.Transaction(func(tx *gorm.DB) error {
+		var invite WorkspaceInvite
+		if err := tx.Where("token_hash = ?", hash).First(&invite).Error; err != nil {
+			return err
+		}
+		var w Workspace
+		if err := tx.Where("uid = ?", invite.WorkspaceUID).First(&w).Error; err != nil {
+			return err
+		}
+		ws = biz.Workspace{UID: w.UID, Name: w.Name, Role: invite.Role, CreatedAt: w.CreatedAt}
+
+		var member WorkspaceMember
+		err := tx.Where("workspace_uid = ? AND phone = ?", w.UID, phone).First(&member).Error
+		if err == nil {
+			// 已经是成员时不占用邀请次数，也不改变原有角色
+			ws.Role = member.Role
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		// 条件更新保证并发接受时不会超过使用次数
+		result := tx.Model(&WorkspaceInvite{}).
+			Where("id = ? AND expires_at > ? AND (max_uses = 0 OR uses < max_uses)", invite.ID, now).
+			Update("uses", gorm.Expr("uses + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(&WorkspaceMember{WorkspaceUID: w.UID, Phone: phone, Role: invite.Role}).Error
+	})
+	return ws, err
+}
+
+func (r *workspaceRepo) CacheMemberRole(ctx context.Context, uid, phone, role string, ttl time.Duration) error {
+	return r.data.redisClient.Set(ctx, workspace.MemberKey(uid, phone), role, ttl).Err()
+}
+
+func (r *workspaceRepo) DeleteMemberCache(ctx context.Context, uid, phone string) error {
+	return r.data.redisClient.Del(ctx, workspace.MemberKey(uid, phone)).Err()
+}
+
+func toBizInvite(invite WorkspaceInvite) biz.WorkspaceInvite {
+	return biz.WorkspaceInvite{
+		UID:          invite.UID,
+		WorkspaceUID: invite.WorkspaceUID,
+		Role:         invite.Role,
+		Prefix:       invite.Prefix,
+		CreatedBy:    invite.CreatedBy,
+		CreatedAt:    invite.CreatedAt,
+		ExpiresAt:    invite.ExpiresAt,
+		MaxUses:      invite.MaxUses,
+		Uses:         invite.Uses,
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+)
+
+func (s *UserService) AdminGetUsers(ctx context.Context, req *v1.AdminGetUsersRequest) (*v1.AdminGetUsersReply, error) {
+	users, total, err := s.auc.GetUsers(ctx, biz.UserFilter{
+		Keyword:      req.Keyword,
+		DisabledOnly: req.DisabledOnly,
+		Page:         int(req.Page),
+		PageSize:     int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.AdminGetUsersReply{Total: total}
+	for _, user := range users {
+		u := &v1.AdminUser{
+			Phone:          user.Phone,
+			Name:           user.Name,
+			Avatar:         user.Avatar,
+			Admin:          user.Admin,
+			Disabled:       user.Disabled,
+			DisabledReason: user.DisabledReason,
+			CreatedAt:      user.CreatedAt.Unix(),
+		}
+		if user.DisabledAt != nil {
+			u.DisabledAt = user.DisabledAt.Unix()
+		}
+		reply.Users = append(reply.Users, u)
+	}
+	return reply, nil
+}
+
+func (s *UserService) AdminSetUserStatus(ctx context.Context, req *v1.AdminSetUserStatusRequest) (*v1.AdminSetUserStatusReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.auc.SetUserStatus(ctx, caller, req.Phone, req.Disabled, req.Reason); err != nil {
+		return nil, err
+	}
+	return &v1.AdminSetUserStatusReply{Message: "success"}, nil
+}
+
+func (s *UserService) AdminGetAuditLogs(ctx context.Context, req *v1.AdminGetAuditLogsRequest) (*v1.AdminGetAuditLogsReply, error) {
+	logs, total, err := s.auc.GetAuditLogs(ctx, biz.AuditLogFilter{
+		Actor:     req.Actor,
+		Operation: req.Operation,
+		Target:    req.Target,
+		Page:      int(req.Page),
+		PageSize:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.AdminGetAuditLogsReply{Total: total}
+	for _, l := range logs {
+		reply.Logs = append(reply.Logs, &v1.AuditLog{
+			Uid:       l.UID,
+			Actor:     l.Actor,
+			Operation: l.Operation,
+			Target:    l.Target,
+			Request:   l.Request,
+			Success:   l.Success,
+			Error:     l.Error,
+			Ip:        l.IP,
+			CreatedAt: l.CreatedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *UserService) RecordAuditLog(ctx context.Context, req *v1.RecordAuditLogRequest) (*v1.RecordAuditLogReply, error) {
+	if req.Log == nil {
+		return nil, biz.ErrAuditLogInvalid
+	}
+	entry := biz.AuditLog{
+		Actor:     req.Log.Actor,
+		Operation: req.Log.Operation,
+		Target:    req.Log.Target,
+		Request:   req.Log.Request,
+		Success:   req.Log.Success,
+		Error:     req.Log.Error,
+		IP:        req.Log.Ip,
+	}
+	if req.Log.CreatedAt > 0 {
+		entry.CreatedAt = time.Unix(req.Log.CreatedAt, 0)
+	}
+	if err := s.auc.RecordAuditLog(ctx, entry); err != nil {
+		return nil, err
+	}
+	return &v1.RecordAuditLogReply{}, nil
+}
+
+func (s *UserService) CheckAdmin(ctx context.Context, req *v1.CheckAdminRequest) (*v1.CheckAdminReply, error) {
+	if req.Phone == "" {
+		return nil, identity.ErrUnauthenticated
+	}
+	return &v1.CheckAdminReply{Admin: s.auc.IsAdmin(req.Phone)}, nil
+}
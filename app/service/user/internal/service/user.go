@@ -5,20 +5,23 @@ import (
 
 	v1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
 	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
 )
 
 type UserService struct {
 	v1.UnimplementedUserServer
 
-	uc *biz.UserUsecase
+	uc  *biz.UserUsecase
+	wuc *biz.WorkspaceUsecase
+	auc *biz.AdminUsecase
 }
 
-func NewUserService(uc *biz.UserUsecase) *UserService {
-	return &UserService{uc: uc}
+func NewUserService(uc *biz.UserUsecase, wuc *biz.WorkspaceUsecase, auc *biz.AdminUsecase) *UserService {
+	return &UserService{uc: uc, wuc: wuc, auc: auc}
 }
 
 func (s *UserService) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginReply, error) {
-	accessToken, refreshToken, err := s.uc.Login(ctx, req.Phone, req.Password)
+	accessToken, refreshToken, err := s.uc.Login(ctx, req.Phone, req.Password, req.Device, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -32,16 +35,179 @@ func (s *UserService) Register(ctx context.Context, req *v1.RegisterRequest) (*v
 }
 
 func (s *UserService) SetProfile(ctx context.Context, req *v1.SetProfileRequest) (*v1.SetProfileReply, error) {
-	if err := s.uc.SetProfile(ctx, req.Phone, biz.Profile{Name: req.Profile.Name, Avatar: req.Profile.Avatar}); err != nil {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.uc.SetProfile(ctx, caller, biz.Profile{Name: req.Profile.Name, Avatar: req.Profile.Avatar}); err != nil {
 		return nil, err
 	}
 	return &v1.SetProfileReply{}, nil
 }
 
 func (s *UserService) GetProfile(ctx context.Context, req *v1.GetProfileRequest) (*v1.GetProfileReply, error) {
-	profile, err := s.uc.GetProfile(ctx, req.Phone)
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.uc.GetProfile(ctx, caller)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.GetProfileReply{Profile: &v1.Profile{Name: profile.Name, Avatar: profile.Avatar}}, nil
 }
+
+func (s *UserService) ChangePassword(ctx context.Context, req *v1.ChangePasswordRequest) (*v1.ChangePasswordReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.uc.ChangePassword(ctx, caller, req.SessionId, req.OldPassword, req.NewPassword); err != nil {
+		return nil, err
+	}
+	return &v1.ChangePasswordReply{Message: "success"}, nil
+}
+
+func (s *UserService) SendResetCode(ctx context.Context, req *v1.SendResetCodeRequest) (*v1.SendResetCodeReply, error) {
+	if err := s.uc.SendResetCode(ctx, req.Phone); err != nil {
+		return nil, err
+	}
+	return &v1.SendResetCodeReply{Message: "success"}, nil
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest) (*v1.ResetPasswordReply, error) {
+	if err := s.uc.ResetPassword(ctx, req.Phone, req.Code, req.NewPassword); err != nil {
+		return nil, err
+	}
+	return &v1.ResetPasswordReply{Message: "success"}, nil
+}
+
+func (s *UserService) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
+	accessToken, refreshToken, err := s.uc.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RefreshTokenReply{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func (s *UserService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.uc.Logout(ctx, caller, req.CurrentSessionId, req.SessionId); err != nil {
+		return nil, err
+	}
+	return &v1.LogoutReply{Message: "success"}, nil
+}
+
+func (s *UserService) LogoutAllDevices(ctx context.Context, req *v1.LogoutAllDevicesRequest) (*v1.LogoutAllDevicesReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.uc.LogoutAllDevices(ctx, caller); err != nil {
+		return nil, err
+	}
+	return &v1.LogoutAllDevicesReply{Message: "success"}, nil
+}
+
+func (s *UserService) ListSessions(ctx context.Context, req *v1.ListSessionsRequest) (*v1.ListSessionsReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.uc.ListSessions(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListSessionsReply{}
+	for _, session := range sessions {
+		reply.Sessions = append(reply.Sessions, &v1.Session{
+			Id:         session.ID,
+			Device:     session.Device,
+			Ip:         session.IP,
+			CreatedAt:  session.CreatedAt.Unix(),
+			LastUsedAt: session.LastUsedAt.Unix(),
+			Current:    session.ID == req.CurrentSessionId,
+		})
+	}
+	return reply, nil
+}
+
+func (s *UserService) OIDCLogin(ctx context.Context, req *v1.OIDCLoginRequest) (*v1.OIDCLoginReply, error) {
+	accessToken, refreshToken, created, err := s.uc.OIDCLogin(ctx, biz.ExternalIdentity{
+		Provider:   req.Provider,
+		Subject:    req.Subject,
+		Email:      req.Email,
+		Name:       req.Name,
+		Phone:      req.Phone,
+		TrustPhone: req.TrustPhone,
+	}, req.Device, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.OIDCLoginReply{AccessToken: accessToken, RefreshToken: refreshToken, Created: created}, nil
+}
+
+func (s *UserService) CreatePersonalAccessToken(ctx context.Context, req *v1.CreatePersonalAccessTokenRequest) (*v1.CreatePersonalAccessTokenReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, secret, err := s.uc.CreatePersonalAccessToken(ctx, caller, req.Name, req.Scopes, int(req.ExpiresInDays))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreatePersonalAccessTokenReply{Token: toPersonalAccessToken(token), Secret: secret}, nil
+}
+
+func (s *UserService) ListPersonalAccessTokens(ctx context.Context, req *v1.ListPersonalAccessTokensRequest) (*v1.ListPersonalAccessTokensReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.uc.ListPersonalAccessTokens(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListPersonalAccessTokensReply{}
+	for _, token := range tokens {
+		reply.Tokens = append(reply.Tokens, toPersonalAccessToken(token))
+	}
+	return reply, nil
+}
+
+func (s *UserService) RevokePersonalAccessToken(ctx context.Context, req *v1.RevokePersonalAccessTokenRequest) (*v1.RevokePersonalAccessTokenReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.uc.RevokePersonalAccessToken(ctx, caller, req.Uid); err != nil {
+		return nil, err
+	}
+	return &v1.RevokePersonalAccessTokenReply{Message: "success"}, nil
+}
+
+func (s *UserService) VerifyPersonalAccessToken(ctx context.Context, req *v1.VerifyPersonalAccessTokenRequest) (*v1.VerifyPersonalAccessTokenReply, error) {
+	token, err := s.uc.VerifyPersonalAccessToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.VerifyPersonalAccessTokenReply{Uid: token.UID, Phone: token.Phone, Scopes: token.Scopes, ExpiresAt: token.ExpiresAt.Unix()}, nil
+}
+
+func toPersonalAccessToken(token biz.PersonalAccessToken) *v1.PersonalAccessToken {
+	reply := &v1.PersonalAccessToken{
+		Uid:       token.UID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		Prefix:    token.Prefix,
+		CreatedAt: token.CreatedAt.Unix(),
+		ExpiresAt: token.ExpiresAt.Unix(),
+	}
+	if token.LastUsedAt != nil {
+		reply.LastUsedAt = token.LastUsedAt.Unix()
+	}
+	return reply
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/Fl0rencess720/Ayana/api/gateway/user/v1"
+	"github.com/Fl0rencess720/Ayana/app/service/user/internal/biz"
+	"github.com/Fl0rencess720/Ayana/pkgs/identity"
+)
+
+func (s *UserService) CreateWorkspace(ctx context.Context, req *v1.CreateWorkspaceRequest) (*v1.CreateWorkspaceReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ws, err := s.wuc.CreateWorkspace(ctx, caller, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateWorkspaceReply{Workspace: toWorkspace(ws)}, nil
+}
+
+func (s *UserService) GetWorkspaces(ctx context.Context, req *v1.GetWorkspacesRequest) (*v1.GetWorkspacesReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	workspaces, err := s.wuc.GetWorkspaces(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetWorkspacesReply{}
+	for _, ws := range workspaces {
+		reply.Workspaces = append(reply.Workspaces, toWorkspace(ws))
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetWorkspaceMembers(ctx context.Context, req *v1.GetWorkspaceMembersRequest) (*v1.GetWorkspaceMembersReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.wuc.GetMembers(ctx, caller, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetWorkspaceMembersReply{}
+	for _, member := range members {
+		reply.Members = append(reply.Members, &v1.WorkspaceMember{
+			Phone:    member.Phone,
+			Name:     member.Name,
+			Role:     member.Role,
+			JoinedAt: member.JoinedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *UserService) SetWorkspaceMember(ctx context.Context, req *v1.SetWorkspaceMemberRequest) (*v1.SetWorkspaceMemberReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.wuc.SetMemberRole(ctx, caller, req.Uid, req.MemberPhone, req.Role); err != nil {
+		return nil, err
+	}
+	return &v1.SetWorkspaceMemberReply{Message: "success"}, nil
+}
+
+func (s *UserService) RemoveWorkspaceMember(ctx context.Context, req *v1.RemoveWorkspaceMemberRequest) (*v1.RemoveWorkspaceMemberReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.wuc.RemoveMember(ctx, caller, req.Uid, req.MemberPhone); err != nil {
+		return nil, err
+	}
+	return &v1.RemoveWorkspaceMemberReply{Message: "success"}, nil
+}
+
+func (s *UserService) CreateWorkspaceInvite(ctx context.Context, req *v1.CreateWorkspaceInviteRequest) (*v1.CreateWorkspaceInviteReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invite, token, err := s.wuc.CreateInvite(ctx, caller, req.Uid, req.Role, time.Duration(req.ExpiresInHours)*time.Hour, req.MaxUses)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateWorkspaceInviteReply{Invite: toWorkspaceInvite(invite), Token: token}, nil
+}
+
+func (s *UserService) GetWorkspaceInvites(ctx context.Context, req *v1.GetWorkspaceInvitesRequest) (*v1.GetWorkspaceInvitesReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := s.wuc.GetInvites(ctx, caller, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetWorkspaceInvitesReply{}
+	for _, invite := range invites {
+		reply.Invites = append(reply.Invites, toWorkspaceInvite(invite))
+	}
+	return reply, nil
+}
+
+func (s *UserService) RevokeWorkspaceInvite(ctx context.Context, req *v1.RevokeWorkspaceInviteRequest) (*v1.RevokeWorkspaceInviteReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.wuc.RevokeInvite(ctx, caller, req.Uid, req.InviteUid); err != nil {
+		return nil, err
+	}
+	return &v1.RevokeWorkspaceInviteReply{Message: "success"}, nil
+}
+
+func (s *UserService) AcceptWorkspaceInvite(ctx context.Context, req *v1.AcceptWorkspaceInviteRequest) (*v1.AcceptWorkspaceInviteReply, error) {
+	caller, err := identity.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ws, err := s.wuc.AcceptInvite(ctx, caller, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AcceptWorkspaceInviteReply{Workspace: toWorkspace(ws)}, nil
+}
+
+func (s *UserService) GetWorkspaceRole(ctx context.Context, req *v1.GetWorkspaceRoleRequest) (*v1.GetWorkspaceRoleReply, error) {
+	role, err := s.wuc.GetRole(ctx, req.Uid, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetWorkspaceRoleReply{Role: role}, nil
+}
+
+func toWorkspace(ws biz.Workspace) *v1.Workspace {
+	return &v1.Workspace{Uid: ws.UID, Name: ws.Name, Role: ws.Role, CreatedAt: ws.CreatedAt.Unix()}
+}
+
+func toWorkspaceInvite(invite biz.WorkspaceInvite) *v1.WorkspaceInvite {
+	return &v1.WorkspaceInvite{
+		Uid:       invite.UID,
+		Role:      invite.Role,
+		Prefix:    invite.Prefix,
+		CreatedAt: invite.CreatedAt.Unix(),
+		ExpiresAt: invite.ExpiresAt.Unix(),
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+	}
+}
@@ -0,0 +1,50 @@
+package broadcast
+
+import (
+	"context"
+	"time"
+)
+
+// Backoff 读取失败后的等待间隔，从 Min 开始每次翻倍直到 Max，读取成功后调用 Reset
+type Backoff struct {
+	Min  time.Duration
+	Max  time.Duration
+	next time.Duration
+}
+
+// NewBackoff 返回读取消息时使用的默认退避
+func NewBackoff() *Backoff {
+	return &Backoff{Min: 100 * time.Millisecond, Max: 5 * time.Second}
+}
+
+func (b *Backoff) Next() time.Duration {
+	if b.next < b.Min {
+		b.next = b.Min
+	}
+	d := b.next
+	b.next *= 2
+	if b.next > b.Max {
+		b.next = b.Max
+	}
+	return d
+}
+
+func (b *Backoff) Reset() {
+	b.next = 0
+}
+
+// Wait 等待下一个间隔，ctx 结束时返回它的错误
+func (b *Backoff) Wait(ctx context.Context) error {
+	return wait(ctx, b.Next())
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,43 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+)
+
+const (
+	DriverMemory = "memory"
+	DriverRedis  = "redis"
+	DriverKafka  = "kafka"
+)
+
+var ErrClosed = errors.New("broadcast: subscription closed")
+
+type Message struct {
+	Key   string
+	Value []byte
+}
+
+// Broker 是 token 广播和暂停信号共用的消息总线抽象，三种实现遵循相同的语义：
+//   - group 不为空时同组订阅者竞争消费，同一个 Key 的消息按发布顺序投递给同一个订阅者；
+//   - group 为空时每个订阅者都会收到全部消息；
+//   - 订阅只能收到订阅之后发布的消息；
+//   - Publish 不等待订阅者消费，消费过慢的订阅者会丢失消息。
+type Broker interface {
+	Publish(ctx context.Context, topic string, msgs ...Message) error
+	Subscribe(ctx context.Context, topic, group string) (Subscription, error)
+	Close() error
+}
+
+type Subscription interface {
+	// Next 阻塞直到收到下一条消息，订阅关闭后返回 ErrClosed
+	Next(ctx context.Context) (Message, error)
+	Close() error
+}
+
+func keyIndex(key string, n int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(n))
+}
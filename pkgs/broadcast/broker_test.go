@@ -0,0 +1,246 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// brokerFactory 为一组测试创建 broker，settle 是订阅生效需要等待的时间，
+// Kafka 的 reader 加入消费组需要时间，内存实现不需要等待
+type brokerFactory struct {
+	newBroker func(t *testing.T) Broker
+	settle    time.Duration
+}
+
+// Redis 和 Kafka 的测试需要真实服务，通过环境变量指定地址，未设置时跳过
+func TestMemoryBroker(t *testing.T) {
+	runBrokerSuite(t, brokerFactory{newBroker: func(t *testing.T) Broker { return NewMemoryBroker() }})
+}
+
+func TestRedisBroker(t *testing.T) {
+	addr := os.Getenv("BROADCAST_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("BROADCAST_TEST_REDIS_ADDR not set")
+	}
+	runBrokerSuite(t, brokerFactory{newBroker: func(t *testing.T) Broker {
+		client := redis.NewClient(&redis.Options{Addr: addr})
+		t.Cleanup(func() { client.Close() })
+		return NewRedisBroker(client)
+	}})
+}
+
+func TestKafkaBroker(t *testing.T) {
+	brokers := os.Getenv("BROADCAST_TEST_KAFKA_BROKERS")
+	if brokers == "" {
+		t.Skip("BROADCAST_TEST_KAFKA_BROKERS not set")
+	}
+	runBrokerSuite(t, brokerFactory{
+		newBroker: func(t *testing.T) Broker { return NewKafkaBroker(strings.Split(brokers, ",")) },
+		settle:    10 * time.Second,
+	})
+}
+
+func runBrokerSuite(t *testing.T, f brokerFactory) {
+	t.Run("FanoutReceivesAll", func(t *testing.T) { testFanoutReceivesAll(t, f) })
+	t.Run("OnlyLaterMessages", func(t *testing.T) { testOnlyLaterMessages(t, f) })
+	t.Run("GroupDeliversOnceInKeyOrder", func(t *testing.T) { testGroupDeliversOnceInKeyOrder(t, f) })
+	t.Run("SlowSubscriberDoesNotBlockPublish", func(t *testing.T) { testSlowSubscriberDoesNotBlockPublish(t, f) })
+	t.Run("ClosedSubscription", func(t *testing.T) { testClosedSubscription(t, f) })
+}
+
+func newTestBroker(t *testing.T, f brokerFactory) Broker {
+	b := f.newBroker(t)
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+// testTopic 每个用例使用独立的主题，避免共享的 Redis 或 Kafka 中残留的消息互相影响
+func testTopic(t *testing.T) string {
+	name := strings.NewReplacer("/", "-", " ", "-").Replace(t.Name())
+	return fmt.Sprintf("broadcast-test-%s-%d", name, time.Now().UnixNano())
+}
+
+func subscribe(t *testing.T, b Broker, topic, group string) Subscription {
+	t.Helper()
+	sub, err := b.Subscribe(context.Background(), topic, group)
+	if err != nil {
+		t.Fatalf("subscribe %s/%s: %v", topic, group, err)
+	}
+	t.Cleanup(func() { sub.Close() })
+	return sub
+}
+
+func publish(t *testing.T, b Broker, topic string, msgs ...Message) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := b.Publish(ctx, topic, msgs...); err != nil {
+		t.Fatalf("publish to %s: %v", topic, err)
+	}
+}
+
+func next(t *testing.T, sub Subscription, timeout time.Duration) Message {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	msg, err := sub.Next(ctx)
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+	return msg
+}
+
+func testFanoutReceivesAll(t *testing.T, f brokerFactory) {
+	b := newTestBroker(t, f)
+	topic := testTopic(t)
+	subs := []Subscription{subscribe(t, b, topic, ""), subscribe(t, b, topic, "")}
+	time.Sleep(f.settle)
+
+	want := []string{"a", "b", "c"}
+	for _, v := range want {
+		publish(t, b, topic, Message{Key: "k", Value: []byte(v)})
+	}
+	for i, sub := range subs {
+		for _, v := range want {
+			if got := string(next(t, sub, 10*time.Second).Value); got != v {
+				t.Fatalf("subscriber %d: got %q, want %q", i, got, v)
+			}
+		}
+	}
+}
+
+func testOnlyLaterMessages(t *testing.T, f brokerFactory) {
+	b := newTestBroker(t, f)
+	topic := testTopic(t)
+	publish(t, b, topic, Message{Key: "k", Value: []byte("before")})
+	sub := subscribe(t, b, topic, "")
+	time.Sleep(f.settle)
+
+	publish(t, b, topic, Message{Key: "k", Value: []byte("after")})
+	if got := string(next(t, sub, 10*time.Second).Value); got != "after" {
+		t.Fatalf("got %q, want %q", got, "after")
+	}
+}
+
+// testGroupDeliversOnceInKeyOrder 同组订阅者合起来每条消息只收到一次，同一个 Key 的消息顺序不变
+func testGroupDeliversOnceInKeyOrder(t *testing.T, f brokerFactory) {
+	b := newTestBroker(t, f)
+	topic := testTopic(t)
+	subs := []Subscription{subscribe(t, b, topic, "group"), subscribe(t, b, topic, "group")}
+	time.Sleep(f.settle)
+
+	const keys, perKey = 4, 25
+	var msgs []Message
+	for i := 0; i < perKey; i++ {
+		for k := 0; k < keys; k++ {
+			msgs = append(msgs, Message{Key: fmt.Sprintf("key-%d", k), Value: []byte(fmt.Sprintf("%d", i))})
+		}
+	}
+	publish(t, b, topic, msgs...)
+
+	var (
+		mu       sync.Mutex
+		received = map[string][]string{}
+		total    int
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, sub := range subs {
+		wg.Add(1)
+		go func(sub Subscription) {
+			defer wg.Done()
+			for {
+				msg, err := sub.Next(ctx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				received[msg.Key] = append(received[msg.Key], string(msg.Value))
+				total++
+				if total == len(msgs) {
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}(sub)
+	}
+	wg.Wait()
+
+	if total != len(msgs) {
+		t.Fatalf("received %d messages, want %d", total, len(msgs))
+	}
+	for key, values := range received {
+		for i, v := range values {
+			if v != fmt.Sprintf("%d", i) {
+				t.Fatalf("key %s: message %d is %q, order broken: %v", key, i, v, values)
+			}
+		}
+	}
+
+	// 消费完成后不应再有重复投递
+	ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	for _, sub := range subs {
+		if msg, err := sub.Next(ctx); err == nil {
+			t.Fatalf("unexpected duplicate message %q", msg.Value)
+		}
+	}
+}
+
+// testSlowSubscriberDoesNotBlockPublish 订阅者不读取时发布也要立即返回，订阅者之后仍能读到最早的消息
+func testSlowSubscriberDoesNotBlockPublish(t *testing.T, f brokerFactory) {
+	b := newTestBroker(t, f)
+	topic := testTopic(t)
+	slow := subscribe(t, b, topic, "")
+	time.Sleep(f.settle)
+
+	msgs := make([]Message, memoryBufferSize+100)
+	for i := range msgs {
+		msgs[i] = Message{Key: "k", Value: []byte(fmt.Sprintf("%d", i))}
+	}
+	start := time.Now()
+	publish(t, b, topic, msgs...)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("publish blocked for %s by a subscriber that never reads", elapsed)
+	}
+	if got := string(next(t, slow, 10*time.Second).Value); got != "0" {
+		t.Fatalf("got %q, want the first message", got)
+	}
+}
+
+func testClosedSubscription(t *testing.T, f brokerFactory) {
+	b := newTestBroker(t, f)
+	topic := testTopic(t)
+	sub := subscribe(t, b, topic, "")
+	if err := sub.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := sub.Next(ctx); !errors.Is(err, ErrClosed) {
+		t.Fatalf("next after close: got %v, want ErrClosed", err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	b := &Backoff{Min: 10 * time.Millisecond, Max: 40 * time.Millisecond}
+	want := []time.Duration{10, 20, 40, 40}
+	for i, w := range want {
+		if got := b.Next(); got != w*time.Millisecond {
+			t.Fatalf("step %d: got %s, want %s", i, got, w*time.Millisecond)
+		}
+	}
+	b.Reset()
+	if got := b.Next(); got != b.Min {
+		t.Fatalf("after reset: got %s, want %s", got, b.Min)
+	}
+}
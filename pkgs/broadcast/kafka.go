@@ -0,0 +1,216 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaOption func(*kafkaBroker)
+
+// WithKafkaReaders 设置消费组订阅时并发读取的 reader 数量，一般与分区数一致
+func WithKafkaReaders(n int) KafkaOption {
+	return func(b *kafkaBroker) {
+		if n > 0 {
+			b.readers = n
+		}
+	}
+}
+
+type kafkaBroker struct {
+	brokers []string
+	readers int
+
+	mu      sync.Mutex
+	writers map[string]*kafka.Writer
+}
+
+func NewKafkaBroker(brokers []string, opts ...KafkaOption) Broker {
+	b := &kafkaBroker{
+		brokers: brokers,
+		readers: 1,
+		writers: make(map[string]*kafka.Writer),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+func (b *kafkaBroker) writer(topic string) *kafka.Writer {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	w, ok := b.writers[topic]
+	if !ok {
+		w = kafka.NewWriter(kafka.WriterConfig{
+			Brokers: b.brokers,
+			Topic:   topic,
+			Async:   true,
+			// 同一个 Key 写入同一个分区，消费组内才能保证同一个 Key 的顺序
+			Balancer: &kafka.Hash{},
+		})
+		// 异步写入的错误只能在回调中拿到
+		w.Completion = func(messages []kafka.Message, err error) {
+			if err != nil {
+				publishErrors.WithLabelValues(DriverKafka, topic).Add(float64(len(messages)))
+			}
+		}
+		b.writers[topic] = w
+	}
+	return w
+}
+
+func (b *kafkaBroker) Publish(ctx context.Context, topic string, msgs ...Message) error {
+	kafkaMessages := make([]kafka.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		kafkaMessages = append(kafkaMessages, kafka.Message{
+			Key:   []byte(msg.Key),
+			Value: msg.Value,
+		})
+	}
+	if err := b.writer(topic).WriteMessages(ctx, kafkaMessages...); err != nil {
+		publishErrors.WithLabelValues(DriverKafka, topic).Add(float64(len(msgs)))
+		return err
+	}
+	return nil
+}
+
+func (b *kafkaBroker) Subscribe(ctx context.Context, topic, group string) (Subscription, error) {
+	var configs []kafka.ReaderConfig
+	if group != "" {
+		for i := 0; i < b.readers; i++ {
+			configs = append(configs, kafka.ReaderConfig{
+				Brokers:     b.brokers,
+				Topic:       topic,
+				GroupID:     group,
+				StartOffset: kafka.LastOffset,
+			})
+		}
+	} else {
+		// 不使用消费组时每个分区单独起一个 reader，保证订阅者能收到全部分区的消息
+		partitions, err := b.partitions(ctx, topic)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range partitions {
+			configs = append(configs, kafka.ReaderConfig{
+				Brokers:     b.brokers,
+				Topic:       topic,
+				Partition:   p.ID,
+				StartOffset: kafka.LastOffset,
+			})
+		}
+	}
+	sub := &kafkaSubscription{
+		msgs: make(chan Message),
+		errs: make(chan error, len(configs)),
+		done: make(chan struct{}),
+	}
+	for _, config := range configs {
+		reader := kafka.NewReader(config)
+		sub.readers = append(sub.readers, reader)
+		go sub.read(reader)
+	}
+	return sub, nil
+}
+
+func (b *kafkaBroker) partitions(ctx context.Context, topic string) ([]kafka.Partition, error) {
+	var lastErr error
+	for _, addr := range b.brokers {
+		conn, err := kafka.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		partitions, err := conn.ReadPartitions(topic)
+		conn.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(partitions) == 0 {
+			lastErr = errors.New("no partitions")
+			continue
+		}
+		return partitions, nil
+	}
+	return nil, fmt.Errorf("read partitions of %s failed: %w", topic, lastErr)
+}
+
+func (b *kafkaBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var errs []error
+	for _, w := range b.writers {
+		errs = append(errs, w.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// kafkaSubscription 把多个 reader 的消息汇总到一个 channel，单个分区内的顺序保持不变
+type kafkaSubscription struct {
+	readers []*kafka.Reader
+	msgs    chan Message
+	errs    chan error
+	done    chan struct{}
+	once    sync.Once
+}
+
+func (s *kafkaSubscription) read(reader *kafka.Reader) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-s.done
+		cancel()
+	}()
+	backoff := NewBackoff()
+	for {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			if errors.Is(err, io.EOF) {
+				s.errs <- err
+				return
+			}
+			readErrors.WithLabelValues(DriverKafka, reader.Config().Topic).Inc()
+			if backoff.Wait(ctx) != nil {
+				return
+			}
+			continue
+		}
+		backoff.Reset()
+		select {
+		case s.msgs <- Message{Key: string(msg.Key), Value: msg.Value}:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *kafkaSubscription) Next(ctx context.Context) (Message, error) {
+	select {
+	case msg := <-s.msgs:
+		return msg, nil
+	case err := <-s.errs:
+		return Message{}, err
+	case <-s.done:
+		return Message{}, ErrClosed
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	}
+}
+
+func (s *kafkaSubscription) Close() error {
+	s.once.Do(func() { close(s.done) })
+	var errs []error
+	for _, reader := range s.readers {
+		errs = append(errs, reader.Close())
+	}
+	return errors.Join(errs...)
+}
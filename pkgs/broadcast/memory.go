@@ -0,0 +1,150 @@
+package broadcast
+
+import (
+	"context"
+	"sync"
+)
+
+const memoryBufferSize = 1024
+
+type memorySubscription struct {
+	broker *memoryBroker
+	topic  string
+	group  string
+	ch     chan Message
+	done   chan struct{}
+	once   sync.Once
+}
+
+type memoryTopic struct {
+	groups map[string][]*memorySubscription
+	fanout []*memorySubscription
+}
+
+// memoryBroker 基于进程内 channel，适用于单进程部署和本地调试
+type memoryBroker struct {
+	mu     sync.RWMutex
+	topics map[string]*memoryTopic
+}
+
+func NewMemoryBroker() Broker {
+	return &memoryBroker{topics: make(map[string]*memoryTopic)}
+}
+
+func (b *memoryBroker) Publish(ctx context.Context, topic string, msgs ...Message) error {
+	for _, msg := range msgs {
+		b.mu.RLock()
+		var targets []*memorySubscription
+		if t, ok := b.topics[topic]; ok {
+			targets = append(targets, t.fanout...)
+			for _, subs := range t.groups {
+				if len(subs) > 0 {
+					targets = append(targets, subs[keyIndex(msg.Key, len(subs))])
+				}
+			}
+		}
+		b.mu.RUnlock()
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// 与 Redis 和 Kafka 一致，发布不等待订阅者消费，缓冲区满时丢弃该订阅者的这条消息
+		for _, sub := range targets {
+			select {
+			case <-sub.done:
+			case sub.ch <- msg:
+			default:
+				droppedMessages.WithLabelValues(DriverMemory, topic).Inc()
+			}
+		}
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(ctx context.Context, topic, group string) (Subscription, error) {
+	sub := &memorySubscription{
+		broker: b,
+		topic:  topic,
+		group:  group,
+		ch:     make(chan Message, memoryBufferSize),
+		done:   make(chan struct{}),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, ok := b.topics[topic]
+	if !ok {
+		t = &memoryTopic{groups: make(map[string][]*memorySubscription)}
+		b.topics[topic] = t
+	}
+	if group == "" {
+		t.fanout = append(t.fanout, sub)
+	} else {
+		t.groups[group] = append(t.groups[group], sub)
+	}
+	return sub, nil
+}
+
+func (b *memoryBroker) remove(sub *memorySubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, ok := b.topics[sub.topic]
+	if !ok {
+		return
+	}
+	if sub.group == "" {
+		t.fanout = removeSubscription(t.fanout, sub)
+		return
+	}
+	t.groups[sub.group] = removeSubscription(t.groups[sub.group], sub)
+	if len(t.groups[sub.group]) == 0 {
+		delete(t.groups, sub.group)
+	}
+}
+
+func (b *memoryBroker) Close() error {
+	b.mu.Lock()
+	topics := b.topics
+	b.topics = make(map[string]*memoryTopic)
+	b.mu.Unlock()
+	for _, t := range topics {
+		for _, sub := range t.fanout {
+			sub.close()
+		}
+		for _, subs := range t.groups {
+			for _, sub := range subs {
+				sub.close()
+			}
+		}
+	}
+	return nil
+}
+
+func (s *memorySubscription) Next(ctx context.Context) (Message, error) {
+	select {
+	case msg := <-s.ch:
+		return msg, nil
+	case <-s.done:
+		return Message{}, ErrClosed
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	}
+}
+
+func (s *memorySubscription) Close() error {
+	s.broker.remove(s)
+	s.close()
+	return nil
+}
+
+func (s *memorySubscription) close() {
+	s.once.Do(func() { close(s.done) })
+}
+
+func removeSubscription(subs []*memorySubscription, target *memorySubscription) []*memorySubscription {
+	for i, sub := range subs {
+		if sub == target {
+			return append(subs[:i:i], subs[i+1:]...)
+		}
+	}
+	return subs
+}
@@ -0,0 +1,27 @@
+package broadcast
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var publishErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "ayana",
+	Subsystem: "broadcast",
+	Name:      "publish_errors_total",
+	Help:      "Messages that failed to be published to the broadcast backend.",
+}, []string{"driver", "topic"})
+
+var droppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "ayana",
+	Subsystem: "broadcast",
+	Name:      "dropped_messages_total",
+	Help:      "Messages dropped because a subscriber's buffer was full.",
+}, []string{"driver", "topic"})
+
+var readErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "ayana",
+	Subsystem: "broadcast",
+	Name:      "read_errors_total",
+	Help:      "Errors returned while reading from the broadcast backend.",
+}, []string{"driver", "topic"})
@@ -0,0 +1,191 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisStreamMaxLen = 100000
+	redisBlockTimeout = time.Second
+	redisReadCount    = 128
+	// redisLeaseTTL 持有租约的订阅者失联后，其他订阅者最多等待这么久接替
+	redisLeaseTTL = 3 * redisBlockTimeout
+)
+
+// renewLease 租约空闲或已属于自己时续期，返回 1 表示持有租约
+var renewLease = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner == false or owner == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+var releaseLease = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func leaseKey(topic, group string) string {
+	return "broadcast:lease:" + topic + ":" + group
+}
+
+// redisBroker 基于 Redis Streams 实现，消费组对应 XGROUP，无消费组时使用 XREAD 从订阅时的最新位置开始广播。
+// Redis 的消费组不按 Key 分配消息，同组多个订阅者同时读取会打乱同一个 Key 的顺序，
+// 因此同组订阅者通过租约轮流读取：同一时刻只有持有租约的订阅者读取，它关闭或失联后由其他订阅者接替。
+type redisBroker struct {
+	client *redis.Client
+}
+
+func NewRedisBroker(client *redis.Client) Broker {
+	return &redisBroker{client: client}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, topic string, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	pipe := b.client.Pipeline()
+	for _, msg := range msgs {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: topic,
+			MaxLen: redisStreamMaxLen,
+			Approx: true,
+			Values: map[string]interface{}{"key": msg.Key, "value": msg.Value},
+		})
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		publishErrors.WithLabelValues(DriverRedis, topic).Add(float64(len(msgs)))
+		return err
+	}
+	return nil
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, topic, group string) (Subscription, error) {
+	sub := &redisSubscription{client: b.client, topic: topic, group: group}
+	if group != "" {
+		err := b.client.XGroupCreateMkStream(ctx, topic, group, "$").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, err
+		}
+		sub.consumer = uuid.NewString()
+		return sub, nil
+	}
+	sub.lastID = "0-0"
+	last, err := b.client.XRevRangeN(ctx, topic, "+", "-", 1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(last) > 0 {
+		sub.lastID = last[0].ID
+	}
+	return sub, nil
+}
+
+func (b *redisBroker) Close() error {
+	return nil
+}
+
+type redisSubscription struct {
+	client   *redis.Client
+	topic    string
+	group    string
+	consumer string
+	lastID   string
+	pending  []Message
+	closed   atomic.Bool
+}
+
+func (s *redisSubscription) Next(ctx context.Context) (Message, error) {
+	for len(s.pending) == 0 {
+		if s.closed.Load() {
+			return Message{}, ErrClosed
+		}
+		if err := ctx.Err(); err != nil {
+			return Message{}, err
+		}
+		if err := s.fetch(ctx); err != nil {
+			return Message{}, err
+		}
+	}
+	msg := s.pending[0]
+	s.pending = s.pending[1:]
+	return msg, nil
+}
+
+func (s *redisSubscription) fetch(ctx context.Context) error {
+	var (
+		streams []redis.XStream
+		err     error
+	)
+	if s.group != "" {
+		var held bool
+		if held, err = s.acquire(ctx); err != nil {
+			return err
+		}
+		if !held {
+			return wait(ctx, redisBlockTimeout)
+		}
+		streams, err = s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  []string{s.topic, ">"},
+			Count:    redisReadCount,
+			Block:    redisBlockTimeout,
+			NoAck:    true,
+		}).Result()
+	} else {
+		streams, err = s.client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{s.topic, s.lastID},
+			Count:   redisReadCount,
+			Block:   redisBlockTimeout,
+		}).Result()
+	}
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		for _, m := range stream.Messages {
+			s.lastID = m.ID
+			key, _ := m.Values["key"].(string)
+			value, _ := m.Values["value"].(string)
+			s.pending = append(s.pending, Message{Key: key, Value: []byte(value)})
+		}
+	}
+	return nil
+}
+
+// acquire 尝试取得或续期消费组的读取租约
+func (s *redisSubscription) acquire(ctx context.Context) (bool, error) {
+	held, err := renewLease.Run(ctx, s.client, []string{leaseKey(s.topic, s.group)},
+		s.consumer, redisLeaseTTL.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return held == 1, nil
+}
+
+func (s *redisSubscription) Close() error {
+	s.closed.Store(true)
+	if s.group != "" {
+		ctx := context.Background()
+		if err := releaseLease.Run(ctx, s.client, []string{leaseKey(s.topic, s.group)}, s.consumer).Err(); err != nil {
+			return err
+		}
+		return s.client.XGroupDelConsumer(ctx, s.topic, s.group, s.consumer).Err()
+	}
+	return nil
+}
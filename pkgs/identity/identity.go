@@ -0,0 +1,100 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/Fl0rencess720/Ayana/pkgs/utils"
+	"github.com/Fl0rencess720/Ayana/pkgs/workspace"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 网关鉴权后的用户身份通过该 gRPC metadata 传给后端服务
+const phoneHeader = "x-ayana-phone"
+
+// 网关检查成员关系后，把请求所在的工作区和调用者的角色传给后端服务
+const (
+	workspaceHeader     = "x-ayana-workspace"
+	workspaceRoleHeader = "x-ayana-workspace-role"
+)
+
+// Client 把 ctx 中已认证的手机号写入请求 metadata
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				for i, kv := 0, headers(ctx); i < len(kv); i += 2 {
+					tr.RequestHeader().Set(kv[i], kv[i+1])
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// OutgoingContext 流式调用不经过客户端中间件，需要直接把身份写入 gRPC metadata
+func OutgoingContext(ctx context.Context) context.Context {
+	if kv := headers(ctx); len(kv) > 0 {
+		return metadata.AppendToOutgoingContext(ctx, kv...)
+	}
+	return ctx
+}
+
+func headers(ctx context.Context) []string {
+	var kv []string
+	if phone := utils.GetPhoneFromContext(ctx); phone != "" {
+		kv = append(kv, phoneHeader, phone)
+	}
+	if scope, ok := workspace.FromContext(ctx); ok {
+		kv = append(kv, workspaceHeader, scope.UID, workspaceRoleHeader, scope.Role)
+	}
+	return kv
+}
+
+// Server 从请求 metadata 中取出调用方身份放入 ctx，后端服务只接受来自网关和内部服务的调用
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(ServerContext(ctx), req)
+		}
+	}
+}
+
+// ServerContext 供不经过服务端中间件的流式接口使用
+func ServerContext(ctx context.Context) context.Context {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if phone := tr.RequestHeader().Get(phoneHeader); phone != "" {
+			ctx = context.WithValue(ctx, jwtc.PhoneKey, phone)
+		}
+		if uid := tr.RequestHeader().Get(workspaceHeader); uid != "" {
+			ctx = workspace.NewContext(ctx, workspace.Scope{UID: uid, Role: tr.RequestHeader().Get(workspaceRoleHeader)})
+		}
+	}
+	return ctx
+}
+
+// ErrUnauthenticated 请求没有经过网关认证
+var ErrUnauthenticated = status.Error(codes.Unauthenticated, "缺少调用者身份")
+
+// Phone 返回网关认证后传来的调用者身份，metadata 中没有身份时返回 ErrUnauthenticated。
+// 面向网关的接口都通过它取调用者，不能使用请求体中的手机号；
+// 只供内部服务调用的接口由调用方在请求中指定手机号
+func Phone(ctx context.Context) (string, error) {
+	if phone := utils.GetPhoneFromContext(ctx); phone != "" {
+		return phone, nil
+	}
+	return "", ErrUnauthenticated
+}
+
+// Owner 取出调用者身份并换算为所在工作区的所有者标识，need 是操作需要的最低工作区角色
+func Owner(ctx context.Context, need string) (string, error) {
+	phone, err := Phone(ctx)
+	if err != nil {
+		return "", err
+	}
+	return workspace.Owner(ctx, phone, need)
+}
@@ -15,22 +15,56 @@ import (
 type ContextKey string
 
 var (
-	PhoneKey = ContextKey("phone")
+	PhoneKey     = ContextKey("phone")
+	SessionIDKey = ContextKey("sid")
+	AdminKey     = ContextKey("admin")
 )
 
+const (
+	AccessTokenTTL  = 1 * time.Hour
+	RefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+var (
+	ErrSessionRevoked  = errors.New("session revoked")
+	ErrAccountDisabled = errors.New("account disabled")
+)
+
+// SessionKey 会话在 Redis 中的 key，用户服务写入，网关鉴权时检查是否存在
+func SessionKey(sid string) string {
+	return "session:" + sid
+}
+
+// DisabledUserKey 被停用账号的标记，用户服务停用账号时写入、启用时删除，网关鉴权时检查
+func DisabledUserKey(phone string) string {
+	return "user_disabled:" + phone
+}
+
 type AuthClaims struct {
-	Phone string `json:"phone"`
+	Phone     string `json:"phone"`
+	SessionID string `json:"sid,omitempty"`
+	// Admin 签发时用户是否为管理员，变更在下次刷新令牌后生效
+	Admin bool `json:"admin,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// RefreshClaims 刷新令牌绑定用户和会话，ID 每次轮换都会变化
+type RefreshClaims struct {
+	Phone     string `json:"phone"`
+	SessionID string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func GenAccessToken(phone string) (string, error) {
+func GenAccessToken(phone, sid string, admin bool) (string, error) {
 	ac := AuthClaims{
-		Phone: phone,
+		Phone:     phone,
+		SessionID: sid,
+		Admin:     admin,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        time.Now().String(),
 			Issuer:    "Fl0rencess720",
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
 		},
 	}
 	accessSecret := viper.GetString("jwtc.accessSecret")
@@ -41,12 +75,16 @@ func GenAccessToken(phone string) (string, error) {
 	return accessToken, nil
 }
 
-func GenRefreshToken() (string, error) {
-	rc := jwt.RegisteredClaims{
-		ID:        time.Now().String(),
-		Issuer:    "Fl0rencess720",
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)),
+func GenRefreshToken(phone, sid, jti string) (string, error) {
+	rc := RefreshClaims{
+		Phone:     phone,
+		SessionID: sid,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Issuer:    "Fl0rencess720",
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(RefreshTokenTTL)),
+		},
 	}
 	refreshSecret := viper.GetString("jwtc.refreshSecret")
 	refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, rc).SignedString([]byte(refreshSecret))
@@ -56,12 +94,13 @@ func GenRefreshToken() (string, error) {
 	return refreshToken, nil
 }
 
-func GenToken(phone string) (string, string, error) {
-	accessToken, err := GenAccessToken(phone)
+// GenToken 为会话签发访问令牌和刷新令牌，jti 是刷新令牌的 ID
+func GenToken(phone, sid, jti string, admin bool) (string, string, error) {
+	accessToken, err := GenAccessToken(phone, sid, admin)
 	if err != nil {
 		return "", "", err
 	}
-	refreshToken, err := GenRefreshToken()
+	refreshToken, err := GenRefreshToken(phone, sid, jti)
 	if err != nil {
 		return "", "", err
 	}
@@ -82,28 +121,66 @@ func ParseToken(aToken string) (*AuthClaims, bool, error) {
 	return nil, true, errors.New("invalid token")
 }
 
-func RefreshToken(aToken, rToken string) (string, error) {
-	accessSecret := viper.GetString("jwtc.accessSecret")
+// ParseRefreshToken 校验刷新令牌的签名和有效期
+func ParseRefreshToken(rToken string) (*RefreshClaims, error) {
 	refreshSecret := viper.GetString("jwtc.refreshSecret")
 	rToken = strings.TrimPrefix(rToken, "Bearer ")
-	_, err := jwt.Parse(rToken, func(token *jwt.Token) (interface{}, error) {
+	var claims RefreshClaims
+	if _, err := jwt.ParseWithClaims(rToken, &claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(refreshSecret), nil
-	})
-	if err != nil {
-		return "", err
+	}); err != nil {
+		return nil, err
 	}
-	var claims AuthClaims
-	_, err = jwt.ParseWithClaims(aToken, &claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(accessSecret), nil
-	})
-	v, _ := err.(*jwt.ValidationError)
-	if v == nil || v.Errors == jwt.ValidationErrorExpired {
-		return GenAccessToken(claims.Phone)
+	if claims.SessionID == "" || claims.Phone == "" {
+		return nil, errors.New("invalid token")
+	}
+	return &claims, nil
+}
+
+// SessionValidator 检查会话是否仍然有效，会话被注销后其访问令牌立即失效
+type SessionValidator interface {
+	ValidSession(ctx context.Context, sid string) (bool, error)
+}
+
+// AccountValidator 检查账号是否被停用，停用后已签发的令牌立即失效
+type AccountValidator interface {
+	DisabledAccount(ctx context.Context, phone string) (bool, error)
+}
+
+type AuthOption func(*authOptions)
+
+type authOptions struct {
+	sessions SessionValidator
+	accounts AccountValidator
+	pats     PersonalAccessTokenValidator
+	scopes   ScopeResolver
+}
+
+func WithSessionValidator(v SessionValidator) AuthOption {
+	return func(o *authOptions) {
+		o.sessions = v
+	}
+}
+
+func WithAccountValidator(v AccountValidator) AuthOption {
+	return func(o *authOptions) {
+		o.accounts = v
+	}
+}
+
+// WithPersonalAccessTokens 同时接受个人访问令牌，按接口名检查令牌的权限范围
+func WithPersonalAccessTokens(v PersonalAccessTokenValidator, scopes ScopeResolver) AuthOption {
+	return func(o *authOptions) {
+		o.pats = v
+		o.scopes = scopes
 	}
-	return "", err
 }
 
-func Auth() middleware.Middleware {
+func Auth(opts ...AuthOption) middleware.Middleware {
+	o := &authOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			if tr, ok := transport.FromServerContext(ctx); ok {
@@ -115,6 +192,21 @@ func Auth() middleware.Middleware {
 				if len(parts) != 2 || parts[0] != "Bearer" {
 					return nil, errors.New("wrong token format")
 				}
+				if o.pats != nil && IsPersonalAccessToken(parts[1]) {
+					pat, err := o.pats.ValidPersonalAccessToken(ctx, parts[1])
+					if err != nil {
+						return nil, err
+					}
+					scope, ok := o.scopes(tr.Operation())
+					if !ok || !pat.HasScope(scope) {
+						return nil, ErrScopeDenied
+					}
+					if err := o.checkAccount(ctx, pat.Phone); err != nil {
+						return nil, err
+					}
+					ctx = context.WithValue(ctx, PhoneKey, pat.Phone)
+					return handler(ctx, req)
+				}
 				parsedToken, isExpire, err := ParseToken(parts[1])
 				if err != nil {
 					return nil, errors.New("invalid token")
@@ -122,9 +214,41 @@ func Auth() middleware.Middleware {
 				if isExpire {
 					return nil, errors.New("token expired")
 				}
+				// 没有会话的旧令牌视为失效，需要重新登录
+				if o.sessions != nil {
+					if parsedToken.SessionID == "" {
+						return nil, ErrSessionRevoked
+					}
+					valid, err := o.sessions.ValidSession(ctx, parsedToken.SessionID)
+					if err != nil {
+						return nil, err
+					}
+					if !valid {
+						return nil, ErrSessionRevoked
+					}
+				}
+				if err := o.checkAccount(ctx, parsedToken.Phone); err != nil {
+					return nil, err
+				}
 				ctx = context.WithValue(ctx, PhoneKey, parsedToken.Phone)
+				ctx = context.WithValue(ctx, SessionIDKey, parsedToken.SessionID)
+				ctx = context.WithValue(ctx, AdminKey, parsedToken.Admin)
 			}
 			return handler(ctx, req)
 		}
 	}
 }
+
+func (o *authOptions) checkAccount(ctx context.Context, phone string) error {
+	if o.accounts == nil {
+		return nil
+	}
+	disabled, err := o.accounts.DisabledAccount(ctx, phone)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		return ErrAccountDisabled
+	}
+	return nil
+}
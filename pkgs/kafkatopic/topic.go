@@ -1,9 +1,9 @@
 package kafkatopic
 
 const (
-	TOPIC               = "ayana_seminar_token"
-	GROUP               = "ayana_seminar_token_group"
-	PARTITIONSIZE       = 4
-	PAUSE_SIGNAL_TOPIC  = "ayana_seminar_pause_signal"
-	RESUME_SIGNAL_TOPIC = "ayana_seminar_resume_signal"
+	TOPIC             = "ayana_seminar_token"
+	GROUP             = "ayana_seminar_token_group"
+	PARTITIONSIZE     = 4
+	COMMAND_TOPIC     = "ayana_seminar_command"
+	COMMAND_ACK_TOPIC = "ayana_seminar_command_ack"
 )
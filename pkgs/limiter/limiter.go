@@ -0,0 +1,84 @@
+package limiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Limit 令牌桶参数：每秒补充 Rate 个令牌，桶容量为 Burst
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// tokenBucketScript 以 Redis 服务器时间计算令牌补充，多个网关实例共享同一个桶。
+// cost 为 0 时只检查桶内是否还有令牌而不消耗
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local cost = tonumber(ARGV[3])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local need = math.max(cost, 1)
+local allowed = 0
+local retry = 0
+if tokens >= need then
+  allowed = 1
+  tokens = tokens - cost
+else
+  retry = math.ceil((need - tokens) * 1000 / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tokens, "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, retry, math.floor(tokens)}
+`)
+
+const keyPrefix = "AyanaRateLimit:"
+
+type Limiter struct {
+	client *redis.Client
+}
+
+func NewRedisLimiter(client *redis.Client) *Limiter {
+	return &Limiter{client: client}
+}
+
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	return l.AllowN(ctx, key, limit, 1)
+}
+
+// AllowN 从桶中取 n 个令牌，n 为 0 时只检查不消耗
+func (l *Limiter) AllowN(ctx context.Context, key string, limit Limit, n int) (Result, error) {
+	if !limit.enabled() {
+		return Result{Allowed: true}, nil
+	}
+	values, err := tokenBucketScript.Run(ctx, l.client, []string{keyPrefix + key}, limit.Rate, limit.Burst, n).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Allowed:    values[0] == 1,
+		RetryAfter: time.Duration(values[1]) * time.Millisecond,
+		Remaining:  int(values[2]),
+	}, nil
+}
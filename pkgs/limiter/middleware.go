@@ -0,0 +1,200 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Fl0rencess720/Ayana/pkgs/jwtc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"go.uber.org/zap"
+)
+
+const reason = "RATE_LIMITED"
+
+type Option func(*options)
+
+type options struct {
+	defaultLimit Limit
+	routes       map[string]Limit
+	loginOp      string
+	loginLimit   Limit
+}
+
+// WithDefault 设置未单独配置的路由使用的限制
+func WithDefault(limit Limit) Option {
+	return func(o *options) {
+		o.defaultLimit = limit
+	}
+}
+
+// WithRoute 为指定 operation 设置限制，非 proto 路由的 operation 为其路径
+func WithRoute(operation string, limit Limit) Option {
+	return func(o *options) {
+		o.routes[operation] = limit
+	}
+}
+
+// WithLoginGuard 限制登录失败次数，同一手机号或同一 IP 失败过多时拒绝继续登录
+func WithLoginGuard(operation string, limit Limit) Option {
+	return func(o *options) {
+		o.loginOp = operation
+		o.loginLimit = limit
+	}
+}
+
+// Server 按路由限流的中间件。已登录请求按手机号计数，白名单路由按客户端 IP 计数，
+// Redis 不可用时放行请求
+func Server(l *Limiter, opts ...Option) middleware.Middleware {
+	o := &options{routes: make(map[string]Limit)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			op := tr.Operation()
+			limit, ok := o.routes[op]
+			if !ok {
+				limit = o.defaultLimit
+			}
+			ip := ClientIP(ctx)
+			subject := "ip:" + ip
+			if phone, ok := ctx.Value(jwtc.PhoneKey).(string); ok && phone != "" {
+				subject = "phone:" + phone
+			}
+			if err := l.check(ctx, tr, op+":"+subject, limit, 1); err != nil {
+				return nil, err
+			}
+			if op != o.loginOp || !o.loginLimit.enabled() {
+				return handler(ctx, req)
+			}
+
+			keys := []string{"login:ip:" + ip}
+			if r, ok := req.(interface{ GetPhone() string }); ok && r.GetPhone() != "" {
+				keys = append(keys, "login:phone:"+r.GetPhone())
+			}
+			for _, key := range keys {
+				if err := l.check(ctx, tr, key, o.loginLimit, 0); err != nil {
+					return nil, err
+				}
+			}
+			reply, err := handler(ctx, req)
+			if err != nil {
+				for _, key := range keys {
+					if _, lerr := l.Allow(ctx, key, o.loginLimit); lerr != nil {
+						zap.L().Error("rate limiter failed", zap.String("key", key), zap.Error(lerr))
+					}
+				}
+			}
+			return reply, err
+		}
+	}
+}
+
+func (l *Limiter) check(ctx context.Context, tr transport.Transporter, key string, limit Limit, n int) error {
+	res, err := l.AllowN(ctx, key, limit, n)
+	if err != nil {
+		zap.L().Error("rate limiter failed", zap.String("key", key), zap.Error(err))
+		return nil
+	}
+	if res.Allowed {
+		return nil
+	}
+	retryAfter := strconv.Itoa(int(math.Ceil(res.RetryAfter.Seconds())))
+	tr.ReplyHeader().Set("Retry-After", retryAfter)
+	return errors.New(429, reason, "请求过于频繁，请稍后再试").WithMetadata(map[string]string{"retry_after": retryAfter})
+}
+
+// trustedProxies 可信的反向代理，只有直接连接来自这些地址时才读取转发请求头
+var trustedProxies atomic.Pointer[[]*net.IPNet]
+
+// SetTrustedProxies 设置可信的反向代理，支持单个 IP 和 CIDR，为空时不信任任何转发请求头
+func SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		nets = append(nets, n)
+	}
+	trustedProxies.Store(&nets)
+	return nil
+}
+
+func isTrustedProxy(addr string) bool {
+	nets := trustedProxies.Load()
+	if nets == nil {
+		return false
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range *nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 取客户端 IP。直接连接来自可信代理时，从 X-Forwarded-For 右侧开始跳过可信代理，
+// 取第一个不可信的地址；否则使用连接的对端地址，客户端伪造的请求头不会生效
+func ClientIP(ctx context.Context) string {
+	r, ok := http.RequestFromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	return clientIP(r)
+}
+
+func clientIP(r *nethttp.Request) string {
+	peer, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peer = r.RemoteAddr
+	}
+	if !isTrustedProxy(peer) {
+		return peer
+	}
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			if !isTrustedProxy(hop) || i == 0 {
+				return hop
+			}
+		}
+	}
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return peer
+}
@@ -0,0 +1,51 @@
+package limiter
+
+import (
+	nethttp "net/http"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { SetTrustedProxies(nil) })
+
+	cases := []struct {
+		name   string
+		remote string
+		xff    string
+		realIP string
+		want   string
+	}{
+		{name: "direct client ignores headers", remote: "203.0.113.7:5000", xff: "1.1.1.1", realIP: "2.2.2.2", want: "203.0.113.7"},
+		{name: "trusted proxy uses xff", remote: "10.1.2.3:5000", xff: "198.51.100.9", want: "198.51.100.9"},
+		{name: "spoofed left hops are skipped", remote: "10.1.2.3:5000", xff: "1.1.1.1, 198.51.100.9", want: "198.51.100.9"},
+		{name: "chained trusted proxies", remote: "10.1.2.3:5000", xff: "198.51.100.9, 192.168.1.1, 10.9.9.9", want: "198.51.100.9"},
+		{name: "trusted proxy uses x-real-ip", remote: "192.168.1.1:5000", realIP: "198.51.100.9", want: "198.51.100.9"},
+		{name: "invalid header falls back to peer", remote: "10.1.2.3:5000", xff: "not-an-ip", realIP: "bad", want: "10.1.2.3"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := &nethttp.Request{RemoteAddr: c.remote, Header: nethttp.Header{}}
+			if c.xff != "" {
+				r.Header.Set("X-Forwarded-For", c.xff)
+			}
+			if c.realIP != "" {
+				r.Header.Set("X-Real-IP", c.realIP)
+			}
+			if got := clientIP(r); got != c.want {
+				t.Fatalf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSetTrustedProxiesRejectsInvalid(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("expected an error for an invalid CIDR")
+	}
+	if err := SetTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Fatal("expected an error for a hostname")
+	}
+}
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	kmetrics "github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+var (
+	once     sync.Once
+	requests metric.Int64Counter
+	seconds  metric.Float64Histogram
+)
+
+// setup 把 Kratos 中间件使用的 OpenTelemetry 指标导出到 Prometheus 默认注册表，
+// 与各服务通过 promauto 注册的业务指标共用一个 /metrics
+func setup() {
+	once.Do(func() {
+		exporter, err := prometheus.New()
+		if err != nil {
+			panic(err)
+		}
+		provider := sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(exporter),
+			sdkmetric.WithView(kmetrics.DefaultSecondsHistogramView(kmetrics.DefaultServerSecondsHistogramName)),
+		)
+		meter := provider.Meter("github.com/Fl0rencess720/Ayana")
+		if requests, err = kmetrics.DefaultRequestsCounter(meter, kmetrics.DefaultServerRequestsCounterName); err != nil {
+			panic(err)
+		}
+		if seconds, err = kmetrics.DefaultSecondsHistogram(meter, kmetrics.DefaultServerSecondsHistogramName); err != nil {
+			panic(err)
+		}
+	})
+}
+
+// Server 返回记录请求数和耗时的服务端中间件
+func Server() middleware.Middleware {
+	setup()
+	return kmetrics.Server(
+		kmetrics.WithRequests(requests),
+		kmetrics.WithSeconds(seconds),
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
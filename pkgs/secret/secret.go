@@ -0,0 +1,207 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// 密文格式: enc:v1:<主密钥ID>:<被主密钥加密的数据密钥>:<被数据密钥加密的明文>
+const (
+	prefix  = "enc:v1:"
+	keySize = 32
+)
+
+var (
+	ErrNoCurrentKey = errors.New("secret: current master key is not configured")
+	ErrUnknownKey   = errors.New("secret: unknown master key")
+	ErrMalformed    = errors.New("secret: malformed ciphertext")
+)
+
+var encoding = base64.RawURLEncoding
+
+// Keyring 使用本地主密钥做信封加密，每个值使用独立的数据密钥
+// 轮换时把新密钥设为 current，旧密钥保留在 keys 中直到所有密文都已重新包装
+type Keyring struct {
+	current string
+	keys    map[string][]byte
+}
+
+// NewKeyring keys 为主密钥 ID 到 base64 编码的 32 字节密钥的映射
+func NewKeyring(current string, keys map[string]string) (*Keyring, error) {
+	k := &Keyring{current: current, keys: make(map[string][]byte, len(keys))}
+	for id, encoded := range keys {
+		if strings.Contains(id, ":") {
+			return nil, fmt.Errorf("secret: invalid master key id %q", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("secret: decode master key %q: %w", id, err)
+		}
+		if len(key) != keySize {
+			return nil, fmt.Errorf("secret: master key %q must be %d bytes", id, keySize)
+		}
+		k.keys[id] = key
+	}
+	if _, ok := k.keys[current]; !ok {
+		return nil, ErrNoCurrentKey
+	}
+	return k, nil
+}
+
+// IsEncrypted 判断值是否为本包生成的密文
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt 使用当前主密钥加密，空字符串原样返回
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	dek := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return "", err
+	}
+	wrapped, err := seal(k.keys[k.current], dek)
+	if err != nil {
+		return "", err
+	}
+	data, err := seal(dek, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return prefix + k.current + ":" + encoding.EncodeToString(wrapped) + ":" + encoding.EncodeToString(data), nil
+}
+
+// Decrypt 解密密文，非密文（加密上线前写入的旧数据）原样返回
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	keyID, wrapped, data, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	dek, err := k.unwrap(keyID, wrapped)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dek, data)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation 值为明文或不是由当前主密钥包装时返回 true
+func (k *Keyring) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	if !IsEncrypted(value) {
+		return true
+	}
+	keyID, _, _, err := parse(value)
+	return err != nil || keyID != k.current
+}
+
+// Rotate 用当前主密钥重新包装数据密钥，明文会被直接加密
+func (k *Keyring) Rotate(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return k.Encrypt(value)
+	}
+	keyID, wrapped, data, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	if keyID == k.current {
+		return value, nil
+	}
+	dek, err := k.unwrap(keyID, wrapped)
+	if err != nil {
+		return "", err
+	}
+	rewrapped, err := seal(k.keys[k.current], dek)
+	if err != nil {
+		return "", err
+	}
+	return prefix + k.current + ":" + encoding.EncodeToString(rewrapped) + ":" + encoding.EncodeToString(data), nil
+}
+
+// Mask 只保留前缀和末尾四位，例如 sk-****abcd
+func Mask(plaintext string) string {
+	if plaintext == "" {
+		return ""
+	}
+	head := ""
+	if i := strings.Index(plaintext, "-"); i >= 0 && i < 8 {
+		head = plaintext[:i+1]
+	}
+	rest := []rune(plaintext[len(head):])
+	if len(rest) <= 4 {
+		return head + "****"
+	}
+	return head + "****" + string(rest[len(rest)-4:])
+}
+
+func (k *Keyring) unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	master, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, keyID)
+	}
+	return open(master, wrapped)
+}
+
+func parse(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, ErrMalformed
+	}
+	wrapped, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	data, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	return parts[0], wrapped, data, nil
+}
+
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
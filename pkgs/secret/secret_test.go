@@ -0,0 +1,173 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, keySize))
+}
+
+func newTestKeyring(t *testing.T, current string, keys map[string]string) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(current, keys)
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	return k
+}
+
+func TestRoundTrip(t *testing.T) {
+	k := newTestKeyring(t, "k1", map[string]string{"k1": testKey(1)})
+	for _, plaintext := range []string{"sk-abcdef123456", "中文密钥", "a:b:c"} {
+		ciphertext, err := k.Encrypt(plaintext)
+		if err != nil {
+			t.Fatalf("encrypt: %v", err)
+		}
+		if !IsEncrypted(ciphertext) || strings.Contains(ciphertext, plaintext) {
+			t.Fatalf("ciphertext %q leaks or lacks prefix", ciphertext)
+		}
+		got, err := k.Decrypt(ciphertext)
+		if err != nil {
+			t.Fatalf("decrypt: %v", err)
+		}
+		if got != plaintext {
+			t.Fatalf("got %q, want %q", got, plaintext)
+		}
+	}
+
+	// 每次加密使用新的数据密钥和随机数，相同明文的密文不同
+	a, _ := k.Encrypt("same")
+	b, _ := k.Encrypt("same")
+	if a == b {
+		t.Fatal("encrypting the same value twice produced identical ciphertexts")
+	}
+
+	if got, err := k.Encrypt(""); err != nil || got != "" {
+		t.Fatalf("empty plaintext: got %q, %v", got, err)
+	}
+}
+
+func TestWrongKey(t *testing.T) {
+	k := newTestKeyring(t, "k1", map[string]string{"k1": testKey(1)})
+	ciphertext, err := k.Encrypt("sk-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 同一个 ID 配置了不同的主密钥
+	other := newTestKeyring(t, "k1", map[string]string{"k1": testKey(2)})
+	if _, err := other.Decrypt(ciphertext); err == nil {
+		t.Fatal("decrypt with a different master key succeeded")
+	}
+
+	// 主密钥 ID 不存在
+	missing := newTestKeyring(t, "k2", map[string]string{"k2": testKey(1)})
+	if _, err := missing.Decrypt(ciphertext); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("got %v, want ErrUnknownKey", err)
+	}
+
+	// 密文被篡改
+	tampered := ciphertext[:len(ciphertext)-2] + "AA"
+	if tampered == ciphertext {
+		tampered = ciphertext[:len(ciphertext)-2] + "BB"
+	}
+	if _, err := k.Decrypt(tampered); err == nil {
+		t.Fatal("decrypt of tampered ciphertext succeeded")
+	}
+
+	if _, err := k.Decrypt(prefix + "k1:only-two"); !errors.Is(err, ErrMalformed) {
+		t.Fatalf("got %v, want ErrMalformed", err)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	old := newTestKeyring(t, "k1", map[string]string{"k1": testKey(1)})
+	ciphertext, err := old.Encrypt("sk-rotate-me")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := newTestKeyring(t, "k2", map[string]string{"k1": testKey(1), "k2": testKey(2)})
+	if !k.NeedsRotation(ciphertext) {
+		t.Fatal("value wrapped by the old key does not need rotation")
+	}
+	// 轮换前旧密文仍然可以解密
+	if got, err := k.Decrypt(ciphertext); err != nil || got != "sk-rotate-me" {
+		t.Fatalf("decrypt before rotation: got %q, %v", got, err)
+	}
+
+	rotated, err := k.Rotate(ciphertext)
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	if k.NeedsRotation(rotated) {
+		t.Fatal("rotated value still needs rotation")
+	}
+	if again, err := k.Rotate(rotated); err != nil || again != rotated {
+		t.Fatalf("rotating a current value changed it: %v", err)
+	}
+
+	// 所有密文轮换完成后可以移除旧密钥
+	retired := newTestKeyring(t, "k2", map[string]string{"k2": testKey(2)})
+	if got, err := retired.Decrypt(rotated); err != nil || got != "sk-rotate-me" {
+		t.Fatalf("decrypt after retiring the old key: got %q, %v", got, err)
+	}
+	if _, err := retired.Decrypt(ciphertext); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("old ciphertext: got %v, want ErrUnknownKey", err)
+	}
+}
+
+// TestLegacyPlaintext 加密上线前写入的明文原样读出，轮换时加密
+func TestLegacyPlaintext(t *testing.T) {
+	k := newTestKeyring(t, "k1", map[string]string{"k1": testKey(1)})
+	if got, err := k.Decrypt("sk-legacy"); err != nil || got != "sk-legacy" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+	if !k.NeedsRotation("sk-legacy") {
+		t.Fatal("plaintext does not need rotation")
+	}
+	if k.NeedsRotation("") {
+		t.Fatal("empty value needs rotation")
+	}
+	rotated, err := k.Rotate("sk-legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(rotated) {
+		t.Fatalf("rotated plaintext is not encrypted: %q", rotated)
+	}
+	if got, err := k.Decrypt(rotated); err != nil || got != "sk-legacy" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	if _, err := NewKeyring("k1", map[string]string{"k2": testKey(1)}); !errors.Is(err, ErrNoCurrentKey) {
+		t.Fatalf("got %v, want ErrNoCurrentKey", err)
+	}
+	if _, err := NewKeyring("k1", map[string]string{"k1": base64.StdEncoding.EncodeToString([]byte("short"))}); err == nil {
+		t.Fatal("short master key accepted")
+	}
+	if _, err := NewKeyring("a:b", map[string]string{"a:b": testKey(1)}); err == nil {
+		t.Fatal("master key id containing ':' accepted")
+	}
+}
+
+func TestMask(t *testing.T) {
+	cases := map[string]string{
+		"":                "",
+		"sk-abcdef123456": "sk-****3456",
+		"abc":             "****",
+		"plainkey99":      "****ey99",
+	}
+	for in, want := range cases {
+		if got := Mask(in); got != want {
+			t.Fatalf("Mask(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
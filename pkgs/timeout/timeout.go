@@ -0,0 +1,44 @@
+// Package timeout 按接口设置超时。Kratos 的 grpc 传输层对所有接口使用同一个超时，
+// 个别接口需要更长时间时，关闭传输层的超时，改用这个中间件
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Operations 接口名到超时时间的映射，未列出的接口使用默认超时
+type Operations map[string]time.Duration
+
+// Middleware 可用于客户端和服务端，d 为默认超时，为 0 时不限制未列出的接口
+func Middleware(d time.Duration, ops Operations) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			timeout := d
+			if op, ok := operation(ctx); ok {
+				if t, ok := ops[op]; ok {
+					timeout = t
+				}
+			}
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func operation(ctx context.Context) (string, bool) {
+	if tr, ok := transport.FromClientContext(ctx); ok {
+		return tr.Operation(), true
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.Operation(), true
+	}
+	return "", false
+}
@@ -0,0 +1,55 @@
+package timeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type testTransport struct {
+	transport.Transporter
+	operation string
+}
+
+func (t testTransport) Operation() string { return t.operation }
+
+// deadline 返回 handler 收到的 ctx 距离截止时间的剩余时间，没有截止时间时返回 0
+func deadline(t *testing.T, ctx context.Context, d time.Duration, ops Operations) time.Duration {
+	t.Helper()
+	var left time.Duration
+	_, err := Middleware(d, ops)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		if dl, ok := ctx.Deadline(); ok {
+			left = time.Until(dl)
+		}
+		return nil, nil
+	})(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return left
+}
+
+func TestMiddleware(t *testing.T) {
+	ops := Operations{"/slow": time.Minute}
+	server := func(op string) context.Context {
+		return transport.NewServerContext(context.Background(), testTransport{operation: op})
+	}
+
+	if left := deadline(t, server("/slow"), time.Second, ops); left <= time.Second || left > time.Minute {
+		t.Fatalf("listed operation: deadline in %s, want about 1m", left)
+	}
+	if left := deadline(t, server("/fast"), time.Second, ops); left <= 0 || left > time.Second {
+		t.Fatalf("unlisted operation: deadline in %s, want about 1s", left)
+	}
+	if left := deadline(t, server("/fast"), 0, ops); left != 0 {
+		t.Fatalf("zero default: deadline in %s, want none", left)
+	}
+
+	// 网关作为服务端收到请求后再调用下游，按客户端的接口名选择超时
+	client := transport.NewClientContext(server("/fast"), testTransport{operation: "/slow"})
+	if left := deadline(t, client, time.Second, ops); left <= time.Second {
+		t.Fatalf("client operation: deadline in %s, want about 1m", left)
+	}
+}
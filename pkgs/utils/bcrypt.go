@@ -13,3 +13,8 @@ func GenBcryptHash(str string) (string, error) {
 	}
 	return string(hashedBytes), nil
 }
+
+// CompareBcryptHash 校验明文是否与 bcrypt 哈希匹配
+func CompareBcryptHash(hash, str string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(str)) == nil
+}
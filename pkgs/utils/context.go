@@ -13,3 +13,17 @@ func GetPhoneFromContext(ctx context.Context) string {
 	}
 	return string(phone)
 }
+
+func GetSessionIDFromContext(ctx context.Context) string {
+	sid, ok := ctx.Value(jwtc.SessionIDKey).(string)
+	if !ok {
+		return ""
+	}
+	return sid
+}
+
+// IsAdminFromContext 访问令牌中带有管理员声明时返回 true，个人访问令牌不具有管理员权限
+func IsAdminFromContext(ctx context.Context) bool {
+	admin, _ := ctx.Value(jwtc.AdminKey).(bool)
+	return admin
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+)
+
+// NewInstanceID 生成当前进程的实例标识，同一主机上的多个进程也不会重复
+func NewInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+}
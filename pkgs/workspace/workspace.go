@@ -0,0 +1,86 @@
+package workspace
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 工作区成员的角色，权限依次递增
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleOwner  = "owner"
+)
+
+// ownerPrefix 工作区拥有的主题、角色、文档和 MCP 服务器，在原来保存手机号的所有者字段中保存 ws:<uid>
+const ownerPrefix = "ws:"
+
+var ErrForbidden = status.Error(codes.PermissionDenied, "没有该工作区的操作权限")
+
+var roleRank = map[string]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+func ValidRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+// Allows 判断 role 是否具有 need 及以上的权限
+func Allows(role, need string) bool {
+	return ValidRole(role) && roleRank[role] >= roleRank[need]
+}
+
+func OwnerKey(uid string) string {
+	return ownerPrefix + uid
+}
+
+// IsOwnerKey 用户手机号不能与工作区所有者标识混淆
+func IsOwnerKey(owner string) bool {
+	return strings.HasPrefix(owner, ownerPrefix)
+}
+
+// Scope 当前请求所在的工作区和调用者在其中的角色，由网关检查成员关系后写入
+type Scope struct {
+	UID  string
+	Role string
+}
+
+type scopeKey struct{}
+
+func NewContext(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+func FromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	return scope, ok && scope.UID != ""
+}
+
+// Owner 返回本次请求操作的资源所有者：不在工作区中时是调用者自己，
+// 否则检查调用者在工作区中的角色至少为 need，返回工作区的所有者标识
+func Owner(ctx context.Context, phone, need string) (string, error) {
+	scope, ok := FromContext(ctx)
+	if !ok {
+		return phone, nil
+	}
+	if !Allows(scope.Role, need) {
+		return "", ErrForbidden
+	}
+	return OwnerKey(scope.UID), nil
+}
+
+// MemberKey 网关缓存成员角色使用的键，成员角色变化或被移除时由用户服务删除
+func MemberKey(uid, phone string) string {
+	return "workspace_member:" + uid + ":" + phone
+}
+
+// RoleResolver 查询用户在工作区中的角色，不是成员时返回 ErrForbidden
+type RoleResolver interface {
+	MemberRole(ctx context.Context, uid, phone string) (string, error)
+}